@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"api/config"
+	"common/jwtutils"
+	"common/session"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	client  *redis.Client
+	keyring *jwtutils.Keyring
+	checker *session.Checker
+)
+
+// InitSession 加载校验令牌的公钥，连接用户服务的会话 redis，用于校验 access token 是否已吊销
+func InitSession() (err error) {
+	cfg := config.GetConfig()
+	if keyring, err = jwtutils.NewKeyring(cfg.Jwt); err != nil {
+		return err
+	}
+	client = redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%d", cfg.Redis.Host, cfg.Redis.Port),
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+	checker = session.NewChecker(session.NewStore(client, cfg.Session, cfg.Jwt), cfg.Session)
+	return nil
+}
+
+// GetKeyring 校验令牌的密钥
+func GetKeyring() *jwtutils.Keyring {
+	return keyring
+}
+
+// GetChecker 会话吊销检查
+func GetChecker() *session.Checker {
+	return checker
+}
+
+// Ping 检查 redis 连接
+func Ping(ctx context.Context) error {
+	if client == nil {
+		return errors.New("redis not initialized")
+	}
+	return client.Ping(ctx).Err()
+}
+
+// Close 关闭 redis 连接
+func Close(ctx context.Context) error {
+	if client == nil {
+		return nil
+	}
+	return client.Close()
+}
@@ -3,17 +3,24 @@ package config
 import (
 	"path"
 
+	"common/conf"
+	"common/discovery"
 	"common/env"
-
-	"github.com/BurntSushi/toml"
+	"common/jwtutils"
+	"common/lifecycle"
+	"common/secrets"
+	"common/session"
 )
 
+// envPrefix 环境变量前缀，如 API_ETCD_ADDRS
+const envPrefix = "api"
+
 // ServerConfig 服务器配置
 type ServerConfig struct {
 	Name string `toml:"name"`
-	Env  string `toml:"env"`
-	Host string `toml:"host"`
-	Port int    `toml:"port"`
+	Env  string `toml:"env" default:"dev"`
+	Host string `toml:"host" default:"0.0.0.0"`
+	Port int    `toml:"port" default:"80"`
 }
 
 // JaegerConfig Jaeger 配置
@@ -27,21 +34,68 @@ type EtcdConfig struct {
 	Addrs []string `toml:"addrs"`
 }
 
+// RedisConfig 会话 redis，与用户服务的 redis 一致
+type RedisConfig struct {
+	Host     string `toml:"host"`
+	Port     int    `toml:"port" default:"6379"`
+	DB       int    `toml:"db"`
+	Password string `toml:"password"`
+}
+
+// GrpcClientConfig 下游 grpc 服务配置
+type GrpcClientConfig struct {
+	// Version 版本选择：空为全部版本，"1.0.0" 指定版本，"1.0.0:95,1.1.0:5" 按百分比灰度
+	Version string `toml:"version"`
+}
+
 // Config 总配置
 type Config struct {
-	Server ServerConfig `toml:"server"`
-	Jaeger JaegerConfig `toml:"jaeger"`
-	Etcd   EtcdConfig   `toml:"etcd"`
+	Server     ServerConfig                `toml:"server"`
+	Jaeger     JaegerConfig                `toml:"jaeger"`
+	Etcd       EtcdConfig                  `toml:"etcd"`
+	GrpcClient map[string]GrpcClientConfig `toml:"grpc_client"`
+	Redis      RedisConfig                 `toml:"redis"`
+	Jwt        jwtutils.Config             `toml:"jwt"`
+	Session    session.Config              `toml:"session"`
+	Secrets    secrets.Config              `toml:"secrets"`
+	Startup    lifecycle.StartupConfig     `toml:"startup"`
+	Shutdown   lifecycle.ShutdownConfig    `toml:"shutdown"`
+}
+
+// Validate 校验配置
+func (c *Config) Validate() error {
+	var v conf.Validation
+	v.Required("server.name", c.Server.Name)
+	v.Range("server.port", c.Server.Port, 1, 65535)
+	v.Required("etcd.addrs", c.Etcd.Addrs)
+	for name, client := range c.GrpcClient {
+		_, err := discovery.ParseVersionSelector(client.Version)
+		v.Check("grpc_client."+name+".version", client.Version, err)
+	}
+	v.Required("redis.host", c.Redis.Host)
+	v.Range("redis.port", c.Redis.Port, 1, 65535)
+	v.Merge("jwt", c.Jwt.Validate())
+	return v.Err()
+}
+
+// SecretProvider 配置中 secret:// 引用的密钥来源
+func (c *Config) SecretProvider() (secrets.Provider, error) {
+	return secrets.New(c.Secrets)
 }
 
 var cfg Config
 
-// InitConfig 加载TOML配置文件
+// InitConfig 加载配置：默认值 -> TOML文件 -> 环境变量 API_{SECTION}_{KEY} -> 命令行参数 -{section}.{key}
 func InitConfig(confFileName string) error {
-	filePath := path.Join(env.GetEnvConfig().ConfDir, confFileName)
-	if _, err := toml.DecodeFile(filePath, &cfg); err != nil {
+	var c Config
+	err := conf.Load(&c, conf.Options{
+		Service: envPrefix,
+		File:    path.Join(env.GetEnvConfig().ConfDir, confFileName),
+	})
+	if err != nil {
 		return err
 	}
+	cfg = c
 	return nil
 }
 
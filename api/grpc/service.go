@@ -3,7 +3,8 @@ package grpc
 import "C"
 import (
 	"context"
-	"log"
+	"errors"
+	"fmt"
 
 	"api/config"
 	"common/applog"
@@ -14,35 +15,59 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/stats"
 )
 
-var UserServiceClient userservice.UserClient
-
-func InitRpcServiceClient() {
-	ctx := context.Background()
-	etcdRegister := discovery.NewResolver(config.GetConfig().Etcd.Addrs, applog.WrapGDPLogger(ctx))
-	resolver.Register(etcdRegister)
+var (
+	UserServiceClient    userservice.UserClient
+	CatalogServiceClient userservice.CatalogClient // 学校、专业目录，由用户服务提供
+	ContentServiceClient userservice.ContentClient // 帖子、草稿，由用户服务提供
+	userConn             *grpc.ClientConn
+	otelHandler          stats.Handler
+)
 
-	// 创建jaeger client
-	otelHandler, err := tracer.JaegerClientHandler(
+// InitTracer 创建jaeger client
+func InitTracer() (err error) {
+	otelHandler, err = tracer.JaegerClientHandler(
 		config.GetConfig().Jaeger.Endpoints,
 		config.GetConfig().Server.Name,
 		config.GetConfig().Server.Env,
 		config.GetConfig().Jaeger.IsOpenOnlySamplerError,
 	)
-	if err != nil {
-		log.Fatal(err)
+	return err
+}
+
+// InitRpcServiceClient 创建下游服务连接，需先调用 InitTracer
+func InitRpcServiceClient() error {
+	if otelHandler == nil {
+		return errors.New("tracer not initialized")
 	}
 
+	ctx := context.Background()
+	etcdBuilder := discovery.NewBuilder(config.GetConfig().Etcd.Addrs, applog.WrapGDPLogger(ctx))
+	resolver.Register(etcdBuilder)
+
 	conn, err := grpc.NewClient(
-		discovery.BuildResolverUrl("user"),
+		discovery.BuildVersionResolverUrl("user", config.GetConfig().GrpcClient["user"].Version),
 		grpc.WithStatsHandler(otelHandler),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(discovery.WeightRoundRobinServiceConfig),
 	)
 	if err != nil {
-		log.Fatalf("did not connect: %v", err)
+		return fmt.Errorf("create user service client failed: %w", err)
 	}
 
+	userConn = conn
 	UserServiceClient = userservice.NewUserClient(conn)
-	return
+	CatalogServiceClient = userservice.NewCatalogClient(conn)
+	ContentServiceClient = userservice.NewContentClient(conn)
+	return nil
+}
+
+// Close 关闭下游服务连接
+func Close(ctx context.Context) error {
+	if userConn == nil {
+		return nil
+	}
+	return userConn.Close()
 }
@@ -0,0 +1,222 @@
+package catalog
+
+import (
+	"strconv"
+
+	"api/grpc"
+	"api/handler"
+	userservice "grpc/user/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+}
+
+func NewHandler() *Handler {
+	return &Handler{}
+}
+
+type CreateSchoolReq struct {
+	Name    string `json:"name" binding:"required"`
+	LogoURL string `json:"logo_url"`
+}
+
+type CreateMajorReq struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+}
+
+type AddSchoolMajorsReq struct {
+	MajorIDs []uint32 `json:"major_ids" binding:"required"`
+}
+
+type SetSchoolStatusReq struct {
+	Status *int32 `json:"status" binding:"required"` // 1-启用，0-停用
+}
+
+// pageQuery 分页和搜索参数
+type pageQuery struct {
+	Page     int32  `form:"page"`
+	PageSize int32  `form:"page_size"`
+	Keyword  string `form:"keyword"`
+}
+
+// ListSchools godoc
+// @Summary      学校列表
+// @Description  分页查询启用的学校，keyword 按名称搜索
+// @Tags         catalog
+// @Produce      json
+// @Param        page       query     int     false  "页码，从1开始"
+// @Param        page_size  query     int     false  "每页数量，默认20，最大100"
+// @Param        keyword    query     string  false  "学校名称"
+// @Success      200        {object}  httputil.ResponseData{data=userservice.ListSchoolsResp}
+// @Router       /api/catalog/schools [get]
+func (*Handler) ListSchools(ctx *gin.Context) {
+	listSchools(ctx, false)
+}
+
+// AdminListSchools godoc
+// @Summary      学校列表（含未启用）
+// @Description  需要 admin 角色
+// @Tags         admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page       query     int     false  "页码，从1开始"
+// @Param        page_size  query     int     false  "每页数量，默认20，最大100"
+// @Param        keyword    query     string  false  "学校名称"
+// @Success      200        {object}  httputil.ResponseData{data=userservice.ListSchoolsResp}
+// @Router       /api/admin/catalog/schools [get]
+func (*Handler) AdminListSchools(ctx *gin.Context) {
+	listSchools(ctx, true)
+}
+
+func listSchools(ctx *gin.Context, includeDisabled bool) {
+	var q pageQuery
+	if err := ctx.ShouldBindQuery(&q); err != nil {
+		handler.ParamsError(ctx)
+		return
+	}
+	resp, err := grpc.CatalogServiceClient.ListSchools(ctx, &userservice.ListSchoolsReq{
+		Page:            q.Page,
+		PageSize:        q.PageSize,
+		Keyword:         q.Keyword,
+		IncludeDisabled: includeDisabled,
+	})
+	handler.Respond(ctx, resp, err)
+}
+
+// ListMajors godoc
+// @Summary      专业列表
+// @Description  分页查询专业，keyword 按名称搜索
+// @Tags         catalog
+// @Produce      json
+// @Param        page       query     int     false  "页码，从1开始"
+// @Param        page_size  query     int     false  "每页数量，默认20，最大100"
+// @Param        keyword    query     string  false  "专业名称"
+// @Success      200        {object}  httputil.ResponseData{data=userservice.ListMajorsResp}
+// @Router       /api/catalog/majors [get]
+func (*Handler) ListMajors(ctx *gin.Context) {
+	var q pageQuery
+	if err := ctx.ShouldBindQuery(&q); err != nil {
+		handler.ParamsError(ctx)
+		return
+	}
+	resp, err := grpc.CatalogServiceClient.ListMajors(ctx, &userservice.ListMajorsReq{
+		Page:     q.Page,
+		PageSize: q.PageSize,
+		Keyword:  q.Keyword,
+	})
+	handler.Respond(ctx, resp, err)
+}
+
+// GetSchoolMajors godoc
+// @Summary      学校开设的专业
+// @Tags         catalog
+// @Produce      json
+// @Param        id   path      int  true  "学校ID"
+// @Success      200  {object}  httputil.ResponseData{data=userservice.MajorList}
+// @Router       /api/catalog/schools/{id}/majors [get]
+func (*Handler) GetSchoolMajors(ctx *gin.Context) {
+	schoolID, ok := schoolIDParam(ctx)
+	if !ok {
+		return
+	}
+	resp, err := grpc.CatalogServiceClient.GetSchoolMajors(ctx, &userservice.GetSchoolMajorsReq{SchoolId: schoolID})
+	handler.Respond(ctx, resp, err)
+}
+
+// CreateSchool godoc
+// @Summary      创建学校
+// @Description  需要 admin 角色，创建后默认启用
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      CreateSchoolReq  true  "学校信息"
+// @Success      200   {object}  httputil.ResponseData{data=userservice.School}
+// @Router       /api/admin/catalog/schools [post]
+func (*Handler) CreateSchool(ctx *gin.Context) {
+	var req CreateSchoolReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	resp, err := grpc.CatalogServiceClient.CreateSchool(ctx, &userservice.CreateSchoolReq{Name: req.Name, LogoUrl: req.LogoURL})
+	handler.Respond(ctx, resp, err)
+}
+
+// SetSchoolStatus godoc
+// @Summary      启用/停用学校
+// @Description  需要 admin 角色
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path      int                 true  "学校ID"
+// @Param        body  body      SetSchoolStatusReq  true  "状态"
+// @Success      200   {object}  httputil.ResponseData
+// @Router       /api/admin/catalog/schools/{id}/status [put]
+func (*Handler) SetSchoolStatus(ctx *gin.Context) {
+	schoolID, ok := schoolIDParam(ctx)
+	if !ok {
+		return
+	}
+	var req SetSchoolStatusReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	_, err := grpc.CatalogServiceClient.SetSchoolStatus(ctx, &userservice.SetSchoolStatusReq{SchoolId: schoolID, Status: *req.Status})
+	handler.Respond(ctx, nil, err)
+}
+
+// AddSchoolMajors godoc
+// @Summary      为学校添加专业
+// @Description  需要 admin 角色，已存在的关联忽略
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path      int                 true  "学校ID"
+// @Param        body  body      AddSchoolMajorsReq  true  "专业ID"
+// @Success      200   {object}  httputil.ResponseData
+// @Router       /api/admin/catalog/schools/{id}/majors [post]
+func (*Handler) AddSchoolMajors(ctx *gin.Context) {
+	schoolID, ok := schoolIDParam(ctx)
+	if !ok {
+		return
+	}
+	var req AddSchoolMajorsReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	_, err := grpc.CatalogServiceClient.AddSchoolMajors(ctx, &userservice.AddSchoolMajorsReq{SchoolId: schoolID, MajorIds: req.MajorIDs})
+	handler.Respond(ctx, nil, err)
+}
+
+// CreateMajor godoc
+// @Summary      创建专业
+// @Description  需要 admin 角色，专业名称全局唯一
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      CreateMajorReq  true  "专业信息"
+// @Success      200   {object}  httputil.ResponseData{data=userservice.Major}
+// @Router       /api/admin/catalog/majors [post]
+func (*Handler) CreateMajor(ctx *gin.Context) {
+	var req CreateMajorReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	resp, err := grpc.CatalogServiceClient.CreateMajor(ctx, &userservice.CreateMajorReq{Name: req.Name, Description: req.Description})
+	handler.Respond(ctx, resp, err)
+}
+
+func schoolIDParam(ctx *gin.Context) (uint32, bool) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil || id == 0 {
+		handler.ParamsError(ctx)
+		return 0, false
+	}
+	return uint32(id), true
+}
@@ -0,0 +1,281 @@
+package content
+
+import (
+	"api/grpc"
+	"api/handler"
+	userservice "grpc/user/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+}
+
+func NewHandler() *Handler {
+	return &Handler{}
+}
+
+// SaveDraftReq 草稿内容，允许为空，发布时需要有正文或图片
+type SaveDraftReq struct {
+	Title   string   `json:"title"`
+	Content string   `json:"content"`
+	Tags    []string `json:"tags"`
+	Images  []string `json:"images"`
+}
+
+type PublishDraftReq struct {
+	Anonymous     bool   `json:"anonymous"`
+	AnonymousName string `json:"anonymous_name"` // 匿名时的称呼，为空使用默认称呼
+	Private       bool   `json:"private"`        // 仅自己可见
+}
+
+// UpdatePostReq 帖子内容，需要有正文或图片
+type UpdatePostReq struct {
+	Title   string   `json:"title"`
+	Content string   `json:"content"`
+	Tags    []string `json:"tags"`
+	Images  []string `json:"images"`
+}
+
+type SetPostVisibilityReq struct {
+	Private *bool `json:"private" binding:"required"`
+}
+
+// cursorQuery 游标分页参数，cursor 为上一页返回的 next_cursor
+type cursorQuery struct {
+	Cursor string `form:"cursor"`
+	Limit  int32  `form:"limit"`
+}
+
+type feedQuery struct {
+	Page     int32 `form:"page"`
+	PageSize int32 `form:"page_size"`
+}
+
+type listPostsQuery struct {
+	cursorQuery
+	SchoolID uint32 `form:"school_id"`
+	Tag      string `form:"tag"`
+	UserID   uint64 `form:"user_id"`
+}
+
+// CreateDraft godoc
+// @Summary      新建草稿
+// @Tags         content
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      SaveDraftReq  true  "草稿内容"
+// @Success      200   {object}  httputil.ResponseData{data=userservice.Draft}
+// @Router       /api/content/drafts [post]
+func (*Handler) CreateDraft(ctx *gin.Context) {
+	saveDraft(ctx, "")
+}
+
+// UpdateDraft godoc
+// @Summary      修改草稿
+// @Description  覆盖草稿的全部内容
+// @Tags         content
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path      string        true  "草稿ID"
+// @Param        body  body      SaveDraftReq  true  "草稿内容"
+// @Success      200   {object}  httputil.ResponseData{data=userservice.Draft}
+// @Router       /api/content/drafts/{id} [put]
+func (*Handler) UpdateDraft(ctx *gin.Context) {
+	saveDraft(ctx, ctx.Param("id"))
+}
+
+func saveDraft(ctx *gin.Context, id string) {
+	var req SaveDraftReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	resp, err := grpc.ContentServiceClient.SaveDraft(ctx, &userservice.SaveDraftReq{
+		Id:      id,
+		Title:   req.Title,
+		Content: req.Content,
+		Tags:    req.Tags,
+		Images:  req.Images,
+	})
+	handler.Respond(ctx, resp, err)
+}
+
+// ListDrafts godoc
+// @Summary      我的草稿
+// @Tags         content
+// @Produce      json
+// @Security     BearerAuth
+// @Param        cursor  query     string  false  "上一页返回的 next_cursor"
+// @Param        limit   query     int     false  "每页数量，默认20，最大100"
+// @Success      200     {object}  httputil.ResponseData{data=userservice.DraftList}
+// @Router       /api/content/drafts [get]
+func (*Handler) ListDrafts(ctx *gin.Context) {
+	var q cursorQuery
+	if err := ctx.ShouldBindQuery(&q); err != nil {
+		handler.ParamsError(ctx)
+		return
+	}
+	resp, err := grpc.ContentServiceClient.ListDrafts(ctx, &userservice.ListDraftsReq{Cursor: q.Cursor, Limit: q.Limit})
+	handler.Respond(ctx, resp, err)
+}
+
+// DeleteDraft godoc
+// @Summary      删除草稿
+// @Tags         content
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "草稿ID"
+// @Success      200  {object}  httputil.ResponseData
+// @Router       /api/content/drafts/{id} [delete]
+func (*Handler) DeleteDraft(ctx *gin.Context) {
+	_, err := grpc.ContentServiceClient.DeleteDraft(ctx, &userservice.DeleteDraftReq{Id: ctx.Param("id")})
+	handler.Respond(ctx, nil, err)
+}
+
+// PublishDraft godoc
+// @Summary      发布草稿
+// @Description  发布后草稿删除，帖子的学校为作者资料中的学校
+// @Tags         content
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path      string           true  "草稿ID"
+// @Param        body  body      PublishDraftReq  true  "发布选项"
+// @Success      200   {object}  httputil.ResponseData{data=userservice.Post}
+// @Router       /api/content/drafts/{id}/publish [post]
+func (*Handler) PublishDraft(ctx *gin.Context) {
+	var req PublishDraftReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	resp, err := grpc.ContentServiceClient.PublishDraft(ctx, &userservice.PublishDraftReq{
+		Id:            ctx.Param("id"),
+		Anonymous:     req.Anonymous,
+		AnonymousName: req.AnonymousName,
+		Private:       req.Private,
+	})
+	handler.Respond(ctx, resp, err)
+}
+
+// GetPost godoc
+// @Summary      帖子详情
+// @Description  仅自己可见、审核中的帖子只有作者可以查看；匿名帖子不返回 user_id
+// @Tags         content
+// @Produce      json
+// @Param        id   path      string  true  "帖子ID"
+// @Success      200  {object}  httputil.ResponseData{data=userservice.Post}
+// @Router       /api/content/posts/{id} [get]
+func (*Handler) GetPost(ctx *gin.Context) {
+	resp, err := grpc.ContentServiceClient.GetPost(ctx, &userservice.GetPostReq{Id: ctx.Param("id")})
+	handler.Respond(ctx, resp, err)
+}
+
+// ListPosts godoc
+// @Summary      帖子列表
+// @Description  按学校、标签、发布者筛选，按发布时间倒序；查询自己的帖子时包含仅自己可见的帖子
+// @Tags         content
+// @Produce      json
+// @Param        school_id  query     int     false  "学校ID"
+// @Param        tag        query     string  false  "标签"
+// @Param        user_id    query     int     false  "发布者ID"
+// @Param        cursor     query     string  false  "上一页返回的 next_cursor"
+// @Param        limit      query     int     false  "每页数量，默认20，最大100"
+// @Success      200        {object}  httputil.ResponseData{data=userservice.PostList}
+// @Router       /api/content/posts [get]
+func (*Handler) ListPosts(ctx *gin.Context) {
+	var q listPostsQuery
+	if err := ctx.ShouldBindQuery(&q); err != nil {
+		handler.ParamsError(ctx)
+		return
+	}
+	resp, err := grpc.ContentServiceClient.ListPosts(ctx, &userservice.ListPostsReq{
+		SchoolId: q.SchoolID,
+		Tag:      q.Tag,
+		UserId:   q.UserID,
+		Cursor:   q.Cursor,
+		Limit:    q.Limit,
+	})
+	handler.Respond(ctx, resp, err)
+}
+
+// Feed godoc
+// @Summary      推荐帖子
+// @Description  登录用户按最近的点赞、评论、收藏、浏览的标签偏好和帖子的新鲜度、热度排序，未登录或没有最近行为时返回热门列表；
+// @Description  第一页重新计算推荐列表，翻页时使用同一份列表
+// @Tags         content
+// @Produce      json
+// @Param        page       query     int  false  "页码，从1开始"
+// @Param        page_size  query     int  false  "每页数量，默认20，最大100"
+// @Success      200        {object}  httputil.ResponseData{data=userservice.FeedResp}
+// @Router       /api/content/feed [get]
+func (*Handler) Feed(ctx *gin.Context) {
+	var q feedQuery
+	if err := ctx.ShouldBindQuery(&q); err != nil {
+		handler.ParamsError(ctx)
+		return
+	}
+	resp, err := grpc.ContentServiceClient.Feed(ctx, &userservice.FeedReq{Page: q.Page, PageSize: q.PageSize})
+	handler.Respond(ctx, resp, err)
+}
+
+// DeletePost godoc
+// @Summary      删除帖子
+// @Description  作者本人或 admin 可删除
+// @Tags         content
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "帖子ID"
+// @Success      200  {object}  httputil.ResponseData
+// @Router       /api/content/posts/{id} [delete]
+func (*Handler) DeletePost(ctx *gin.Context) {
+	_, err := grpc.ContentServiceClient.DeletePost(ctx, &userservice.DeletePostReq{Id: ctx.Param("id")})
+	handler.Respond(ctx, nil, err)
+}
+
+// SetPostVisibility godoc
+// @Summary      设置仅自己可见
+// @Description  只能由作者在正常和仅自己可见之间切换
+// @Tags         content
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path      string                true  "帖子ID"
+// @Param        body  body      SetPostVisibilityReq  true  "是否仅自己可见"
+// @Success      200   {object}  httputil.ResponseData{data=userservice.Post}
+// @Router       /api/content/posts/{id}/visibility [put]
+func (*Handler) SetPostVisibility(ctx *gin.Context) {
+	var req SetPostVisibilityReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	resp, err := grpc.ContentServiceClient.SetPostVisibility(ctx, &userservice.SetPostVisibilityReq{Id: ctx.Param("id"), Private: *req.Private})
+	handler.Respond(ctx, resp, err)
+}
+
+// UpdatePost godoc
+// @Summary      修改帖子
+// @Description  只能由作者修改，覆盖帖子的标题、正文、标签和图片，不改变状态
+// @Tags         content
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path      string         true  "帖子ID"
+// @Param        body  body      UpdatePostReq  true  "帖子内容"
+// @Success      200   {object}  httputil.ResponseData{data=userservice.Post}
+// @Router       /api/content/posts/{id} [put]
+func (*Handler) UpdatePost(ctx *gin.Context) {
+	var req UpdatePostReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	resp, err := grpc.ContentServiceClient.UpdatePost(ctx, &userservice.UpdatePostReq{
+		Id:      ctx.Param("id"),
+		Title:   req.Title,
+		Content: req.Content,
+		Tags:    req.Tags,
+		Images:  req.Images,
+	})
+	handler.Respond(ctx, resp, err)
+}
@@ -0,0 +1,207 @@
+package content
+
+import (
+	"api/grpc"
+	"api/handler"
+	userservice "grpc/user/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CreateCommentReq struct {
+	Content   string `json:"content" binding:"required"`
+	Anonymous bool   `json:"anonymous"`
+}
+
+type listCommentsQuery struct {
+	cursorQuery
+	ReplyLimit int32 `form:"reply_limit"`
+}
+
+type ReconcileCountersReq struct {
+	Fix bool `json:"fix"` // false 只报告差异
+}
+
+// LikePost godoc
+// @Summary      点赞
+// @Description  重复点赞不报错
+// @Tags         content
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "帖子ID"
+// @Success      200  {object}  httputil.ResponseData
+// @Router       /api/content/posts/{id}/like [post]
+func (*Handler) LikePost(ctx *gin.Context) {
+	_, err := grpc.ContentServiceClient.LikePost(ctx, &userservice.PostActionReq{PostId: ctx.Param("id")})
+	handler.Respond(ctx, nil, err)
+}
+
+// UnlikePost godoc
+// @Summary      取消点赞
+// @Tags         content
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "帖子ID"
+// @Success      200  {object}  httputil.ResponseData
+// @Router       /api/content/posts/{id}/like [delete]
+func (*Handler) UnlikePost(ctx *gin.Context) {
+	_, err := grpc.ContentServiceClient.UnlikePost(ctx, &userservice.PostActionReq{PostId: ctx.Param("id")})
+	handler.Respond(ctx, nil, err)
+}
+
+// CollectPost godoc
+// @Summary      收藏
+// @Description  重复收藏不报错
+// @Tags         content
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "帖子ID"
+// @Success      200  {object}  httputil.ResponseData
+// @Router       /api/content/posts/{id}/collect [post]
+func (*Handler) CollectPost(ctx *gin.Context) {
+	_, err := grpc.ContentServiceClient.CollectPost(ctx, &userservice.PostActionReq{PostId: ctx.Param("id")})
+	handler.Respond(ctx, nil, err)
+}
+
+// UncollectPost godoc
+// @Summary      取消收藏
+// @Tags         content
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "帖子ID"
+// @Success      200  {object}  httputil.ResponseData
+// @Router       /api/content/posts/{id}/collect [delete]
+func (*Handler) UncollectPost(ctx *gin.Context) {
+	_, err := grpc.ContentServiceClient.UncollectPost(ctx, &userservice.PostActionReq{PostId: ctx.Param("id")})
+	handler.Respond(ctx, nil, err)
+}
+
+// CreateComment godoc
+// @Summary      评论帖子
+// @Tags         content
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path      string            true  "帖子ID"
+// @Param        body  body      CreateCommentReq  true  "评论内容"
+// @Success      200   {object}  httputil.ResponseData{data=userservice.Comment}
+// @Router       /api/content/posts/{id}/comments [post]
+func (*Handler) CreateComment(ctx *gin.Context) {
+	var req CreateCommentReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	resp, err := grpc.ContentServiceClient.CreateComment(ctx, &userservice.CreateCommentReq{
+		PostId:    ctx.Param("id"),
+		Content:   req.Content,
+		Anonymous: req.Anonymous,
+	})
+	handler.Respond(ctx, resp, err)
+}
+
+// ReplyComment godoc
+// @Summary      回复评论
+// @Description  回复楼中楼时归到所在的顶层评论下，reply_to_id 为被回复的评论
+// @Tags         content
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path      string            true  "被回复的评论ID"
+// @Param        body  body      CreateCommentReq  true  "回复内容"
+// @Success      200   {object}  httputil.ResponseData{data=userservice.Comment}
+// @Router       /api/content/comments/{id}/replies [post]
+func (*Handler) ReplyComment(ctx *gin.Context) {
+	var req CreateCommentReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	resp, err := grpc.ContentServiceClient.CreateComment(ctx, &userservice.CreateCommentReq{
+		ParentId:  ctx.Param("id"),
+		Content:   req.Content,
+		Anonymous: req.Anonymous,
+	})
+	handler.Respond(ctx, resp, err)
+}
+
+// ListComments godoc
+// @Summary      评论列表
+// @Description  按时间倒序查询顶层评论，每条附带最早的几条回复；已删除的评论显示为占位
+// @Tags         content
+// @Produce      json
+// @Param        id           path      string  true   "帖子ID"
+// @Param        reply_limit  query     int     false  "每条评论附带的回复数，默认3，最大10"
+// @Param        cursor       query     string  false  "上一页返回的 next_cursor"
+// @Param        limit        query     int     false  "每页数量，默认20，最大100"
+// @Success      200          {object}  httputil.ResponseData{data=userservice.CommentList}
+// @Router       /api/content/posts/{id}/comments [get]
+func (*Handler) ListComments(ctx *gin.Context) {
+	var q listCommentsQuery
+	if err := ctx.ShouldBindQuery(&q); err != nil {
+		handler.ParamsError(ctx)
+		return
+	}
+	resp, err := grpc.ContentServiceClient.ListComments(ctx, &userservice.ListCommentsReq{
+		PostId:     ctx.Param("id"),
+		Cursor:     q.Cursor,
+		Limit:      q.Limit,
+		ReplyLimit: q.ReplyLimit,
+	})
+	handler.Respond(ctx, resp, err)
+}
+
+// ListReplies godoc
+// @Summary      回复列表
+// @Description  按时间正序查询顶层评论的回复
+// @Tags         content
+// @Produce      json
+// @Param        id      path      string  true   "顶层评论ID"
+// @Param        cursor  query     string  false  "上一页返回的 next_cursor"
+// @Param        limit   query     int     false  "每页数量，默认20，最大100"
+// @Success      200     {object}  httputil.ResponseData{data=userservice.CommentList}
+// @Router       /api/content/comments/{id}/replies [get]
+func (*Handler) ListReplies(ctx *gin.Context) {
+	var q cursorQuery
+	if err := ctx.ShouldBindQuery(&q); err != nil {
+		handler.ParamsError(ctx)
+		return
+	}
+	resp, err := grpc.ContentServiceClient.ListReplies(ctx, &userservice.ListRepliesReq{
+		CommentId: ctx.Param("id"),
+		Cursor:    q.Cursor,
+		Limit:     q.Limit,
+	})
+	handler.Respond(ctx, resp, err)
+}
+
+// DeleteComment godoc
+// @Summary      删除评论
+// @Description  只有评论者本人可删除，匿名评论不返回 user_id 但评论者本人仍可删除；删除后在列表中显示为占位
+// @Tags         content
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "评论ID"
+// @Success      200  {object}  httputil.ResponseData
+// @Router       /api/content/comments/{id} [delete]
+func (*Handler) DeleteComment(ctx *gin.Context) {
+	_, err := grpc.ContentServiceClient.DeleteComment(ctx, &userservice.DeleteCommentReq{Id: ctx.Param("id")})
+	handler.Respond(ctx, nil, err)
+}
+
+// ReconcileCounters godoc
+// @Summary      校正帖子计数
+// @Description  需要 admin 角色，按点赞、收藏、评论记录重新统计帖子的计数，返回差异
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      ReconcileCountersReq  true  "是否修正"
+// @Success      200   {object}  httputil.ResponseData{data=userservice.ReconcileCountersResp}
+// @Router       /api/admin/content/reconcile [post]
+func (*Handler) ReconcileCounters(ctx *gin.Context) {
+	var req ReconcileCountersReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	resp, err := grpc.ContentServiceClient.ReconcileCounters(ctx, &userservice.ReconcileCountersReq{Fix: req.Fix})
+	handler.Respond(ctx, resp, err)
+}
@@ -0,0 +1,110 @@
+package content
+
+import (
+	"api/grpc"
+	"api/handler"
+	userservice "grpc/user/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+type searchTagsQuery struct {
+	Prefix string `form:"prefix"`
+	Limit  int32  `form:"limit"`
+}
+
+type trendingTagsQuery struct {
+	Hours int32 `form:"hours"`
+	Limit int32 `form:"limit"`
+}
+
+type SetTagOfficialReq struct {
+	Name        string `json:"name" binding:"required"`
+	Official    *bool  `json:"official" binding:"required"`
+	Description string `json:"description"` // 为空时不修改
+}
+
+type MergeTagsReq struct {
+	Source string `json:"source" binding:"required"`
+	Target string `json:"target" binding:"required"`
+}
+
+// SearchTags godoc
+// @Summary      标签补全
+// @Description  按前缀补全标签，官方标签在前，其余按帖子数倒序；prefix 为空时返回官方标签
+// @Tags         content
+// @Produce      json
+// @Param        prefix  query     string  false  "标签前缀"
+// @Param        limit   query     int     false  "返回数量，默认10，最大50"
+// @Success      200     {object}  httputil.ResponseData{data=userservice.TagList}
+// @Router       /api/content/tags [get]
+func (*Handler) SearchTags(ctx *gin.Context) {
+	var q searchTagsQuery
+	if err := ctx.ShouldBindQuery(&q); err != nil {
+		handler.ParamsError(ctx)
+		return
+	}
+	resp, err := grpc.ContentServiceClient.SearchTags(ctx, &userservice.SearchTagsReq{Prefix: q.Prefix, Limit: q.Limit})
+	handler.Respond(ctx, resp, err)
+}
+
+// TrendingTags godoc
+// @Summary      热门标签
+// @Description  最近一段时间内正常帖子使用最多的标签，post_count 为时间内的帖子数，结果缓存5分钟
+// @Tags         content
+// @Produce      json
+// @Param        hours  query     int  false  "统计最近几小时，默认24，最大720"
+// @Param        limit  query     int  false  "返回数量，默认10，最大50"
+// @Success      200    {object}  httputil.ResponseData{data=userservice.TagList}
+// @Router       /api/content/tags/trending [get]
+func (*Handler) TrendingTags(ctx *gin.Context) {
+	var q trendingTagsQuery
+	if err := ctx.ShouldBindQuery(&q); err != nil {
+		handler.ParamsError(ctx)
+		return
+	}
+	resp, err := grpc.ContentServiceClient.TrendingTags(ctx, &userservice.TrendingTagsReq{Hours: q.Hours, Limit: q.Limit})
+	handler.Respond(ctx, resp, err)
+}
+
+// SetTagOfficial godoc
+// @Summary      设置官方标签
+// @Description  需要 admin 角色，标签不存在时创建
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      SetTagOfficialReq  true  "标签"
+// @Success      200   {object}  httputil.ResponseData{data=userservice.Tag}
+// @Router       /api/admin/content/tags/official [put]
+func (*Handler) SetTagOfficial(ctx *gin.Context) {
+	var req SetTagOfficialReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	resp, err := grpc.ContentServiceClient.SetTagOfficial(ctx, &userservice.SetTagOfficialReq{
+		Name:        req.Name,
+		Official:    *req.Official,
+		Description: req.Description,
+	})
+	handler.Respond(ctx, resp, err)
+}
+
+// MergeTags godoc
+// @Summary      合并标签
+// @Description  需要 admin 角色，帖子中的 source 替换为 target，之后使用 source 时自动改为 target
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      MergeTagsReq  true  "合并的标签"
+// @Success      200   {object}  httputil.ResponseData{data=userservice.Tag}
+// @Router       /api/admin/content/tags/merge [post]
+func (*Handler) MergeTags(ctx *gin.Context) {
+	var req MergeTagsReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	resp, err := grpc.ContentServiceClient.MergeTags(ctx, &userservice.MergeTagsReq{Source: req.Source, Target: req.Target})
+	handler.Respond(ctx, resp, err)
+}
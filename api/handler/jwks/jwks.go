@@ -0,0 +1,29 @@
+package jwks
+
+import (
+	"net/http"
+
+	"api/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+}
+
+func NewHandler() *Handler {
+	return &Handler{}
+}
+
+// JWKS godoc
+// @Summary      JWT 校验公钥
+// @Description  RFC 7517 格式的公钥集合，按令牌头的 kid 选择公钥校验 RS256/EdDSA 令牌
+// @Tags         auth
+// @Produce      json
+// @Success      200  {object}  jwtutils.JWKS
+// @Router       /.well-known/jwks.json [get]
+func (*Handler) JWKS(ctx *gin.Context) {
+	// 轮换密钥时新公钥先加入，客户端缓存过期后即可取到
+	ctx.Header("Cache-Control", "public, max-age=300")
+	ctx.JSON(http.StatusOK, auth.GetKeyring().JWKS())
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"common/errs"
+	"common/httputil"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Bind 解析请求体，失败时返回参数错误
+func Bind(ctx *gin.Context, req any) bool {
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ParamsError(ctx)
+		return false
+	}
+	return true
+}
+
+// ParamsError 返回参数错误
+func ParamsError(ctx *gin.Context) {
+	httputil.ErrorJsonResponse(ctx, "", httputil.CodeParamsError, "参数错误", nil)
+}
+
+// Respond grpc 调用结果转换为响应，错误码和提示由下游服务返回
+func Respond(ctx *gin.Context, data any, err error) {
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		httputil.ErrorJsonResponse(ctx, "", code, msg, nil)
+		return
+	}
+	httputil.SuccessJsonResponse(ctx, "", data)
+}
@@ -0,0 +1,152 @@
+package user
+
+import (
+	"api/grpc"
+	"api/handler"
+	"api/middleware"
+	"common/httputil"
+	userservice "grpc/user/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AccountHandler struct {
+}
+
+func NewAccountHandler() *AccountHandler {
+	return &AccountHandler{}
+}
+
+type RegisterReq struct {
+	Phone    string `json:"phone" binding:"required"`
+	Password string `json:"password" binding:"required"`
+	Nickname string `json:"nickname"`
+}
+
+type LoginReq struct {
+	Phone    string `json:"phone" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+type RefreshTokenReq struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+type LogoutReq struct {
+	All bool `json:"all"`
+}
+
+type LogoffReq struct {
+	Password string `json:"password" binding:"required"`
+}
+
+// Register godoc
+// @Summary      注册
+// @Description  手机号+密码注册，密码8-32位
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        body  body      RegisterReq  true  "注册信息"
+// @Success      200   {object}  httputil.ResponseData{data=userservice.RegisterResp}
+// @Router       /api/user/register [post]
+func (*AccountHandler) Register(ctx *gin.Context) {
+	var req RegisterReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	resp, err := grpc.UserServiceClient.Register(ctx, &userservice.RegisterReq{
+		Phone:    req.Phone,
+		Password: req.Password,
+		Nickname: req.Nickname,
+	})
+	handler.Respond(ctx, resp, err)
+}
+
+// Login godoc
+// @Summary      登录
+// @Description  手机号+密码登录，令牌与 X-Device-ID 绑定，同一设备重复登录会替换原会话
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        X-Device-ID  header    string    true  "设备ID"
+// @Param        body         body      LoginReq  true  "登录信息"
+// @Success      200          {object}  httputil.ResponseData{data=userservice.TokenResp}
+// @Router       /api/user/login [post]
+func (*AccountHandler) Login(ctx *gin.Context) {
+	var req LoginReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	deviceID := ctx.GetHeader(middleware.HeaderDeviceID)
+	if deviceID == "" {
+		httputil.ErrorJsonResponse(ctx, "", httputil.CodeParamsError, "缺少设备ID", nil)
+		return
+	}
+	resp, err := grpc.UserServiceClient.Login(ctx, &userservice.LoginReq{
+		Phone:    req.Phone,
+		Password: req.Password,
+		DeviceId: deviceID,
+	})
+	handler.Respond(ctx, resp, err)
+}
+
+// RefreshToken godoc
+// @Summary      刷新令牌
+// @Description  用 refresh token 换取新的令牌，旧的 refresh token 随即失效
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        X-Device-ID  header    string           true  "设备ID"
+// @Param        body         body      RefreshTokenReq  true  "refresh token"
+// @Success      200          {object}  httputil.ResponseData{data=userservice.TokenResp}
+// @Router       /api/user/token/refresh [post]
+func (*AccountHandler) RefreshToken(ctx *gin.Context) {
+	var req RefreshTokenReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	resp, err := grpc.UserServiceClient.RefreshToken(ctx, &userservice.RefreshTokenReq{
+		RefreshToken: req.RefreshToken,
+		DeviceId:     ctx.GetHeader(middleware.HeaderDeviceID),
+	})
+	handler.Respond(ctx, resp, err)
+}
+
+// Logout godoc
+// @Summary      退出登录
+// @Description  下线当前设备，all 为 true 时下线所有设备
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      LogoutReq  false  "是否下线所有设备"
+// @Success      200   {object}  httputil.ResponseData
+// @Router       /api/user/logout [post]
+func (*AccountHandler) Logout(ctx *gin.Context) {
+	var req LogoutReq
+	// 请求体可以为空
+	if ctx.Request.ContentLength > 0 && !handler.Bind(ctx, &req) {
+		return
+	}
+	_, err := grpc.UserServiceClient.Logout(ctx, &userservice.LogoutReq{All: req.All})
+	handler.Respond(ctx, nil, err)
+}
+
+// Logoff godoc
+// @Summary      注销账号
+// @Description  验证密码后注销当前账号并下线所有设备，注销后手机号可重新注册
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      LogoffReq  true  "密码"
+// @Success      200   {object}  httputil.ResponseData
+// @Router       /api/user/logoff [post]
+func (*AccountHandler) Logoff(ctx *gin.Context) {
+	var req LogoffReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	_, err := grpc.UserServiceClient.Logoff(ctx, &userservice.LogoffReq{Password: req.Password})
+	handler.Respond(ctx, nil, err)
+}
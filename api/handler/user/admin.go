@@ -0,0 +1,63 @@
+package user
+
+import (
+	"api/grpc"
+	"api/handler"
+	userservice "grpc/user/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminHandler struct {
+}
+
+func NewAdminHandler() *AdminHandler {
+	return &AdminHandler{}
+}
+
+type BanReq struct {
+	UserID   int64 `json:"user_id" binding:"required"`
+	Duration int64 `json:"duration"` // 封禁时长（秒），0 为永久封禁，解封时间不能晚于 2106 年
+}
+
+type UnbanReq struct {
+	UserID int64 `json:"user_id" binding:"required"`
+}
+
+// Ban godoc
+// @Summary      封禁用户
+// @Description  封禁并下线用户的所有设备，需要 admin 角色
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      BanReq  true  "封禁信息"
+// @Success      200   {object}  httputil.ResponseData
+// @Router       /api/admin/user/ban [post]
+func (*AdminHandler) Ban(ctx *gin.Context) {
+	var req BanReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	_, err := grpc.UserServiceClient.Ban(ctx, &userservice.BanReq{UserId: req.UserID, Duration: req.Duration})
+	handler.Respond(ctx, nil, err)
+}
+
+// Unban godoc
+// @Summary      解封用户
+// @Description  需要 admin 角色
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      UnbanReq  true  "用户ID"
+// @Success      200   {object}  httputil.ResponseData
+// @Router       /api/admin/user/unban [post]
+func (*AdminHandler) Unban(ctx *gin.Context) {
+	var req UnbanReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	_, err := grpc.UserServiceClient.Unban(ctx, &userservice.UnbanReq{UserId: req.UserID})
+	handler.Respond(ctx, nil, err)
+}
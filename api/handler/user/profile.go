@@ -0,0 +1,90 @@
+package user
+
+import (
+	"strconv"
+
+	"api/grpc"
+	"api/handler"
+	userservice "grpc/user/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProfileHandler struct {
+}
+
+func NewProfileHandler() *ProfileHandler {
+	return &ProfileHandler{}
+}
+
+// UpdateProfileReq 只更新请求中出现的字段
+type UpdateProfileReq struct {
+	Nickname       *string `json:"nickname"`
+	SchoolID       *uint32 `json:"school_id"`
+	MajorID        *uint32 `json:"major_id"`
+	AdmissionGrade *int32  `json:"admission_grade"`
+	AvatarURL      *string `json:"avatar_url"`
+}
+
+// GetProfile godoc
+// @Summary      查询用户资料
+// @Description  不传 user_id 时查询当前用户，查询他人时手机号脱敏
+// @Tags         user
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id  query     int  false  "用户ID"
+// @Success      200      {object}  httputil.ResponseData{data=userservice.Profile}
+// @Router       /api/user/profile [get]
+func (*ProfileHandler) GetProfile(ctx *gin.Context) {
+	var userID int64
+	if v := ctx.Query("user_id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			handler.ParamsError(ctx)
+			return
+		}
+		userID = id
+	}
+	resp, err := grpc.UserServiceClient.GetProfile(ctx, &userservice.GetProfileReq{UserId: userID})
+	handler.Respond(ctx, resp, err)
+}
+
+// UpdateProfile godoc
+// @Summary      修改用户资料
+// @Description  只更新请求中出现的字段
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      UpdateProfileReq  true  "资料"
+// @Success      200   {object}  httputil.ResponseData{data=userservice.Profile}
+// @Router       /api/user/profile [put]
+func (*ProfileHandler) UpdateProfile(ctx *gin.Context) {
+	var req UpdateProfileReq
+	if !handler.Bind(ctx, &req) {
+		return
+	}
+	in := &userservice.UpdateProfileReq{}
+	if req.Nickname != nil {
+		in.Nickname = *req.Nickname
+		in.Fields = append(in.Fields, "nickname")
+	}
+	if req.SchoolID != nil {
+		in.SchoolId = *req.SchoolID
+		in.Fields = append(in.Fields, "school_id")
+	}
+	if req.MajorID != nil {
+		in.MajorId = *req.MajorID
+		in.Fields = append(in.Fields, "major_id")
+	}
+	if req.AdmissionGrade != nil {
+		in.AdmissionGrade = *req.AdmissionGrade
+		in.Fields = append(in.Fields, "admission_grade")
+	}
+	if req.AvatarURL != nil {
+		in.AvatarUrl = *req.AvatarURL
+		in.Fields = append(in.Fields, "avatar_url")
+	}
+	resp, err := grpc.UserServiceClient.UpdateProfile(ctx, in)
+	handler.Respond(ctx, resp, err)
+}
@@ -0,0 +1,75 @@
+package initialize
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"api/auth"
+	"api/config"
+	"api/grpc"
+	"api/web"
+	"common/env"
+	"common/lifecycle"
+	"common/tracer"
+)
+
+// Init 加载配置并按依赖顺序启动组件，返回的管理器负责优雅关闭
+//
+// 依赖关系：tracer -> grpc_client, session -> http
+func Init(ctx context.Context) (*lifecycle.Manager, error) {
+	if err := env.InitEnvConfig(); err != nil {
+		return nil, fmt.Errorf("init env failed: %w", err)
+	}
+	if err := config.InitConfig("config.toml"); err != nil {
+		return nil, fmt.Errorf("init config failed: %w", err)
+	}
+
+	lc := lifecycle.NewManager(config.GetConfig().Startup, config.GetConfig().Shutdown)
+	lc.MustRegister(components(lc)...)
+
+	if err := lc.Start(ctx); err != nil {
+		return nil, err
+	}
+	return lc, nil
+}
+
+// components 组件定义
+func components(lc *lifecycle.Manager) []lifecycle.Component {
+	var server *http.Server
+
+	return []lifecycle.Component{
+		{
+			Name:      "tracer",
+			Start:     func(context.Context) error { return grpc.InitTracer() },
+			Stop:      tracer.Shutdown,
+			StopPhase: lifecycle.PhaseFlush,
+		},
+		{
+			Name:      "grpc_client",
+			DependsOn: []string{"tracer"},
+			Start:     func(context.Context) error { return grpc.InitRpcServiceClient() },
+			Stop:      grpc.Close,
+			StopPhase: lifecycle.PhaseResource,
+		},
+		{
+			Name:      "session",
+			Start:     func(context.Context) error { return auth.InitSession() },
+			Ready:     auth.Ping,
+			Stop:      auth.Close,
+			StopPhase: lifecycle.PhaseResource,
+		},
+		{
+			Name:      "http",
+			DependsOn: []string{"grpc_client", "session"},
+			Start: func(context.Context) (err error) {
+				server, err = web.Start()
+				return err
+			},
+			Stop: func(ctx context.Context) error {
+				return lifecycle.ShutdownHTTP(server, lc.GraceTimeout())(ctx)
+			},
+			StopPhase: lifecycle.PhaseServer,
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package initialize
+
+import (
+	"context"
+	"testing"
+
+	"common/lifecycle"
+)
+
+// TestShutdownOrder 使用组件定义中的名称、依赖和关闭阶段，替换为空的启动和关闭钩子，
+// 关闭时按 服务 -> 刷新 -> 资源 的顺序执行
+func TestShutdownOrder(t *testing.T) {
+	lc := lifecycle.NewManager(lifecycle.StartupConfig{Retries: -1}, lifecycle.ShutdownConfig{PropagationDelay: -1})
+	want := map[string]lifecycle.Phase{
+		"http":        lifecycle.PhaseServer,
+		"tracer":      lifecycle.PhaseFlush,
+		"grpc_client": lifecycle.PhaseResource,
+		"session":     lifecycle.PhaseResource,
+	}
+	assertShutdownOrder(t, lc, components(lc), want)
+}
+
+func assertShutdownOrder(t *testing.T, lc *lifecycle.Manager, components []lifecycle.Component, want map[string]lifecycle.Phase) {
+	t.Helper()
+	var stopped []string
+	for _, c := range components {
+		if c.Stop == nil {
+			continue
+		}
+		if c.StopPhase != want[c.Name] {
+			t.Errorf("component %s stops in phase %v, want %v", c.Name, c.StopPhase, want[c.Name])
+		}
+		name := c.Name
+		lc.MustRegister(lifecycle.Component{
+			Name:      name,
+			DependsOn: c.DependsOn,
+			Stop: func(context.Context) error {
+				stopped = append(stopped, name)
+				return nil
+			},
+			StopPhase: c.StopPhase,
+		})
+	}
+	if t.Failed() {
+		return
+	}
+	// 没有关闭钩子的组件只作为依赖存在
+	for _, c := range components {
+		if c.Stop == nil {
+			lc.MustRegister(lifecycle.Component{Name: c.Name, DependsOn: c.DependsOn})
+		}
+	}
+	if err := lc.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error: %v", err)
+	}
+	if err := lc.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error: %v", err)
+	}
+	if len(stopped) != len(want) {
+		t.Fatalf("stopped %v, want %d components", stopped, len(want))
+	}
+	for i := 1; i < len(stopped); i++ {
+		if prev, cur := want[stopped[i-1]], want[stopped[i]]; prev > cur {
+			t.Errorf("%s (%v) stopped before %s (%v): %v", stopped[i-1], prev, stopped[i], cur, stopped)
+		}
+	}
+}
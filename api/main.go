@@ -4,10 +4,8 @@ import (
 	"context"
 	"log"
 
-	"api/config"
-	"api/web"
+	"api/initialize"
 	srv "common"
-	"common/env"
 )
 
 // @title           Swagger Example API
@@ -27,15 +25,12 @@ import (
 // @name  Authorization
 // @description  请输入 JWT Token，格式为：Bearer {你的Token值}
 func main() {
-	err := env.InitEnvConfig()
+	//按依赖顺序启动组件：tracer -> grpc_client -> http
+	//关闭时等待进行中的请求完成（超时强制关闭），再刷新追踪数据，最后关闭grpc连接
+	lc, err := initialize.Init(context.Background())
 	if err != nil {
-		return
+		log.Fatalf("api start failed: %v", err)
 	}
-	err = config.InitConfig("config.toml")
-	if err != nil {
-		return
-	}
-	server, _ := web.Start()
 
 	//开启pprof 默认的访问路径是/debug/pprof
 	//pprof.Register(r)
@@ -52,9 +47,6 @@ func main() {
 	//		alloc1(outCh) // 不停的有goruntine因为outCh堵塞，无法释放
 	//	}
 	//})
-	srv.Run(func() {
-		if err := server.Shutdown(context.Background()); err != nil {
-			log.Printf("Gin server graceful shutdown failed: %v", err)
-		}
-	})
+
+	srv.Run(lc)
 }
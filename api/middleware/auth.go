@@ -0,0 +1,159 @@
+package middleware
+
+import (
+	"errors"
+	"log"
+
+	"api/auth"
+	"common/errs"
+	"common/httputil"
+	"common/jwtutils"
+	"common/session"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	HeaderAuthorization = "Authorization"
+	HeaderDeviceID      = "X-Device-ID"
+
+	// gin.Context 中保存用户信息的键
+	ContextUserID   = "userID"
+	ContextDeviceID = "deviceID"
+	ContextClaims   = "claims"
+)
+
+// 令牌本身的错误码见 jwtutils.Errors
+const (
+	TokenRevokedCode httputil.BusinessCode = 10001004
+	AuthFailedCode   httputil.BusinessCode = 10001005
+	ForbiddenCode    httputil.BusinessCode = 10001009
+)
+
+type authOptions struct {
+	optional bool
+	skip     map[string]struct{}
+}
+
+// AuthOption 鉴权选项
+type AuthOption func(o *authOptions)
+
+// Optional 未携带 Token 时按匿名用户放行，携带了 Token 仍需校验通过
+func Optional() AuthOption {
+	return func(o *authOptions) {
+		o.optional = true
+	}
+}
+
+// Skip 跳过鉴权的路由，与注册时的路径一致，如 /api/user/login
+func Skip(paths ...string) AuthOption {
+	return func(o *authOptions) {
+		for _, p := range paths {
+			o.skip[p] = struct{}{}
+		}
+	}
+}
+
+// Auth 鉴权中间件，校验 Authorization: Bearer {token} 和 X-Device-ID 请求头，
+// 以及会话是否已吊销（下线、被挤下线），通过后用户信息写入 gin.Context 和下游 grpc 请求的 metadata
+//
+// 挂在路由或路由组上按需启用，组内不需要鉴权的路由用 Skip 排除
+func Auth(opts ...AuthOption) gin.HandlerFunc {
+	o := &authOptions{skip: make(map[string]struct{})}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return func(c *gin.Context) {
+		if _, ok := o.skip[c.FullPath()]; ok {
+			c.Next()
+			return
+		}
+
+		token := jwtutils.BearerToken(c.GetHeader(HeaderAuthorization))
+		if token == "" {
+			if o.optional {
+				c.Next()
+				return
+			}
+			abortError(c, jwtutils.TokenMissing)
+			return
+		}
+
+		deviceID := c.GetHeader(HeaderDeviceID)
+		claims, err := auth.GetKeyring().Parse(token, jwtutils.TokenTypeAccess, deviceID)
+		if err != nil {
+			abortError(c, jwtutils.ToBError(err))
+			return
+		}
+
+		if err := auth.GetChecker().Check(c, claims); err != nil {
+			switch {
+			case errors.Is(err, session.ErrRevoked), errors.Is(err, session.ErrSessionNotFound):
+				abort(c, TokenRevokedCode, "登录已失效，请重新登录")
+			default:
+				log.Printf("check session failed: %v", err)
+				abort(c, AuthFailedCode, "鉴权失败，请稍后重试")
+			}
+			return
+		}
+		userID := claims.UserID
+
+		c.Set(ContextUserID, userID)
+		c.Set(ContextDeviceID, deviceID)
+		c.Set(ContextClaims, claims)
+		// handler 以 gin.Context 调用 grpc 时通过 ContextWithFallback 取到请求的 context
+		c.Request = c.Request.WithContext(jwtutils.AppendToOutgoingContext(c.Request.Context(), token, userID, deviceID))
+		c.Next()
+	}
+}
+
+// RequireRole 需要令牌中含有角色，挂在 Auth 之后
+func RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := Claims(c)
+		if !ok {
+			abortError(c, jwtutils.TokenMissing)
+			return
+		}
+		if !claims.HasRole(role) {
+			abort(c, ForbiddenCode, "无权限")
+			return
+		}
+		c.Next()
+	}
+}
+
+// UserID 当前登录用户，未鉴权或匿名访问时 ok 为 false
+func UserID(c *gin.Context) (userID uint64, ok bool) {
+	v, ok := c.Get(ContextUserID)
+	if !ok {
+		return 0, false
+	}
+	userID, ok = v.(uint64)
+	return userID, ok
+}
+
+// Claims 当前登录用户的令牌内容，含角色
+func Claims(c *gin.Context) (*jwtutils.Claims, bool) {
+	v, ok := c.Get(ContextClaims)
+	if !ok {
+		return nil, false
+	}
+	claims, ok := v.(*jwtutils.Claims)
+	return claims, ok
+}
+
+// DeviceID 当前登录设备
+func DeviceID(c *gin.Context) string {
+	return c.GetString(ContextDeviceID)
+}
+
+func abortError(c *gin.Context, err *errs.BError) {
+	abort(c, httputil.BusinessCode(err.Code), err.Msg)
+}
+
+func abort(c *gin.Context, code httputil.BusinessCode, msg string) {
+	httputil.ErrorJsonResponse(c, "", code, msg, nil)
+	c.Abort()
+}
@@ -0,0 +1,31 @@
+package router
+
+import (
+	"api/handler/catalog"
+	"api/middleware"
+	"common/jwtutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Catalog struct {
+}
+
+func init() {
+	Register(&Catalog{})
+}
+
+func (*Catalog) Route(r *gin.Engine) {
+	h := catalog.NewHandler()
+	g := r.Group("/api/catalog")
+	g.GET("/schools", h.ListSchools)
+	g.GET("/schools/:id/majors", h.GetSchoolMajors)
+	g.GET("/majors", h.ListMajors)
+
+	a := r.Group("/api/admin/catalog", middleware.Auth(), middleware.RequireRole(jwtutils.RoleAdmin))
+	a.GET("/schools", h.AdminListSchools)
+	a.POST("/schools", h.CreateSchool)
+	a.PUT("/schools/:id/status", h.SetSchoolStatus)
+	a.POST("/schools/:id/majors", h.AddSchoolMajors)
+	a.POST("/majors", h.CreateMajor)
+}
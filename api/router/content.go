@@ -0,0 +1,51 @@
+package router
+
+import (
+	"api/handler/content"
+	"api/middleware"
+	"common/jwtutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Content struct {
+}
+
+func init() {
+	Register(&Content{})
+}
+
+func (*Content) Route(r *gin.Engine) {
+	h := content.NewHandler()
+	// 查询接口允许匿名访问，登录后可以查看自己仅自己可见的帖子
+	g := r.Group("/api/content", middleware.Auth(middleware.Optional()))
+	g.GET("/posts", h.ListPosts)
+	g.GET("/posts/:id", h.GetPost)
+	g.GET("/feed", h.Feed)
+	g.GET("/posts/:id/comments", h.ListComments)
+	g.GET("/comments/:id/replies", h.ListReplies)
+	g.GET("/tags", h.SearchTags)
+	g.GET("/tags/trending", h.TrendingTags)
+
+	a := r.Group("/api/content", middleware.Auth())
+	a.GET("/drafts", h.ListDrafts)
+	a.POST("/drafts", h.CreateDraft)
+	a.PUT("/drafts/:id", h.UpdateDraft)
+	a.DELETE("/drafts/:id", h.DeleteDraft)
+	a.POST("/drafts/:id/publish", h.PublishDraft)
+	a.PUT("/posts/:id", h.UpdatePost)
+	a.DELETE("/posts/:id", h.DeletePost)
+	a.PUT("/posts/:id/visibility", h.SetPostVisibility)
+	a.POST("/posts/:id/like", h.LikePost)
+	a.DELETE("/posts/:id/like", h.UnlikePost)
+	a.POST("/posts/:id/collect", h.CollectPost)
+	a.DELETE("/posts/:id/collect", h.UncollectPost)
+	a.POST("/posts/:id/comments", h.CreateComment)
+	a.POST("/comments/:id/replies", h.ReplyComment)
+	a.DELETE("/comments/:id", h.DeleteComment)
+
+	admin := r.Group("/api/admin/content", middleware.Auth(), middleware.RequireRole(jwtutils.RoleAdmin))
+	admin.POST("/reconcile", h.ReconcileCounters)
+	admin.PUT("/tags/official", h.SetTagOfficial)
+	admin.POST("/tags/merge", h.MergeTags)
+}
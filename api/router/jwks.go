@@ -0,0 +1,19 @@
+package router
+
+import (
+	"api/handler/jwks"
+
+	"github.com/gin-gonic/gin"
+)
+
+type JWKS struct {
+}
+
+func init() {
+	Register(&JWKS{})
+}
+
+func (*JWKS) Route(r *gin.Engine) {
+	h := jwks.NewHandler()
+	r.GET("/.well-known/jwks.json", h.JWKS)
+}
@@ -1,8 +1,9 @@
 package router
 
 import (
-	"api/grpc"
 	"api/handler/user"
+	"api/middleware"
+	"common/jwtutils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -16,8 +17,23 @@ func init() {
 }
 
 func (*User) Route(r *gin.Engine) {
-	grpc.InitRpcServiceClient()
-
 	h := user.NewTestHandler()
-	r.POST("/api/user/test", h.Test)
+	account := user.NewAccountHandler()
+	profile := user.NewProfileHandler()
+	g := r.Group("/api/user", middleware.Auth(
+		middleware.Skip("/api/user/test", "/api/user/register", "/api/user/login", "/api/user/token/refresh"),
+	))
+	g.POST("/test", h.Test)
+	g.POST("/register", account.Register)
+	g.POST("/login", account.Login)
+	g.POST("/token/refresh", account.RefreshToken)
+	g.POST("/logout", account.Logout)
+	g.POST("/logoff", account.Logoff)
+	g.GET("/profile", profile.GetProfile)
+	g.PUT("/profile", profile.UpdateProfile)
+
+	admin := user.NewAdminHandler()
+	a := r.Group("/api/admin/user", middleware.Auth(), middleware.RequireRole(jwtutils.RoleAdmin))
+	a.POST("/ban", admin.Ban)
+	a.POST("/unban", admin.Unban)
 }
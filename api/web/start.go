@@ -3,6 +3,7 @@ package web
 import (
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 
 	"api/config"
@@ -14,6 +15,8 @@ import (
 func Start() (*http.Server, error) {
 	engine := gin.New()
 	gin.SetMode(gin.DebugMode)
+	// handler 直接以 gin.Context 调用 grpc，需要取到中间件写入请求 context 的 metadata 和 trace
+	engine.ContextWithFallback = true
 
 	// 创建jaeger trace
 	/*tp, tpErr := tracer.JaegerTraceProvider(
@@ -45,8 +48,14 @@ func Start() (*http.Server, error) {
 		Handler: engine,
 	}
 
+	// 同步监听，端口占用等错误直接返回
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("listen tcp %s error: %w", addr, err)
+	}
+
 	go func() {
-		err := ginServer.ListenAndServe()
+		err := ginServer.Serve(lis)
 		if err != nil && !errors.Is(err, http.ErrServerClosed) { // 排除正常关闭的错误
 			panic(fmt.Sprintf("Gin server start failed: %v", err))
 		}
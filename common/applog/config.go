@@ -1,17 +1,36 @@
 package applog
 
+import "common/conf"
+
 // LogConfig 日志的配置相关
 type LogConfig struct {
 	Path    string `toml:"path"`
 	LogFile string `toml:"log_file"`
-	Split   string `toml:"split"`
-	Level   string `toml:"level"`
+	Split   string `toml:"split" default:"d"`
+	Level   string `toml:"level" default:"info"`
 	Stdout  bool   `toml:"stdout"`
-	MaxAge  int    `toml:"max_age"`
-	Format  string `toml:"format"`
+	MaxAge  int    `toml:"max_age" default:"168"`
+	Format  string `toml:"format" default:"json"`
 	ELK     ELK
 }
 
+// Validate 校验日志配置
+func (c LogConfig) Validate() error {
+	var v conf.Validation
+	v.Required("log_file", c.LogFile)
+	v.OneOf("split", c.Split, "h", "hour", "hourly", "d", "day", "daily", "m", "month", "monthly", "w", "week", "weekly")
+	v.OneOf("level", c.Level, LoggerDebug, LoggerInfo, LoggerWarn, LoggerError, LoggerFatal)
+	v.OneOf("format", c.Format, "json", "JSON", "text", "TEXT")
+	v.Range("max_age", c.MaxAge, 1, 24*365*10)
+	if c.ELK.IsSendELK {
+		v.Required("elk.kafka_addr", c.ELK.KafkaAddr)
+		v.Required("elk.kafka_topic", c.ELK.KafkaTopic)
+		v.Required("elk.addr", c.ELK.Addr)
+		v.Required("elk.index", c.ELK.Index)
+	}
+	return v.Err()
+}
+
 type ELK struct {
 	IsSendELK          bool   `toml:"is_send_elk"`          // 是否发送日志到 ELK
 	KafkaAddr          string `toml:"kafka_addr"`           // Kafka 地址
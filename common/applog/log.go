@@ -137,6 +137,24 @@ func createFormatter(format string) logrus.Formatter {
 	}
 }
 
+// parseLevel 解析日志级别
+func parseLevel(level string) (logrus.Level, bool) {
+	switch level {
+	case LoggerInfo:
+		return logrus.InfoLevel, true
+	case LoggerError:
+		return logrus.ErrorLevel, true
+	case LoggerWarn:
+		return logrus.WarnLevel, true
+	case LoggerFatal:
+		return logrus.FatalLevel, true
+	case LoggerDebug:
+		return logrus.DebugLevel, true
+	default:
+		return logrus.InfoLevel, false
+	}
+}
+
 // NewLogger 用来初始化一个logger
 func NewLogger(conf *LogConfig) *Logger {
 	if len(conf.LogFile) == 0 {
@@ -151,26 +169,11 @@ func NewLogger(conf *LogConfig) *Logger {
 
 	// 设置输出的level以及初始化对应的实例
 	executor := logrus.New()
-	switch conf.Level {
-	case LoggerInfo:
-		executor.SetLevel(logrus.InfoLevel)
-		break
-	case LoggerError:
-		executor.SetLevel(logrus.ErrorLevel)
-		break
-	case LoggerWarn:
-		executor.SetLevel(logrus.WarnLevel)
-		break
-	case LoggerFatal:
-		executor.SetLevel(logrus.FatalLevel)
-		break
-	case LoggerDebug:
-		executor.SetLevel(logrus.DebugLevel)
-		break
-	default:
-		executor.SetLevel(logrus.InfoLevel)
-		break
+	level, ok := parseLevel(conf.Level)
+	if !ok {
+		level = logrus.InfoLevel
 	}
+	executor.SetLevel(level)
 
 	// 创建日志格式化器
 	formatter := createFormatter(conf.Format)
@@ -244,8 +247,6 @@ func NewLogger(conf *LogConfig) *Logger {
 			conf.ELK.Index,
 			GetEsClient(),
 		)
-
-		mq.RegisterShutdownHook()
 	}
 
 	return &Logger{
@@ -2,7 +2,10 @@ package applog
 
 import (
 	"context"
+	"errors"
 	"fmt"
+
+	"common/applog/mq"
 )
 
 type ctxKey string
@@ -29,6 +32,27 @@ func InitLoggers(log LogConfig) error {
 	return nil
 }
 
+// SetLevel 运行时调整日志级别
+func SetLevel(level string) error {
+	if logger == nil {
+		return errors.New("logger not initialized")
+	}
+	lv, ok := parseLevel(level)
+	if !ok {
+		return fmt.Errorf("invalid log level: %s", level)
+	}
+	logger.executor.SetLevel(lv)
+	return nil
+}
+
+// Close 刷新并关闭日志相关的 Kafka 生产者和消费者
+func Close(ctx context.Context) error {
+	if logger == nil || logger.logConfig == nil || !logger.logConfig.ELK.IsSendELK {
+		return nil
+	}
+	return mq.Close(ctx)
+}
+
 // WrapGDPLogger 获取日志
 func WrapGDPLogger(ctx context.Context) *Tracer {
 	// 从context中获取tracer
@@ -4,9 +4,7 @@ import (
 	"context"
 	"errors"
 	"log"
-	"os"
-	"os/signal"
-	"syscall"
+	"sync"
 	"time"
 
 	"github.com/segmentio/kafka-go"
@@ -20,8 +18,11 @@ type LogData struct {
 	Data []byte
 }
 type KafkaWriter struct {
-	w    *kafka.Writer
-	data chan LogData
+	w         *kafka.Writer
+	data      chan LogData
+	closeCh   chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
 }
 
 func InitLogWriter(kkAddr string) {
@@ -30,8 +31,10 @@ func InitLogWriter(kkAddr string) {
 		Balancer: &kafka.LeastBytes{},
 	}
 	k := &KafkaWriter{
-		w:    w,
-		data: make(chan LogData, 100),
+		w:       w,
+		data:    make(chan LogData, 100),
+		closeCh: make(chan struct{}),
+		done:    make(chan struct{}),
 	}
 
 	go k.sendKafka()
@@ -43,73 +46,99 @@ func GetLogWriter() *KafkaWriter {
 	return globalKafkaWriter
 }
 
+// Send 发送日志，关闭后丢弃，避免关闭过程中写日志阻塞
 func (w *KafkaWriter) Send(data LogData) {
-	w.data <- data
+	select {
+	case <-w.closeCh:
+	case w.data <- data:
+	}
 }
 
-func (w *KafkaWriter) Close() {
-	if w.w != nil {
-		w.w.Close()
+// Close 停止接收日志，发送完缓冲中的日志后关闭生产者
+func (w *KafkaWriter) Close(ctx context.Context) error {
+	w.closeOnce.Do(func() {
+		close(w.closeCh)
+	})
+	select {
+	case <-w.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
 
 func (w *KafkaWriter) sendKafka() {
+	defer close(w.done)
 	for {
 		select {
 		case data := <-w.data:
-			messages := []kafka.Message{
-				{
-					Topic: data.Topic,
-					Value: data.Data,
-				},
-			}
-			var err error
-			const retries = 3
-			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-			for i := 0; i < retries; i++ {
-				// attempt to create topic prior to publishing the message
-				err = w.w.WriteMessages(ctx, messages...)
-				if err == nil {
-					log.Printf("kafka send writemessage success \n")
-					break
-				} else {
-					log.Printf("kafka send writemessage err %s \n", err.Error())
-				}
-				if errors.Is(err, kafka.LeaderNotAvailable) || errors.Is(err, context.DeadlineExceeded) {
-					time.Sleep(time.Millisecond * 250)
-					continue
-				}
-				if err != nil {
-					log.Printf("kafka send writemessage err %s \n", err.Error())
+			w.write(data)
+		case <-w.closeCh:
+			// 发送剩余日志后关闭
+			for {
+				select {
+				case data := <-w.data:
+					w.write(data)
+				default:
+					if w.w != nil {
+						if err := w.w.Close(); err != nil {
+							log.Printf("kafka writer close err %s \n", err.Error())
+						}
+					}
+					return
 				}
 			}
-			cancel()
 		}
 	}
 
 }
 
-// RegisterShutdownHook 注册程序退出钩子，释放资源
-func RegisterShutdownHook() {
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+func (w *KafkaWriter) write(data LogData) {
+	messages := []kafka.Message{
+		{
+			Topic: data.Topic,
+			Value: data.Data,
+		},
+	}
+	var err error
+	const retries = 3
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for i := 0; i < retries; i++ {
+		// attempt to create topic prior to publishing the message
+		err = w.w.WriteMessages(ctx, messages...)
+		if err == nil {
+			log.Printf("kafka send writemessage success \n")
+			break
+		} else {
+			log.Printf("kafka send writemessage err %s \n", err.Error())
+		}
+		if errors.Is(err, kafka.LeaderNotAvailable) || errors.Is(err, context.DeadlineExceeded) {
+			time.Sleep(time.Millisecond * 250)
+			continue
+		}
+		if err != nil {
+			log.Printf("kafka send writemessage err %s \n", err.Error())
+		}
+	}
+}
 
-	go func() {
-		<-sigChan
-		log.Println("收到退出信号，开始释放资源...")
+// Close 释放资源：刷新并关闭 Kafka 生产者，关闭 Kafka 消费者
+func Close(ctx context.Context) error {
+	var errs []error
 
-		// 关闭 Kafka 生产者
-		if globalKafkaWriter != nil {
-			globalKafkaWriter.Close()
+	if globalKafkaWriter != nil {
+		if err := globalKafkaWriter.Close(ctx); err != nil {
+			errs = append(errs, err)
+		} else {
 			log.Println("Kafka 生产者已关闭")
 		}
+	}
 
-		// 关闭 Kafka 消费者
-		if globalKafkaReader != nil {
-			globalKafkaReader.Close()
-			log.Println("Kafka 消费者已关闭")
-		}
+	if globalKafkaReader != nil {
+		globalKafkaReader.Close()
+		log.Println("Kafka 消费者已关闭")
+	}
 
-		os.Exit(0)
-	}()
+	return errors.Join(errs...)
 }
@@ -0,0 +1,80 @@
+package conf
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// 配置来源
+const (
+	SourceDefault  = "default"
+	SourceFile     = "file"
+	SourceRemote   = "remote"
+	SourceEnv      = "env"
+	SourceFlag     = "flag"
+	SourceSecret   = "secret"
+	SourceValidate = "validate"
+)
+
+var (
+	ErrRequired     = errors.New("is required")
+	ErrInvalidValue = errors.New("invalid value")
+	ErrOutOfRange   = errors.New("out of range")
+	ErrUnknownField = errors.New("unknown config field")
+)
+
+// FieldError 单个配置项错误
+type FieldError struct {
+	Field  string // 配置路径，如 grpc.addr
+	Source string // 出错的配置来源
+	Key    string // 来源中的键，如环境变量名 USER_SERVICE_GRPC_ADDR
+	Value  string // 出错的值
+	Err    error
+}
+
+func (e *FieldError) Error() string {
+	var b strings.Builder
+	b.WriteString(e.Field)
+	if e.Key != "" && e.Key != e.Field {
+		fmt.Fprintf(&b, " (%s %s)", e.Source, e.Key)
+	} else if e.Source != "" && e.Source != SourceValidate {
+		fmt.Fprintf(&b, " (%s)", e.Source)
+	}
+	if e.Value != "" {
+		fmt.Fprintf(&b, " %q", e.Value)
+	}
+	fmt.Fprintf(&b, ": %v", e.Err)
+	return b.String()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Errors 多个配置项错误
+type Errors []*FieldError
+
+func (es Errors) Error() string {
+	msgs := make([]string, 0, len(es))
+	for _, e := range es {
+		msgs = append(msgs, e.Error())
+	}
+	return "invalid config: " + strings.Join(msgs, "; ")
+}
+
+func (es Errors) Unwrap() []error {
+	errs := make([]error, 0, len(es))
+	for _, e := range es {
+		errs = append(errs, e)
+	}
+	return errs
+}
+
+// err 没有错误时返回 nil，避免返回带类型的 nil
+func (es Errors) err() error {
+	if len(es) == 0 {
+		return nil
+	}
+	return es
+}
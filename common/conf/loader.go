@@ -0,0 +1,244 @@
+package conf
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"common/secrets"
+
+	"github.com/BurntSushi/toml"
+)
+
+// flagConfig 指定配置文件路径的命令行参数
+const flagConfig = "config"
+
+// Options 加载选项
+type Options struct {
+	// Service 服务名，用作环境变量前缀，如 user_service -> USER_SERVICE_GRPC_ADDR
+	Service string
+	// File TOML 配置文件路径，可被命令行参数 -config 覆盖
+	File string
+	// Args 命令行参数，nil 时使用 os.Args[1:]
+	Args []string
+	// Remote 远程配置（如 etcd），配置路径 -> 值，覆盖文件中的值
+	Remote map[string]string
+}
+
+// resolve 填充默认命令行参数，并以 -config 参数覆盖配置文件路径
+func (o Options) resolve() Options {
+	if o.Args == nil {
+		o.Args = os.Args[1:]
+	}
+	if file, ok := lookupFlag(o.Args, flagConfig); ok {
+		o.File = file
+	}
+	return o
+}
+
+// Load 按 默认值 -> TOML 文件 -> 远程配置 -> 环境变量 -> 命令行参数 的顺序加载配置，后者覆盖前者
+//
+// 默认值来自字段的 default 标签；环境变量为 {SERVICE}_{SECTION}_{KEY}，如 USER_SERVICE_MYSQL_MASTER_PASSWORD；
+// 命令行参数为 -section.key=value，如 -grpc.addr=127.0.0.1:8882；切片用逗号分隔。
+// 合并完成后解析 secret:// 引用，若 dst 实现了 Validator 则进行校验，错误类型为 Errors
+func Load(dst any, opts Options) error {
+	opts = opts.resolve()
+
+	var errs Errors
+	if err := walk(dst, func(f field) error {
+		if f.def == "" || !f.value.IsZero() {
+			return nil
+		}
+		if err := f.set(f.def); err != nil {
+			errs = append(errs, &FieldError{Field: f.path(), Source: SourceDefault, Value: f.def, Err: err})
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	if opts.File != "" {
+		if _, err := toml.DecodeFile(opts.File, dst); err != nil {
+			return fmt.Errorf("load config file %s failed: %w", opts.File, err)
+		}
+	}
+
+	if err := loadRemote(dst, opts.Remote); err != nil {
+		return err
+	}
+	if err := loadEnv(dst, opts.Service); err != nil {
+		return err
+	}
+	if err := loadFlags(dst, opts.Args); err != nil {
+		return err
+	}
+	if err := resolveSecrets(dst); err != nil {
+		return err
+	}
+
+	if v, ok := dst.(Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// EnvName 配置项对应的环境变量名
+func EnvName(service string, path ...string) string {
+	parts := make([]string, 0, len(path)+1)
+	if service != "" {
+		parts = append(parts, service)
+	}
+	parts = append(parts, path...)
+	name := strings.ToUpper(strings.Join(parts, "_"))
+	return strings.NewReplacer(".", "_", "-", "_").Replace(name)
+}
+
+// loadRemote 使用远程配置覆盖，未知的配置路径视为错误
+func loadRemote(dst any, remote map[string]string) error {
+	if len(remote) == 0 {
+		return nil
+	}
+
+	var errs Errors
+	used := make(map[string]bool, len(remote))
+	err := walk(dst, func(f field) error {
+		s, ok := remote[f.path()]
+		if !ok {
+			return nil
+		}
+		used[f.path()] = true
+		if err := f.set(s); err != nil {
+			errs = append(errs, &FieldError{Field: f.path(), Source: SourceRemote, Value: s, Err: err})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for key, s := range remote {
+		if !used[key] {
+			errs = append(errs, &FieldError{Field: key, Source: SourceRemote, Value: s, Err: ErrUnknownField})
+		}
+	}
+	return errs.err()
+}
+
+// loadEnv 使用环境变量覆盖配置
+func loadEnv(dst any, service string) error {
+	var errs Errors
+	err := walk(dst, func(f field) error {
+		key := EnvName(service, f.keys...)
+		s, ok := os.LookupEnv(key)
+		if !ok {
+			return nil
+		}
+		if err := f.set(s); err != nil {
+			errs = append(errs, &FieldError{Field: f.path(), Source: SourceEnv, Key: key, Value: s, Err: err})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return errs.err()
+}
+
+// loadFlags 使用命令行参数覆盖配置
+func loadFlags(dst any, args []string) error {
+	fs := flag.NewFlagSet("config", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.String(flagConfig, "", "config file path")
+
+	var errs Errors
+	err := walk(dst, func(f field) error {
+		fs.Func(f.path(), "", func(s string) error {
+			if err := f.set(s); err != nil {
+				errs = append(errs, &FieldError{Field: f.path(), Source: SourceFlag, Value: s, Err: err})
+			}
+			return nil
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			fs.SetOutput(os.Stderr)
+			fs.PrintDefaults()
+		}
+		return fmt.Errorf("parse flags failed: %w", err)
+	}
+	return errs.err()
+}
+
+// SecretProvider 由配置自身提供密钥来源，未实现时从环境变量读取
+type SecretProvider interface {
+	SecretProvider() (secrets.Provider, error)
+}
+
+// resolveSecrets 将字符串配置中的 secret:// 引用替换为密钥
+func resolveSecrets(dst any) error {
+	var refs []field
+	err := walk(dst, func(f field) error {
+		if f.value.Kind() == reflect.String && secrets.IsRef(f.value.String()) {
+			refs = append(refs, f)
+		}
+		return nil
+	})
+	if err != nil || len(refs) == 0 {
+		return err
+	}
+
+	var provider secrets.Provider
+	if sp, ok := dst.(SecretProvider); ok {
+		provider, err = sp.SecretProvider()
+	} else {
+		provider, err = secrets.New(secrets.Config{})
+	}
+	if err != nil {
+		return err
+	}
+
+	var errs Errors
+	for _, f := range refs {
+		ref := f.value.String()
+		value, err := secrets.Resolve(context.Background(), provider, ref)
+		if err != nil {
+			errs = append(errs, &FieldError{Field: f.path(), Source: SourceSecret, Value: ref, Err: err})
+			continue
+		}
+		f.value.SetString(value)
+		f.sync()
+	}
+	return errs.err()
+}
+
+// lookupFlag 在解析全部参数前查找指定参数，支持 -name=v、-name v 及双横线形式
+func lookupFlag(args []string, name string) (string, bool) {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		trimmed := strings.TrimLeft(arg, "-")
+		if trimmed == arg || len(arg)-len(trimmed) > 2 {
+			continue
+		}
+		if trimmed == name && i+1 < len(args) {
+			return args[i+1], true
+		}
+		if v, ok := strings.CutPrefix(trimmed, name+"="); ok {
+			return v, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,249 @@
+package conf
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testDB struct {
+	Host     string `toml:"host"`
+	Password string `toml:"password"`
+}
+
+type testConfig struct {
+	Name    string            `toml:"name" default:"default"`
+	Port    int               `toml:"port" default:"80"`
+	Timeout time.Duration     `toml:"timeout" default:"1s"`
+	Tags    []string          `toml:"tags"`
+	Labels  map[string]string `toml:"labels"`
+	MySQL   struct {
+		Master testDB   `toml:"master"`
+		Slaves []testDB `toml:"slaves"`
+	} `toml:"mysql"`
+}
+
+func (c *testConfig) Validate() error {
+	var v Validation
+	v.Required("name", c.Name)
+	v.Range("port", c.Port, 1, 65535)
+	return v.Err()
+}
+
+const testService = "conf_test"
+
+func writeTOML(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	tests := []struct {
+		name       string
+		file       string
+		remote     map[string]string
+		env        map[string]string
+		args       []string
+		wantName   string
+		wantPort   int
+		wantSource string // 期望出错的来源，为空表示成功
+	}{
+		{name: "defaults", wantName: "default", wantPort: 80},
+		{name: "file over default", file: "name = \"file\"\nport = 81", wantName: "file", wantPort: 81},
+		{name: "default fills missing key", file: "name = \"file\"", wantName: "file", wantPort: 80},
+		{
+			name:     "remote over file",
+			file:     "name = \"file\"\nport = 81",
+			remote:   map[string]string{"name": "remote"},
+			wantName: "remote", wantPort: 81,
+		},
+		{
+			name:     "env over remote",
+			file:     "name = \"file\"",
+			remote:   map[string]string{"name": "remote", "port": "82"},
+			env:      map[string]string{"CONF_TEST_NAME": "env"},
+			wantName: "env", wantPort: 82,
+		},
+		{
+			name:     "flag over env",
+			file:     "name = \"file\"",
+			remote:   map[string]string{"name": "remote"},
+			env:      map[string]string{"CONF_TEST_NAME": "env", "CONF_TEST_PORT": "83"},
+			args:     []string{"-name=flag"},
+			wantName: "flag", wantPort: 83,
+		},
+		{
+			name:     "secret resolved after flag",
+			env:      map[string]string{"CONF_TEST_NAME": "env", "SECRET_CONF_NAME": "from-secret"},
+			args:     []string{"-name", "secret://conf/name"},
+			wantName: "from-secret", wantPort: 80,
+		},
+		{
+			name:       "validate after secret",
+			env:        map[string]string{"SECRET_CONF_EMPTY": ""},
+			args:       []string{"-name=secret://conf/empty"},
+			wantSource: SourceValidate,
+		},
+		{
+			name:     "validate sees merged value",
+			file:     "port = 0",
+			args:     []string{"-port=8080"},
+			wantName: "default", wantPort: 8080,
+		},
+		{name: "validate fails", file: "port = 0", wantSource: SourceValidate},
+		{name: "missing secret", args: []string{"-name=secret://conf/missing"}, wantSource: SourceSecret},
+		{name: "bad remote value", remote: map[string]string{"port": "x"}, wantSource: SourceRemote},
+		{name: "unknown remote key", remote: map[string]string{"nope": "1"}, wantSource: SourceRemote},
+		{name: "bad env value", env: map[string]string{"CONF_TEST_PORT": "x"}, wantSource: SourceEnv},
+		{name: "bad flag value", args: []string{"-port=x"}, wantSource: SourceFlag},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			opts := Options{Service: testService, Remote: tt.remote, Args: tt.args}
+			if opts.Args == nil {
+				opts.Args = []string{}
+			}
+			if tt.file != "" {
+				opts.File = writeTOML(t, tt.file)
+			}
+
+			var c testConfig
+			err := Load(&c, opts)
+			if tt.wantSource != "" {
+				var es Errors
+				if !errors.As(err, &es) || es[0].Source != tt.wantSource {
+					t.Fatalf("Load() error = %v, want error from %s", err, tt.wantSource)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() error: %v", err)
+			}
+			if c.Name != tt.wantName || c.Port != tt.wantPort {
+				t.Errorf("got name=%q port=%d, want name=%q port=%d", c.Name, c.Port, tt.wantName, tt.wantPort)
+			}
+		})
+	}
+}
+
+func TestEnvName(t *testing.T) {
+	tests := []struct {
+		service string
+		path    []string
+		want    string
+	}{
+		{"user_service", []string{"grpc", "addr"}, "USER_SERVICE_GRPC_ADDR"},
+		{"user_service", []string{"mysql", "master", "password"}, "USER_SERVICE_MYSQL_MASTER_PASSWORD"},
+		{"user_service", []string{"mysql", "slaves", "0", "host"}, "USER_SERVICE_MYSQL_SLAVES_0_HOST"},
+		{"api-gateway", []string{"jwt.access_secret"}, "API_GATEWAY_JWT_ACCESS_SECRET"},
+		{"", []string{"port"}, "PORT"},
+	}
+	for _, tt := range tests {
+		if got := EnvName(tt.service, tt.path...); got != tt.want {
+			t.Errorf("EnvName(%q, %v) = %q, want %q", tt.service, tt.path, got, tt.want)
+		}
+	}
+}
+
+const nestedTOML = `
+tags = ["a"]
+
+[labels]
+region = "file"
+
+[mysql.master]
+host = "master"
+
+[[mysql.slaves]]
+host = "slave0"
+
+[[mysql.slaves]]
+host = "slave1"
+`
+
+// TestLoadKeyMapping 嵌套结构体、切片下标、map 键和切片值的环境变量及命令行参数
+func TestLoadKeyMapping(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		args []string
+		get  func(c *testConfig) any
+		want any
+	}{
+		{"env nested", map[string]string{"CONF_TEST_MYSQL_MASTER_PASSWORD": "pw"}, nil,
+			func(c *testConfig) any { return c.MySQL.Master.Password }, "pw"},
+		{"env slice index", map[string]string{"CONF_TEST_MYSQL_SLAVES_0_HOST": "env0"}, nil,
+			func(c *testConfig) any { return []string{c.MySQL.Slaves[0].Host, c.MySQL.Slaves[1].Host} }, []string{"env0", "slave1"}},
+		{"flag slice index", nil, []string{"-mysql.slaves.1.host=flag1"},
+			func(c *testConfig) any { return []string{c.MySQL.Slaves[0].Host, c.MySQL.Slaves[1].Host} }, []string{"slave0", "flag1"}},
+		{"env map key", map[string]string{"CONF_TEST_LABELS_REGION": "env"}, nil,
+			func(c *testConfig) any { return c.Labels["region"] }, "env"},
+		{"flag map key", nil, []string{"-labels.region=flag"},
+			func(c *testConfig) any { return c.Labels["region"] }, "flag"},
+		{"env slice value", map[string]string{"CONF_TEST_TAGS": "x, y"}, nil,
+			func(c *testConfig) any { return c.Tags }, []string{"x", "y"}},
+		{"flag empty slice", nil, []string{"-tags="},
+			func(c *testConfig) any { return c.Tags }, []string{}},
+		{"flag duration", nil, []string{"--timeout", "3s"},
+			func(c *testConfig) any { return c.Timeout }, 3 * time.Second},
+	}
+	file := writeTOML(t, nestedTOML)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			args := tt.args
+			if args == nil {
+				args = []string{}
+			}
+			var c testConfig
+			if err := Load(&c, Options{Service: testService, File: file, Args: args}); err != nil {
+				t.Fatalf("Load() error: %v", err)
+			}
+			if got := tt.get(&c); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadConfigFlag(t *testing.T) {
+	file := writeTOML(t, "name = \"from-flag-file\"")
+	for _, args := range [][]string{
+		{"-config", file},
+		{"--config=" + file},
+	} {
+		var c testConfig
+		if err := Load(&c, Options{Service: testService, File: "missing.toml", Args: args}); err != nil {
+			t.Fatalf("Load(%v) error: %v", args, err)
+		}
+		if c.Name != "from-flag-file" {
+			t.Errorf("Load(%v) name = %q, want from-flag-file", args, c.Name)
+		}
+	}
+}
+
+func TestLoadEnvErrorKey(t *testing.T) {
+	t.Setenv("CONF_TEST_MYSQL_SLAVES_0_HOST", "ok")
+	t.Setenv("CONF_TEST_PORT", "x")
+	var c testConfig
+	err := Load(&c, Options{Service: testService, File: writeTOML(t, nestedTOML), Args: []string{}})
+	var es Errors
+	if !errors.As(err, &es) || len(es) != 1 {
+		t.Fatalf("Load() error = %v, want one field error", err)
+	}
+	if es[0].Field != "port" || es[0].Key != "CONF_TEST_PORT" || !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("field error = %+v", es[0])
+	}
+}
@@ -0,0 +1,145 @@
+package conf
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	// fileDebounce 编辑器保存时会产生多个事件，合并后再重新加载
+	fileDebounce = 500 * time.Millisecond
+	// etcdTimeout 读取 etcd 配置的超时
+	etcdTimeout = 3 * time.Second
+)
+
+// WatchConfig 动态配置
+type WatchConfig struct {
+	WatchFile  bool   `toml:"watch_file"`  // 监听本地配置文件变化
+	EtcdPrefix string `toml:"etcd_prefix"` // etcd 配置前缀，如 /config/user_service/，为空不启用
+}
+
+// etcdSource etcd 配置源，键为 {prefix}{section}/{key}，如 /config/user_service/grpc/weight
+type etcdSource struct {
+	cli    *clientv3.Client
+	prefix string
+}
+
+func newEtcdSource(addrs []string, prefix string) (*etcdSource, error) {
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:   addrs,
+		DialTimeout: etcdTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return &etcdSource{cli: cli, prefix: prefix}, nil
+}
+
+// load 读取前缀下所有配置，返回 配置路径 -> 值
+func (s *etcdSource) load(ctx context.Context) (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, etcdTimeout)
+	defer cancel()
+
+	resp, err := s.cli.Get(ctx, s.prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("load config from etcd %s failed: %w", s.prefix, err)
+	}
+	values := make(map[string]string, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		key := strings.Trim(strings.TrimPrefix(string(kv.Key), s.prefix), "/")
+		if key == "" {
+			continue
+		}
+		values[strings.ReplaceAll(key, "/", ".")] = string(kv.Value)
+	}
+	return values, nil
+}
+
+// watch 监听前缀下的变化，watch 中断时稍后重新监听
+func (s *etcdSource) watch(ctx context.Context, notify func()) {
+	for {
+		watchCh := s.cli.Watch(ctx, s.prefix, clientv3.WithPrefix())
+		for res := range watchCh {
+			if res.Err() != nil {
+				break
+			}
+			notify()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+		// 重新监听前全量同步一次，避免遗漏中断期间的变化
+		notify()
+	}
+}
+
+func (s *etcdSource) close() error {
+	return s.cli.Close()
+}
+
+// fileSource 本地配置文件，监听所在目录以兼容编辑器先删除再重命名的保存方式
+type fileSource struct {
+	path    string
+	watcher *fsnotify.Watcher
+}
+
+func newFileSource(path string) (*fileSource, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		_ = watcher.Close()
+		return nil, fmt.Errorf("watch config file %s failed: %w", path, err)
+	}
+	return &fileSource{path: path, watcher: watcher}, nil
+}
+
+// watch 文件变化时通知，短时间内的多次变化合并为一次
+func (s *fileSource) watch(ctx context.Context, notify func(), onError func(error)) {
+	timer := time.NewTimer(fileDebounce)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-s.watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(ev.Name) != s.path || ev.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+			timer.Reset(fileDebounce)
+		case err, ok := <-s.watcher.Errors:
+			if !ok {
+				return
+			}
+			onError(err)
+		case <-timer.C:
+			notify()
+		}
+	}
+}
+
+func (s *fileSource) close() error {
+	return s.watcher.Close()
+}
@@ -0,0 +1,84 @@
+package conf
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// Validator 配置校验，Load 在合并所有来源后调用
+type Validator interface {
+	Validate() error
+}
+
+// Validation 收集校验错误
+//
+//	var v conf.Validation
+//	v.Required("grpc.addr", c.Grpc.Addr)
+//	v.OneOf("app_log.split", c.AppLog.Split, "h", "d", "m", "w")
+//	return v.Err()
+type Validation struct {
+	errs Errors
+}
+
+// Add 添加错误
+func (v *Validation) Add(field, value string, err error) {
+	v.errs = append(v.errs, &FieldError{Field: field, Source: SourceValidate, Value: value, Err: err})
+}
+
+// Required 值不能为空（空字符串、零值、空切片/map）
+func (v *Validation) Required(field string, value any) {
+	rv := reflect.ValueOf(value)
+	switch {
+	case !rv.IsValid(), rv.IsZero():
+		v.Add(field, "", ErrRequired)
+	case rv.Kind() == reflect.Slice || rv.Kind() == reflect.Map:
+		if rv.Len() == 0 {
+			v.Add(field, "", ErrRequired)
+		}
+	}
+}
+
+// OneOf 值必须是给定值之一
+func (v *Validation) OneOf(field, value string, allowed ...string) {
+	if !slices.Contains(allowed, value) {
+		v.Add(field, value, fmt.Errorf("%w, must be one of %v", ErrInvalidValue, allowed))
+	}
+}
+
+// Range 值必须在 [min, max] 之间
+func (v *Validation) Range(field string, value, min, max int) {
+	if value < min || value > max {
+		v.Add(field, fmt.Sprint(value), fmt.Errorf("%w, must be in [%d, %d]", ErrOutOfRange, min, max))
+	}
+}
+
+// Check err 不为 nil 时记录为字段错误
+func (v *Validation) Check(field, value string, err error) {
+	if err != nil {
+		v.Add(field, value, fmt.Errorf("%w: %v", ErrInvalidValue, err))
+	}
+}
+
+// Merge 合并子配置的校验结果，字段加上前缀
+func (v *Validation) Merge(prefix string, err error) {
+	if err == nil {
+		return
+	}
+	var es Errors
+	if !errors.As(err, &es) {
+		v.Add(prefix, "", err)
+		return
+	}
+	for _, e := range es {
+		merged := *e
+		merged.Field = prefix + "." + e.Field
+		v.errs = append(v.errs, &merged)
+	}
+}
+
+// Err 返回收集到的错误，没有错误时返回 nil
+func (v *Validation) Err() error {
+	return v.errs.err()
+}
@@ -0,0 +1,169 @@
+package conf
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// field 配置叶子字段
+type field struct {
+	keys  []string      // 配置路径，如 [mysql master password]
+	value reflect.Value // 可写的字段值
+	def   string        // default 标签
+	sync  func()        // 字段位于 map 值中时写回 map
+}
+
+func (f field) path() string {
+	return strings.Join(f.keys, ".")
+}
+
+// set 解析并设置字段值
+func (f field) set(s string) error {
+	if err := setValue(f.value, s); err != nil {
+		return err
+	}
+	f.sync()
+	return nil
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// walk 遍历配置结构体的叶子字段，字段名取 toml 标签；切片中的结构体按下标、map 按键继续遍历
+func walk(dst any, fn func(f field) error) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return errors.New("config must be a pointer to struct")
+	}
+	return walkStruct(v.Elem(), nil, func() {}, fn)
+}
+
+func walkStruct(v reflect.Value, keys []string, sync func(), fn func(f field) error) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(sf.Tag.Get("toml"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(sf.Name)
+		}
+		if err := walkValue(v.Field(i), append(keys[:len(keys):len(keys)], name), sf.Tag.Get("default"), sync, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func walkValue(v reflect.Value, keys []string, def string, sync func(), fn func(f field) error) error {
+	switch {
+	case isLeaf(v.Type()):
+		return fn(field{keys: keys, value: v, def: def, sync: sync})
+	case v.Kind() == reflect.Struct:
+		return walkStruct(v, keys, sync, fn)
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Struct:
+		for i := 0; i < v.Len(); i++ {
+			if err := walkStruct(v.Index(i), append(keys[:len(keys):len(keys)], strconv.Itoa(i)), sync, fn); err != nil {
+				return err
+			}
+		}
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String:
+		iter := v.MapRange()
+		for iter.Next() {
+			// map 的值不可寻址，复制一份，修改后写回
+			key, elem := iter.Key(), reflect.New(v.Type().Elem()).Elem()
+			elem.Set(iter.Value())
+			elemSync := func() {
+				v.SetMapIndex(key, elem)
+				sync()
+			}
+			if err := walkValue(elem, append(keys[:len(keys):len(keys)], key.String()), "", elemSync, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isLeaf 可以由字符串设置的类型
+func isLeaf(t reflect.Type) bool {
+	if t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// setValue 按字段类型解析字符串，切片用逗号分隔
+func setValue(v reflect.Value, s string) error {
+	if v.Kind() == reflect.Slice {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			v.Set(reflect.MakeSlice(v.Type(), 0, 0))
+			return nil
+		}
+		items := strings.Split(s, ",")
+		slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := setValue(slice.Index(i), strings.TrimSpace(item)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+		return nil
+	}
+
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidValue, err)
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("%w: expect bool", ErrInvalidValue)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w: expect integer", ErrInvalidValue)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w: expect unsigned integer", ErrInvalidValue)
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w: expect number", ErrInvalidValue)
+		}
+		v.SetFloat(n)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
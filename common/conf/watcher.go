@@ -0,0 +1,323 @@
+package conf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// masked 审计日志中敏感配置的替代值
+const masked = "******"
+
+// Change 配置项变化，每次热更新为每个变化的配置项生成一条审计记录
+type Change struct {
+	Field   string // 配置路径，如 grpc.weight
+	Old     string // 旧值，敏感配置已脱敏
+	New     string // 新值，敏感配置已脱敏
+	Source  string // 触发来源：file / remote
+	Applied bool   // 是否已在运行时生效，false 表示需要重启
+	Err     error  // 生效失败的原因
+}
+
+func (c Change) String() string {
+	status := "applied"
+	switch {
+	case c.Err != nil:
+		status = "apply failed: " + c.Err.Error()
+	case !c.Applied:
+		status = "restart required"
+	}
+	return fmt.Sprintf("config %s changed by %s: %q -> %q, %s", c.Field, c.Source, c.Old, c.New, status)
+}
+
+type subscription[C any] struct {
+	field   string
+	changed func(old, new *C) bool
+	apply   func(c *C) error
+}
+
+// Watcher 动态配置，监听本地文件或 etcd，变化时重新加载并通知订阅者
+//
+// 重新加载与 Load 的顺序一致，etcd 中的值位于文件之后、环境变量之前；
+// 新配置校验失败时保留旧配置。订阅以外的配置项变化只记录审计日志，需重启生效
+type Watcher[C any] struct {
+	opts     Options
+	reloadMu sync.Mutex // 串行化重新加载
+
+	mu       sync.Mutex
+	current  C
+	subs     []subscription[C]
+	onReload []func(C)
+	audit    func(Change)
+	onError  func(error)
+
+	file *fileSource
+	etcd *etcdSource
+
+	reloadCh chan string
+	ctx      context.Context
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+// NewWatcher 创建动态配置，current 为启动时加载的配置
+func NewWatcher[C any](opts Options, current C) *Watcher[C] {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Watcher[C]{
+		opts:    opts.resolve(),
+		current: current,
+		audit: func(c Change) {
+			log.Print(c.String())
+		},
+		onError: func(err error) {
+			log.Printf("reload config failed: %v", err)
+		},
+		reloadCh: make(chan string, 1),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Subscribe 订阅配置项变化，值变化时调用 fn；field 为配置路径，用于审计日志标记已生效
+func Subscribe[C any, T comparable](w *Watcher[C], field string, get func(c *C) T, fn func(v T) error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.subs = append(w.subs, subscription[C]{
+		field: field,
+		changed: func(old, new *C) bool {
+			return get(old) != get(new)
+		},
+		apply: func(c *C) error {
+			return fn(get(c))
+		},
+	})
+}
+
+// OnReload 配置重新加载后、通知订阅者前回调，用于替换全局配置
+func (w *Watcher[C]) OnReload(fn func(c C)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.onReload = append(w.onReload, fn)
+}
+
+// OnAudit 设置审计日志输出，默认使用标准库 log
+func (w *Watcher[C]) OnAudit(fn func(c Change)) {
+	w.audit = fn
+}
+
+// OnError 设置重新加载失败时的回调，默认使用标准库 log
+func (w *Watcher[C]) OnError(fn func(err error)) {
+	w.onError = fn
+}
+
+// WatchFile 监听本地配置文件
+func (w *Watcher[C]) WatchFile() error {
+	if w.opts.File == "" {
+		return errors.New("config file is not set")
+	}
+	file, err := newFileSource(w.opts.File)
+	if err != nil {
+		return err
+	}
+	w.file = file
+	return nil
+}
+
+// WatchEtcd 监听 etcd 前缀下的配置
+func (w *Watcher[C]) WatchEtcd(addrs []string, prefix string) error {
+	etcd, err := newEtcdSource(addrs, prefix)
+	if err != nil {
+		return err
+	}
+	w.etcd = etcd
+	return nil
+}
+
+// Start 启用 etcd 时先同步一次远程配置，然后在后台监听变化
+func (w *Watcher[C]) Start(ctx context.Context) error {
+	if w.etcd != nil {
+		if err := w.Reload(ctx, SourceRemote); err != nil {
+			return err
+		}
+	}
+
+	w.wg.Add(1)
+	go w.loop()
+
+	if w.file != nil {
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.file.watch(w.ctx, w.notify(SourceFile), w.onError)
+		}()
+	}
+	if w.etcd != nil {
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.etcd.watch(w.ctx, w.notify(SourceRemote))
+		}()
+	}
+	return nil
+}
+
+// Stop 停止监听
+func (w *Watcher[C]) Stop(ctx context.Context) error {
+	w.cancel()
+	w.wg.Wait()
+
+	var errs []error
+	if w.file != nil {
+		errs = append(errs, w.file.close())
+	}
+	if w.etcd != nil {
+		errs = append(errs, w.etcd.close())
+	}
+	return errors.Join(errs...)
+}
+
+// Current 当前配置
+func (w *Watcher[C]) Current() C {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.current
+}
+
+// notify 合并重新加载请求，加载过程中的多次变化只触发一次
+func (w *Watcher[C]) notify(source string) func() {
+	return func() {
+		select {
+		case w.reloadCh <- source:
+		default:
+		}
+	}
+}
+
+// loop 串行处理重新加载
+func (w *Watcher[C]) loop() {
+	defer w.wg.Done()
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case source := <-w.reloadCh:
+			if err := w.Reload(w.ctx, source); err != nil {
+				w.onError(err)
+			}
+		}
+	}
+}
+
+// Reload 重新加载配置，通知变化的订阅者并记录审计日志
+func (w *Watcher[C]) Reload(ctx context.Context, source string) error {
+	w.reloadMu.Lock()
+	defer w.reloadMu.Unlock()
+
+	opts := w.opts
+	if w.etcd != nil {
+		remote, err := w.etcd.load(ctx)
+		if err != nil {
+			return err
+		}
+		opts.Remote = remote
+	}
+
+	var next C
+	if err := Load(&next, opts); err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	old := w.current
+	w.current = next
+	subs := make([]subscription[C], len(w.subs))
+	copy(subs, w.subs)
+	onReload := make([]func(C), len(w.onReload))
+	copy(onReload, w.onReload)
+	w.mu.Unlock()
+
+	changes, err := diff(&old, &next)
+	if err != nil || len(changes) == 0 {
+		return err
+	}
+
+	for _, fn := range onReload {
+		fn(next)
+	}
+
+	applied := make(map[string]error)
+	for _, sub := range subs {
+		if sub.changed(&old, &next) {
+			applied[sub.field] = sub.apply(&next)
+		}
+	}
+
+	for _, c := range changes {
+		c.Source = source
+		if err, ok := applied[c.Field]; ok {
+			c.Applied, c.Err = err == nil, err
+		}
+		w.audit(c)
+	}
+	return nil
+}
+
+// diff 比较两份配置的叶子字段
+func diff(old, new any) ([]Change, error) {
+	oldValues, err := flatten(old)
+	if err != nil {
+		return nil, err
+	}
+	newValues, err := flatten(new)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]struct{}, len(newValues))
+	for k := range oldValues {
+		fields[k] = struct{}{}
+	}
+	for k := range newValues {
+		fields[k] = struct{}{}
+	}
+
+	var changes []Change
+	for field := range fields {
+		o, n := oldValues[field], newValues[field]
+		if o == n {
+			continue
+		}
+		if isSensitive(field) {
+			o, n = masked, masked
+		}
+		changes = append(changes, Change{Field: field, Old: o, New: n})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes, nil
+}
+
+func flatten(c any) (map[string]string, error) {
+	values := make(map[string]string)
+	err := walk(c, func(f field) error {
+		values[f.path()] = fmt.Sprint(f.value.Interface())
+		return nil
+	})
+	return values, err
+}
+
+// isSensitive 密码、密钥类配置不输出原值，如 jwt.keys.0.private_key 可以直接配置 PEM 私钥
+func isSensitive(field string) bool {
+	key := strings.ToLower(field[strings.LastIndex(field, ".")+1:])
+	for _, word := range []string{"password", "secret", "api_key", "token", "private_key"} {
+		if strings.Contains(key, word) {
+			return true
+		}
+	}
+	return false
+}
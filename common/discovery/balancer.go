@@ -0,0 +1,199 @@
+package discovery
+
+import (
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	_ "google.golang.org/grpc/health" // 注册客户端健康检查
+	"google.golang.org/grpc/resolver"
+)
+
+const (
+	// WeightRoundRobin 平滑加权轮询负载均衡器名称
+	WeightRoundRobin = "smooth_weighted_round_robin"
+
+	weightAttrKey = "weight"
+	defaultWeight = 1
+)
+
+// WeightRoundRobinServiceConfig 通过 service config 选择加权轮询负载均衡器，并开启客户端健康检查（grpc.health.v1）
+var WeightRoundRobinServiceConfig = fmt.Sprintf(`{"loadBalancingConfig":[{"%s":{}}],"healthCheckConfig":{"serviceName":""}}`, WeightRoundRobin)
+
+func init() {
+	balancer.Register(newWeightRoundRobinBuilder())
+}
+
+// newWeightRoundRobinBuilder 基于 base balancer 创建加权轮询构建器
+// 权重保存在 resolver.Address.BalancerAttributes 中，权重变化不会重建连接
+func newWeightRoundRobinBuilder() balancer.Builder {
+	return &weightBalancerBuilder{}
+}
+
+type weightBalancerBuilder struct{}
+
+func (*weightBalancerBuilder) Build(cc balancer.ClientConn, opts balancer.BuildOptions) balancer.Balancer {
+	pb := &weightPickerBuilder{}
+	return &weightBalancer{
+		Balancer: base.NewBalancerBuilder(WeightRoundRobin, pb, base.Config{HealthCheck: true}).Build(cc, opts),
+		pb:       pb,
+	}
+}
+
+func (*weightBalancerBuilder) Name() string {
+	return WeightRoundRobin
+}
+
+// weightBalancer 包装 base balancer，记录解析结果中最新的地址。
+// base balancer 按地址复用 SubConn 时保留的是首次出现的地址，其中的 BalancerAttributes 不会更新
+type weightBalancer struct {
+	balancer.Balancer
+	pb *weightPickerBuilder
+}
+
+// UpdateClientConnState 先记录最新的地址，base balancer 随后重建 picker 时使用最新的权重
+func (b *weightBalancer) UpdateClientConnState(s balancer.ClientConnState) error {
+	b.pb.setAddresses(s.ResolverState.Addresses)
+	return b.Balancer.UpdateClientConnState(s)
+}
+
+type weightPickerBuilder struct {
+	mu    sync.Mutex
+	addrs map[string]resolver.Address
+}
+
+func (b *weightPickerBuilder) setAddresses(addrs []resolver.Address) {
+	m := make(map[string]resolver.Address, len(addrs))
+	for _, a := range addrs {
+		m[a.Addr] = a
+	}
+	b.mu.Lock()
+	b.addrs = m
+	b.mu.Unlock()
+}
+
+// latest 获取地址最新的属性，没有记录时使用 SubConn 创建时的地址
+func (b *weightPickerBuilder) latest(addr resolver.Address) resolver.Address {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if a, ok := b.addrs[addr.Addr]; ok {
+		return a
+	}
+	return addr
+}
+
+// Build 根据就绪的 SubConn 及其权重构建 picker
+// 地址上带有版本流量百分比时，先按百分比选择版本，再在版本内按节点权重选择
+func (b *weightPickerBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+
+	groupIdx := make(map[string]int)
+	groups := make([]*versionGroup, 0)
+	percents := make([]int, 0)
+	for sc, scInfo := range info.ReadySCs {
+		addr := b.latest(scInfo.Address)
+		// 未启用灰度时所有节点为同一组
+		version, percent := "", defaultWeight
+		if p := getPercent(addr); p >= 0 {
+			if p == 0 {
+				// 灰度流量为0的版本不参与选择
+				continue
+			}
+			version, percent = GetVersion(addr), p
+		}
+
+		idx, ok := groupIdx[version]
+		if !ok {
+			idx = len(groups)
+			groupIdx[version] = idx
+			groups = append(groups, &versionGroup{version: version})
+			percents = append(percents, percent)
+		}
+		groups[idx].scs = append(groups[idx].scs, sc)
+		groups[idx].weights = append(groups[idx].weights, GetWeight(addr))
+	}
+	if len(groups) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+
+	for _, g := range groups {
+		g.weighter = newSmoothWeighter(g.weights)
+	}
+	return &weightPicker{
+		versions: newSmoothWeighter(percents),
+		groups:   groups,
+	}
+}
+
+// versionGroup 同一版本的节点
+type versionGroup struct {
+	version  string
+	scs      []balancer.SubConn
+	weights  []int
+	weighter *smoothWeighter
+}
+
+// weightPicker 平滑加权轮询 picker
+type weightPicker struct {
+	mu       sync.Mutex
+	versions *smoothWeighter // 版本间按流量百分比选择
+	groups   []*versionGroup
+}
+
+// Pick 选择一个 SubConn
+func (p *weightPicker) Pick(balancer.PickInfo) (balancer.PickResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	g := p.groups[0]
+	if len(p.groups) > 1 {
+		g = p.groups[p.versions.next()]
+	}
+	return balancer.PickResult{SubConn: g.scs[g.weighter.next()]}, nil
+}
+
+// smoothWeighter 平滑加权轮询（nginx smooth weighted round-robin）
+type smoothWeighter struct {
+	weights        []int // 配置权重
+	currentWeights []int // 当前权重
+	totalWeight    int
+}
+
+func newSmoothWeighter(weights []int) *smoothWeighter {
+	w := &smoothWeighter{
+		weights:        weights,
+		currentWeights: make([]int, len(weights)),
+	}
+	for _, weight := range weights {
+		w.totalWeight += weight
+	}
+	return w
+}
+
+// next 每次选择时所有节点当前权重加上配置权重，选出当前权重最大的节点，并将其减去总权重
+func (w *smoothWeighter) next() int {
+	best := -1
+	for i := range w.weights {
+		w.currentWeights[i] += w.weights[i]
+		if best == -1 || w.currentWeights[i] > w.currentWeights[best] {
+			best = i
+		}
+	}
+	w.currentWeights[best] -= w.totalWeight
+	return best
+}
+
+// GetWeight 获取地址上的权重，未设置或非法时返回默认权重
+func GetWeight(addr resolver.Address) int {
+	if addr.BalancerAttributes == nil {
+		return defaultWeight
+	}
+	weight, ok := addr.BalancerAttributes.Value(weightAttrKey).(int)
+	if !ok || weight <= 0 {
+		return defaultWeight
+	}
+	return weight
+}
@@ -0,0 +1,140 @@
+package discovery
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/attributes"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/resolver"
+)
+
+func TestSmoothWeighterNext(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights []int
+		want    []int
+	}{
+		{"5/1/1", []int{5, 1, 1}, []int{0, 0, 1, 0, 2, 0, 0, 0, 0, 1, 0, 2, 0, 0}},
+		{"equal", []int{1, 1, 1}, []int{0, 1, 2, 0, 1, 2}},
+		{"2/1", []int{2, 1}, []int{0, 1, 0, 0, 1, 0}},
+		{"single", []int{3}, []int{0, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := newSmoothWeighter(tt.weights)
+			for i, want := range tt.want {
+				if got := w.next(); got != want {
+					t.Fatalf("pick %d = %d, want %d", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetWeight(t *testing.T) {
+	tests := []struct {
+		name string
+		addr resolver.Address
+		want int
+	}{
+		{"absent", resolver.Address{Addr: "a"}, defaultWeight},
+		{"zero", resolver.Address{Addr: "a", BalancerAttributes: attributes.New(weightAttrKey, 0)}, defaultWeight},
+		{"negative", resolver.Address{Addr: "a", BalancerAttributes: attributes.New(weightAttrKey, -3)}, defaultWeight},
+		{"wrong type", resolver.Address{Addr: "a", BalancerAttributes: attributes.New(weightAttrKey, "5")}, defaultWeight},
+		{"set", BuildAddress(Server{Addr: "a", Weight: 5}), 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetWeight(tt.addr); got != tt.want {
+				t.Errorf("GetWeight() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildAddressKeepsAttributesEmpty(t *testing.T) {
+	a := BuildAddress(Server{Addr: "127.0.0.1:1", Weight: 1, Version: "1.0.0"})
+	b := BuildAddress(Server{Addr: "127.0.0.1:1", Weight: 9, Version: "1.0.0"})
+	// Attributes 参与 SubConn 的地址比较，权重变化不能影响
+	if a.Attributes != nil || b.Attributes != nil {
+		t.Fatalf("weight and version must not be stored in Attributes")
+	}
+	if a.Equal(b) {
+		t.Fatalf("addresses with different weight should not be equal for resolver updates")
+	}
+	if GetVersion(a) != "1.0.0" {
+		t.Errorf("GetVersion() = %q, want 1.0.0", GetVersion(a))
+	}
+}
+
+type fakeSubConn struct {
+	balancer.SubConn
+	name string
+}
+
+// pickCounts 按 picker 选择 n 次，统计每个 SubConn 被选中的次数
+func pickCounts(t *testing.T, p balancer.Picker, n int) map[string]int {
+	t.Helper()
+	counts := make(map[string]int)
+	for i := 0; i < n; i++ {
+		res, err := p.Pick(balancer.PickInfo{})
+		if err != nil {
+			t.Fatalf("Pick() error: %v", err)
+		}
+		counts[res.SubConn.(*fakeSubConn).name]++
+	}
+	return counts
+}
+
+func TestWeightPickerBuild(t *testing.T) {
+	a, b := &fakeSubConn{name: "a"}, &fakeSubConn{name: "b"}
+	addrA := BuildAddress(Server{Addr: "a", Weight: 3})
+	addrB := BuildAddress(Server{Addr: "b"})
+	pb := &weightPickerBuilder{}
+	info := base.PickerBuildInfo{ReadySCs: map[balancer.SubConn]base.SubConnInfo{
+		a: {Address: addrA},
+		b: {Address: addrB},
+	}}
+
+	if got := pickCounts(t, pb.Build(info), 8); got["a"] != 6 || got["b"] != 2 {
+		t.Errorf("weights 3/absent picked %v, want a=6 b=2", got)
+	}
+
+	// base balancer 保留 SubConn 创建时的地址，权重变化通过最新的解析结果生效
+	pb.setAddresses([]resolver.Address{BuildAddress(Server{Addr: "a", Weight: 1}), BuildAddress(Server{Addr: "b", Weight: 3})})
+	if got := pickCounts(t, pb.Build(info), 8); got["a"] != 2 || got["b"] != 6 {
+		t.Errorf("updated weights 1/3 picked %v, want a=2 b=6", got)
+	}
+
+	if _, err := pb.Build(base.PickerBuildInfo{}).Pick(balancer.PickInfo{}); err != balancer.ErrNoSubConnAvailable {
+		t.Errorf("empty picker error = %v, want ErrNoSubConnAvailable", err)
+	}
+}
+
+func TestWeightPickerBuildVersionPercent(t *testing.T) {
+	selector, err := ParseVersionSelector("1.0.0:75,1.1.0:25")
+	if err != nil {
+		t.Fatal(err)
+	}
+	old1, old2, canary := &fakeSubConn{name: "old1"}, &fakeSubConn{name: "old2"}, &fakeSubConn{name: "canary"}
+	info := base.PickerBuildInfo{ReadySCs: map[balancer.SubConn]base.SubConnInfo{
+		old1:   {Address: selector.buildAddress(Server{Addr: "old1", Version: "1.0.0", Weight: 1})},
+		old2:   {Address: selector.buildAddress(Server{Addr: "old2", Version: "1.0.0", Weight: 2})},
+		canary: {Address: selector.buildAddress(Server{Addr: "canary", Version: "1.1.0", Weight: 10})},
+	}}
+	// 先按版本百分比选择，节点权重只在版本内生效
+	got := pickCounts(t, (&weightPickerBuilder{}).Build(info), 400)
+	if got["canary"] != 100 || got["old1"] != 100 || got["old2"] != 200 {
+		t.Errorf("picked %v, want canary=100 old1=100 old2=200", got)
+	}
+
+	// 百分比为0的版本不参与选择
+	selector, _ = ParseVersionSelector("1.0.0:100,1.1.0:0")
+	info.ReadySCs[canary] = base.SubConnInfo{Address: selector.buildAddress(Server{Addr: "canary", Version: "1.1.0"})}
+	info.ReadySCs[old1] = base.SubConnInfo{Address: selector.buildAddress(Server{Addr: "old1", Version: "1.0.0", Weight: 1})}
+	info.ReadySCs[old2] = base.SubConnInfo{Address: selector.buildAddress(Server{Addr: "old2", Version: "1.0.0", Weight: 2})}
+	if got := pickCounts(t, (&weightPickerBuilder{}).Build(info), 30); got["canary"] != 0 {
+		t.Errorf("version with 0 percent picked %d times", got["canary"])
+	}
+}
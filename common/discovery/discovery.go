@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"common/applog"
@@ -20,9 +21,12 @@ type Register struct {
 	DialTimeout int
 
 	closeCh     chan struct{}
+	doneCh      chan struct{} // keepAlive 协程退出（节点已注销）
+	stopOnce    sync.Once
 	leasesID    clientv3.LeaseID
 	keepAliveCh <-chan *clientv3.LeaseKeepAliveResponse
 
+	mu      sync.Mutex // 保护 srvInfo 及节点写入
 	srvInfo Server
 	srvTTL  int64
 	cli     *clientv3.Client
@@ -53,27 +57,68 @@ func (r *Register) Register(srvInfo Server, ttl int64) (*Register, chan<- struct
 		return nil, nil, err
 	}
 
+	if srvInfo.Status == "" {
+		srvInfo.Status = StatusServing
+	}
 	r.srvInfo = srvInfo
 	r.srvTTL = ttl
 
 	if err = r.register(); err != nil {
+		_ = r.cli.Close()
 		return nil, nil, err
 	}
 
 	r.closeCh = make(chan struct{})
+	r.doneCh = make(chan struct{})
 
 	go r.keepAlive()
 
 	return r, r.closeCh, nil
 }
 
-// Stop stop register
+// Stop stop register，阻塞直到节点从 etcd 注销、租约撤销
 func (r *Register) Stop() {
-	r.closeCh <- struct{}{}
+	r.stopOnce.Do(func() {
+		close(r.closeCh)
+	})
+	<-r.doneCh
+}
+
+// SetStatus 更新节点健康状态，不可用时从 etcd 注销节点，恢复后重新注册
+func (r *Register) SetStatus(status string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.srvInfo.Status == status {
+		return nil
+	}
+	r.srvInfo.Status = status
+	if status == StatusServing {
+		return r.put()
+	}
+	return r.unregister()
+}
+
+// SetWeight 更新节点权重，节点不可用时只记录权重，恢复注册时生效
+func (r *Register) SetWeight(weight int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.srvInfo.Weight == weight {
+		return nil
+	}
+	r.srvInfo.Weight = weight
+	if !r.srvInfo.IsServing() {
+		return nil
+	}
+	return r.put()
 }
 
-// register 注册节点
+// register 注册节点，节点不可用时只申请租约，待恢复后再写入节点
 func (r *Register) register() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	leaseCtx, cancel := context.WithTimeout(context.Background(), time.Duration(r.DialTimeout)*time.Second)
 	defer cancel()
 
@@ -86,6 +131,14 @@ func (r *Register) register() error {
 		return err
 	}
 
+	if !r.srvInfo.IsServing() {
+		return nil
+	}
+	return r.put()
+}
+
+// put 写入节点信息，调用方需持有 r.mu
+func (r *Register) put() error {
 	data, err := json.Marshal(r.srvInfo)
 	if err != nil {
 		return err
@@ -96,22 +149,33 @@ func (r *Register) register() error {
 
 // unregister 删除节点
 func (r *Register) unregister() error {
-	_, err := r.cli.Delete(context.Background(), BuildRegPath(r.srvInfo))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(r.DialTimeout)*time.Second)
+	defer cancel()
+	_, err := r.cli.Delete(ctx, BuildRegPath(r.srvInfo))
 	return err
 }
 
 // keepAlive
 func (r *Register) keepAlive() {
+	defer close(r.doneCh)
 	ticker := time.NewTicker(time.Duration(r.srvTTL) * time.Second)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-r.closeCh:
+			r.mu.Lock()
 			if err := r.unregister(); err != nil {
 				r.logger.Error("unregister failed", err)
 			}
-			if _, err := r.cli.Revoke(context.Background(), r.leasesID); err != nil {
+			r.mu.Unlock()
+			ctx, cancel := context.WithTimeout(context.Background(), time.Duration(r.DialTimeout)*time.Second)
+			if _, err := r.cli.Revoke(ctx, r.leasesID); err != nil {
 				r.logger.Error("revoke failed", err)
 			}
+			cancel()
+			if err := r.cli.Close(); err != nil {
+				r.logger.Error("close etcd client failed", err)
+			}
 			return
 		case res := <-r.keepAliveCh:
 			if res == nil {
@@ -140,17 +204,7 @@ func (r *Register) UpdateHandler() http.HandlerFunc {
 			return
 		}
 
-		var update = func() error {
-			r.srvInfo.Weight = weight
-			data, err := json.Marshal(r.srvInfo)
-			if err != nil {
-				return err
-			}
-			_, err = r.cli.Put(context.Background(), BuildRegPath(r.srvInfo), string(data), clientv3.WithLease(r.leasesID))
-			return err
-		}
-
-		if err := update(); err != nil {
+		if err := r.SetWeight(weight); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
 			return
@@ -160,6 +214,9 @@ func (r *Register) UpdateHandler() http.HandlerFunc {
 }
 
 func (r *Register) GetServerInfo() (Server, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	resp, err := r.cli.Get(context.Background(), BuildRegPath(r.srvInfo))
 	if err != nil {
 		return r.srvInfo, err
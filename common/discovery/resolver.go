@@ -2,13 +2,13 @@ package discovery
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"common/applog"
 
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
-	"google.golang.org/grpc/attributes"
 	"google.golang.org/grpc/resolver"
 )
 
@@ -16,25 +16,21 @@ const (
 	schema = "etcd"
 )
 
-// Resolver for grpc client
-type Resolver struct {
+// Builder for grpc client，每个目标地址创建独立的 Resolver，共享同一个 etcd 客户端
+type Builder struct {
 	schema      string   // 解析器的协议名（固定为 "etcd"，用于 gRPC 识别）
 	EtcdAddrs   []string // etcd 集群地址（如 ["localhost:2379"]）
 	DialTimeout int      // 连接 etcd 的超时时间（秒）
 
-	closeCh      chan struct{}      // 关闭信号通道
-	watchCh      clientv3.WatchChan // 监听 etcd 变化的通道
-	cli          *clientv3.Client   // etcd 客户端实例
-	keyPrefix    string             // 服务在 etcd 中的注册路径前缀（如 "/services/user-service/"）
-	srvAddrsList []resolver.Address // 当前可用的服务节点列表（从 etcd 同步）
-
-	cc     resolver.ClientConn // gRPC 客户端连接（用于更新节点列表）
-	logger *applog.Tracer      // 日志工具
+	mu     sync.Mutex
+	cli    *clientv3.Client // 共享的 etcd 客户端实例
+	refs   int              // 使用 cli 的 Resolver 数量，归零时关闭 cli
+	logger *applog.Tracer   // 日志工具
 }
 
-// NewResolver create a new resolver.Builder base on etcd
-func NewResolver(etcdAddrs []string, logger *applog.Tracer) *Resolver {
-	return &Resolver{
+// NewBuilder create a new resolver.Builder base on etcd
+func NewBuilder(etcdAddrs []string, logger *applog.Tracer) *Builder {
+	return &Builder{
 		schema:      schema,
 		EtcdAddrs:   etcdAddrs,
 		DialTimeout: 3,
@@ -43,67 +39,159 @@ func NewResolver(etcdAddrs []string, logger *applog.Tracer) *Resolver {
 }
 
 // Scheme returns the scheme supported by this resolver.
-func (r *Resolver) Scheme() string {
-	return r.schema
+func (b *Builder) Scheme() string {
+	return b.schema
 }
 
 // Build creates a new resolver.Resolver for the given target
-func (r *Resolver) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (resolver.Resolver, error) {
-	r.cc = cc
+func (b *Builder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (resolver.Resolver, error) {
+	selector, err := ParseVersionSelector(target.URL.Query().Get(versionQueryKey))
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := b.acquire()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &Resolver{
+		builder:      b,
+		cli:          cli,
+		keyPrefix:    BuildPrefix(Server{Name: target.Endpoint(), Version: selector.singleVersion()}),
+		selector:     selector,
+		resolveNowCh: make(chan struct{}, 1),
+		ctx:          ctx,
+		cancel:       cancel,
+		cc:           cc,
+		logger:       b.logger,
+	}
 
-	r.keyPrefix = BuildPrefix(Server{Name: target.Endpoint()})
-	if _, err := r.start(); err != nil {
+	rev, err := r.sync()
+	if err != nil {
+		cancel()
+		b.release()
 		return nil, err
 	}
+
+	r.wg.Add(1)
+	go r.watch(rev)
+
 	return r, nil
 }
 
-// ResolveNow resolver.Resolver interface
-func (r *Resolver) ResolveNow(o resolver.ResolveNowOptions) {}
+// acquire 获取共享的 etcd 客户端，首次使用时创建
+func (b *Builder) acquire() (*clientv3.Client, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
 
-// Close resolver.Resolver interface
-func (r *Resolver) Close() {
-	r.closeCh <- struct{}{}
+	if b.cli == nil {
+		cli, err := clientv3.New(clientv3.Config{
+			Endpoints:   b.EtcdAddrs,
+			DialTimeout: time.Duration(b.DialTimeout) * time.Second,
+		})
+		if err != nil {
+			return nil, err
+		}
+		b.cli = cli
+	}
+	b.refs++
+	return b.cli, nil
 }
 
-// start
-func (r *Resolver) start() (chan<- struct{}, error) {
-	var err error
-	r.cli, err = clientv3.New(clientv3.Config{
-		Endpoints:   r.EtcdAddrs,
-		DialTimeout: time.Duration(r.DialTimeout) * time.Second,
-	})
-	if err != nil {
-		return nil, err
+// release 释放 etcd 客户端引用，最后一个 Resolver 关闭时关闭客户端
+func (b *Builder) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.cli == nil {
+		return
+	}
+	b.refs--
+	if b.refs <= 0 {
+		if err := b.cli.Close(); err != nil {
+			b.logger.Error("close etcd client failed", err)
+		}
+		b.cli = nil
+		b.refs = 0
 	}
+}
 
-	r.closeCh = make(chan struct{})
+// Resolver for a single grpc target
+type Resolver struct {
+	builder      *Builder
+	cli          *clientv3.Client   // etcd 客户端实例（由 Builder 共享）
+	keyPrefix    string             // 服务在 etcd 中的注册路径前缀（如 "/services/user-service/"）
+	selector     VersionSelector    // 版本选择器（来自目标地址的 version 参数）
+	srvAddrsList []resolver.Address // 当前可用的服务节点列表（从 etcd 同步）
 
-	if err = r.sync(); err != nil {
-		return nil, err
-	}
+	resolveNowCh chan struct{}      // 立即重新解析信号
+	ctx          context.Context    // 关闭时取消，同时结束 etcd watch
+	cancel       context.CancelFunc // 取消函数
+	wg           sync.WaitGroup     // 等待 watch 协程退出
+	closeOnce    sync.Once
 
-	go r.watch()
+	cc     resolver.ClientConn // gRPC 客户端连接（用于更新节点列表）
+	logger *applog.Tracer      // 日志工具
+}
 
-	return r.closeCh, nil
+// ResolveNow resolver.Resolver interface
+func (r *Resolver) ResolveNow(o resolver.ResolveNowOptions) {
+	select {
+	case r.resolveNowCh <- struct{}{}:
+	default:
+	}
+}
+
+// Close resolver.Resolver interface
+func (r *Resolver) Close() {
+	r.closeOnce.Do(func() {
+		r.cancel()
+		r.wg.Wait()
+		r.builder.release()
+	})
 }
 
 // watch update events
-func (r *Resolver) watch() {
+func (r *Resolver) watch(rev int64) {
+	defer r.wg.Done()
+
 	ticker := time.NewTicker(time.Minute)
-	// 监听服务变化
-	r.watchCh = r.cli.Watch(context.Background(), r.keyPrefix, clientv3.WithPrefix())
+	defer ticker.Stop()
+
+	// 从同步时的版本之后开始监听，避免遗漏 sync 与 watch 之间的变化
+	watchCh := r.cli.Watch(r.ctx, r.keyPrefix, clientv3.WithPrefix(), clientv3.WithRev(rev+1))
 
 	for {
 		select {
-		case <-r.closeCh:
+		case <-r.ctx.Done():
 			return
-		case res, ok := <-r.watchCh:
-			if ok {
+		case res, ok := <-watchCh:
+			if ok && res.Err() == nil {
 				r.update(res.Events)
+				continue
+			}
+			// watch 被关闭（如版本被压缩），稍后全量同步并重新监听
+			r.logger.Error("watch interrupted, resync", r.keyPrefix, res.Err())
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+			opts := []clientv3.OpOption{clientv3.WithPrefix()}
+			if newRev, err := r.sync(); err != nil {
+				r.logger.Error("sync failed", err)
+			} else {
+				opts = append(opts, clientv3.WithRev(newRev+1))
+			}
+			watchCh = r.cli.Watch(r.ctx, r.keyPrefix, opts...)
+		case <-r.resolveNowCh:
+			if _, err := r.sync(); err != nil {
+				r.logger.Error("sync failed", err)
 			}
 		case <-ticker.C:
-			if err := r.sync(); err != nil {
+			if _, err := r.sync(); err != nil {
 				r.logger.Error("sync failed", err)
 			}
 		}
@@ -119,18 +207,24 @@ func (r *Resolver) update(events []*clientv3.Event) {
 		switch ev.Type {
 		case mvccpb.PUT:
 			info, err = ParseValue(ev.Kv.Value)
-			if err != nil {
+			if err != nil || !r.selector.Match(info.Version) {
 				continue
 			}
-			addr := resolver.Address{
-				Addr: info.Addr,
-				Attributes: attributes.New(
-					"weight", info.Weight,
-				),
+			addr := r.selector.buildAddress(info)
+			if !info.IsServing() {
+				// 节点上报不可用，从列表中移除
+				if s, ok := Remove(r.srvAddrsList, addr); ok {
+					r.srvAddrsList = s
+					r.cc.UpdateState(resolver.State{Addresses: r.srvAddrsList})
+				}
+				continue
 			}
 			if !Exist(r.srvAddrsList, addr) {
 				r.srvAddrsList = append(r.srvAddrsList, addr)
 				r.cc.UpdateState(resolver.State{Addresses: r.srvAddrsList})
+			} else if Replace(r.srvAddrsList, addr) {
+				// 节点已存在但权重等属性发生变化，通知 balancer 重新计算
+				r.cc.UpdateState(resolver.State{Addresses: r.srvAddrsList})
 			}
 		case mvccpb.DELETE:
 			info, err = SplitPath(string(ev.Kv.Key))
@@ -146,29 +240,23 @@ func (r *Resolver) update(events []*clientv3.Event) {
 	}
 }
 
-// sync 同步获取所有地址信息
-func (r *Resolver) sync() error {
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+// sync 同步获取所有地址信息，返回当前 etcd 版本号
+func (r *Resolver) sync() (int64, error) {
+	ctx, cancel := context.WithTimeout(r.ctx, 3*time.Second)
 	defer cancel()
 	res, err := r.cli.Get(ctx, r.keyPrefix, clientv3.WithPrefix())
 	if err != nil {
-		return err
+		return 0, err
 	}
 	r.srvAddrsList = []resolver.Address{}
 
 	for _, v := range res.Kvs {
 		info, err := ParseValue(v.Value)
-		if err != nil {
+		if err != nil || !r.selector.Match(info.Version) || !info.IsServing() {
 			continue
 		}
-		addr := resolver.Address{
-			Addr: info.Addr,
-			Attributes: attributes.New(
-				"weight", info.Weight,
-			),
-		}
-		r.srvAddrsList = append(r.srvAddrsList, addr)
+		r.srvAddrsList = append(r.srvAddrsList, r.selector.buildAddress(info))
 	}
 	r.cc.UpdateState(resolver.State{Addresses: r.srvAddrsList})
-	return nil
+	return res.Header.Revision, nil
 }
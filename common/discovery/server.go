@@ -6,14 +6,28 @@ import (
 	"fmt"
 	"strings"
 
+	"google.golang.org/grpc/attributes"
 	"google.golang.org/grpc/resolver"
 )
 
+const (
+	// StatusServing 节点可用，与 grpc.health.v1 的状态名一致
+	StatusServing = "SERVING"
+	// StatusNotServing 节点不可用
+	StatusNotServing = "NOT_SERVING"
+)
+
 type Server struct {
 	Name    string `json:"name"`
-	Addr    string `json:"addr"`    //服务地址
-	Version string `json:"version"` //服务版本
-	Weight  int    `json:"weight"`  //服务权重
+	Addr    string `json:"addr"`             //服务地址
+	Version string `json:"version"`          //服务版本
+	Weight  int    `json:"weight"`           //服务权重
+	Status  string `json:"status,omitempty"` //健康状态
+}
+
+// IsServing 节点是否可用，未上报状态的节点视为可用
+func (s Server) IsServing() bool {
+	return s.Status == "" || s.Status == StatusServing
 }
 
 // BuildPrefix 构建服务前缀/project/
@@ -44,6 +58,13 @@ func SplitPath(path string) (Server, error) {
 		return info, errors.New("invalid path")
 	}
 	info.Addr = strs[len(strs)-1]
+	// /name/version/addr
+	if len(strs) >= 4 {
+		info.Name = strs[1]
+		info.Version = strs[2]
+	} else if len(strs) == 3 {
+		info.Name = strs[1]
+	}
 	return info, nil
 }
 
@@ -57,6 +78,17 @@ func Exist(l []resolver.Address, addr resolver.Address) bool {
 	return false
 }
 
+// Replace helper function, 替换同地址但属性不同的节点
+func Replace(l []resolver.Address, addr resolver.Address) bool {
+	for i := range l {
+		if l[i].Addr == addr.Addr && !l[i].Equal(addr) {
+			l[i] = addr
+			return true
+		}
+	}
+	return false
+}
+
 // Remove helper function
 func Remove(s []resolver.Address, addr resolver.Address) ([]resolver.Address, bool) {
 	for i := range s {
@@ -68,6 +100,15 @@ func Remove(s []resolver.Address, addr resolver.Address) ([]resolver.Address, bo
 	return nil, false
 }
 
+// BuildAddress 构建 grpc 地址，权重和版本通过 BalancerAttributes 传递给 balancer。
+// Attributes 参与 SubConn 的地址比较，放在其中时权重变化会导致重建连接
+func BuildAddress(info Server) resolver.Address {
+	return resolver.Address{
+		Addr:               info.Addr,
+		BalancerAttributes: attributes.New(weightAttrKey, info.Weight).WithValue(versionAttrKey, info.Version),
+	}
+}
+
 func BuildResolverUrl(app string) string {
 	return schema + ":///" + app
 }
@@ -0,0 +1,152 @@
+package discovery
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/resolver"
+)
+
+const (
+	// versionQueryKey 目标地址中的版本参数，如 etcd:///user?version=1.0.0
+	versionQueryKey = "version"
+
+	versionAttrKey = "version"
+	percentAttrKey = "version_percent"
+)
+
+// VersionPercent 版本及其流量百分比
+type VersionPercent struct {
+	Version string
+	Percent int
+}
+
+// VersionSelector 版本选择器
+// 格式：空为全部版本；"1.0.0" 指定版本；"1.0.0,1.1.0" 多个版本按节点权重均衡；
+// "1.0.0:95,1.1.0:5" 按百分比灰度，百分比之和必须为100
+type VersionSelector struct {
+	Versions []VersionPercent
+	split    bool
+}
+
+// ParseVersionSelector 解析版本选择器
+func ParseVersionSelector(s string) (VersionSelector, error) {
+	selector := VersionSelector{}
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return selector, nil
+	}
+
+	withPercent, total := 0, 0
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		vp := VersionPercent{Version: item}
+		if idx := strings.LastIndex(item, ":"); idx != -1 {
+			percent, err := strconv.Atoi(item[idx+1:])
+			if err != nil || percent < 0 || percent > 100 {
+				return selector, fmt.Errorf("invalid version percent: %s", item)
+			}
+			vp.Version = item[:idx]
+			vp.Percent = percent
+			withPercent++
+			total += percent
+		}
+		if vp.Version == "" {
+			return selector, fmt.Errorf("invalid version: %s", item)
+		}
+		for _, exist := range selector.Versions {
+			if exist.Version == vp.Version {
+				return selector, fmt.Errorf("duplicate version: %s", vp.Version)
+			}
+		}
+		selector.Versions = append(selector.Versions, vp)
+	}
+
+	if withPercent > 0 {
+		if withPercent != len(selector.Versions) {
+			return selector, fmt.Errorf("version percent must be set for all versions: %s", s)
+		}
+		if total != 100 {
+			return selector, fmt.Errorf("sum of version percent must be 100, got %d", total)
+		}
+		selector.split = true
+	}
+	return selector, nil
+}
+
+// Match 判断版本是否被选中，未配置版本时全部匹配
+func (v VersionSelector) Match(version string) bool {
+	if len(v.Versions) == 0 {
+		return true
+	}
+	for _, vp := range v.Versions {
+		if vp.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// singleVersion 只选择一个版本时返回该版本，用于缩小 etcd 监听前缀
+func (v VersionSelector) singleVersion() string {
+	if len(v.Versions) == 1 {
+		return v.Versions[0].Version
+	}
+	return ""
+}
+
+// percent 获取版本的流量百分比，未启用灰度时返回0
+func (v VersionSelector) percent(version string) int {
+	if !v.split {
+		return 0
+	}
+	for _, vp := range v.Versions {
+		if vp.Version == version {
+			return vp.Percent
+		}
+	}
+	return 0
+}
+
+// buildAddress 构建地址，灰度时附带版本流量百分比
+func (v VersionSelector) buildAddress(info Server) resolver.Address {
+	addr := BuildAddress(info)
+	if v.split {
+		addr.BalancerAttributes = addr.BalancerAttributes.WithValue(percentAttrKey, v.percent(info.Version))
+	}
+	return addr
+}
+
+// GetVersion 获取地址上的服务版本
+func GetVersion(addr resolver.Address) string {
+	if addr.BalancerAttributes == nil {
+		return ""
+	}
+	version, _ := addr.BalancerAttributes.Value(versionAttrKey).(string)
+	return version
+}
+
+// getPercent 获取地址上的版本流量百分比，未设置时返回-1
+func getPercent(addr resolver.Address) int {
+	if addr.BalancerAttributes == nil {
+		return -1
+	}
+	percent, ok := addr.BalancerAttributes.Value(percentAttrKey).(int)
+	if !ok {
+		return -1
+	}
+	return percent
+}
+
+// BuildVersionResolverUrl 构建带版本选择的解析地址，如 etcd:///user?version=1.0.0:95,1.1.0:5
+func BuildVersionResolverUrl(app string, version string) string {
+	if version == "" {
+		return BuildResolverUrl(app)
+	}
+	return BuildResolverUrl(app) + "?" + versionQueryKey + "=" + url.QueryEscape(version)
+}
@@ -0,0 +1,74 @@
+package discovery
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseVersionSelector(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    []VersionPercent
+		split   bool
+		wantErr bool
+	}{
+		{name: "empty", in: ""},
+		{name: "blank", in: "  "},
+		{name: "only commas", in: " , ,"},
+		{name: "single", in: "1.0.0", want: []VersionPercent{{Version: "1.0.0"}}},
+		{name: "multiple", in: "1.0.0, 1.1.0", want: []VersionPercent{{Version: "1.0.0"}, {Version: "1.1.0"}}},
+		{name: "percent", in: "1.0.0:95,1.1.0:5", want: []VersionPercent{{"1.0.0", 95}, {"1.1.0", 5}}, split: true},
+		{name: "percent with zero", in: "1.0.0:100,1.1.0:0", want: []VersionPercent{{"1.0.0", 100}, {"1.1.0", 0}}, split: true},
+		{name: "sum below 100", in: "1.0.0:90,1.1.0:5", wantErr: true},
+		{name: "sum above 100", in: "1.0.0:95,1.1.0:10", wantErr: true},
+		{name: "single below 100", in: "1.0.0:50", wantErr: true},
+		{name: "partial percent", in: "1.0.0:100,1.1.0", wantErr: true},
+		{name: "non numeric percent", in: "1.0.0:abc", wantErr: true},
+		{name: "negative percent", in: "1.0.0:-5,1.1.0:105", wantErr: true},
+		{name: "percent over 100", in: "1.0.0:101", wantErr: true},
+		{name: "empty percent", in: "1.0.0:", wantErr: true},
+		{name: "empty version", in: ":100", wantErr: true},
+		{name: "duplicate", in: "1.0.0,1.0.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVersionSelector(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseVersionSelector(%q) = %+v, want error", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseVersionSelector(%q) error: %v", tt.in, err)
+			}
+			if !reflect.DeepEqual(got.Versions, tt.want) || got.split != tt.split {
+				t.Errorf("ParseVersionSelector(%q) = %+v split=%v, want %+v split=%v", tt.in, got.Versions, got.split, tt.want, tt.split)
+			}
+		})
+	}
+}
+
+func TestVersionSelectorMatch(t *testing.T) {
+	all, _ := ParseVersionSelector("")
+	canary, _ := ParseVersionSelector("1.0.0:95,1.1.0:5")
+	tests := []struct {
+		selector VersionSelector
+		version  string
+		want     bool
+	}{
+		{all, "", true},
+		{all, "2.0.0", true},
+		{canary, "1.1.0", true},
+		{canary, "2.0.0", false},
+	}
+	for _, tt := range tests {
+		if got := tt.selector.Match(tt.version); got != tt.want {
+			t.Errorf("%+v.Match(%q) = %v, want %v", tt.selector.Versions, tt.version, got, tt.want)
+		}
+	}
+	if canary.percent("1.1.0") != 5 || all.percent("1.1.0") != 0 {
+		t.Errorf("percent() mismatch")
+	}
+}
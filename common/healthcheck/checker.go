@@ -0,0 +1,167 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"common/applog"
+)
+
+const (
+	defaultInterval         = 5 * time.Second
+	defaultTimeout          = 2 * time.Second
+	defaultFailureThreshold = 2
+)
+
+// ProbeFunc 依赖探测函数，返回 nil 表示依赖可用
+type ProbeFunc func(ctx context.Context) error
+
+type probe struct {
+	name string
+	fn   ProbeFunc
+}
+
+// Checker 周期性执行依赖探测，健康状态变化时通知监听者
+type Checker struct {
+	Interval         time.Duration // 探测间隔
+	Timeout          time.Duration // 单次探测超时
+	FailureThreshold int           // 连续失败多少次后判定为不健康，避免抖动
+
+	mu        sync.Mutex
+	probes    []probe
+	listeners []func(healthy bool)
+	healthy   bool
+	checked   bool
+	failures  int
+
+	closeOnce sync.Once
+	closeCh   chan struct{}
+	wg        sync.WaitGroup
+	logger    *applog.Tracer
+}
+
+// NewChecker 创建健康检查器，interval/timeout 为0时使用默认值
+func NewChecker(interval, timeout time.Duration, logger *applog.Tracer) *Checker {
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &Checker{
+		Interval:         interval,
+		Timeout:          timeout,
+		FailureThreshold: defaultFailureThreshold,
+		closeCh:          make(chan struct{}),
+		logger:           logger,
+	}
+}
+
+// AddProbe 添加依赖探测
+func (c *Checker) AddProbe(name string, fn ProbeFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.probes = append(c.probes, probe{name: name, fn: fn})
+}
+
+// OnChange 注册健康状态变化回调，首次检查完成时也会回调
+func (c *Checker) OnChange(fn func(healthy bool)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.listeners = append(c.listeners, fn)
+}
+
+// Healthy 当前是否健康
+func (c *Checker) Healthy() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.healthy
+}
+
+// Start 立即执行一次检查，然后在后台周期检查
+func (c *Checker) Start() {
+	c.Check(context.Background())
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		ticker := time.NewTicker(c.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.closeCh:
+				return
+			case <-ticker.C:
+				c.Check(context.Background())
+			}
+		}
+	}()
+}
+
+// Stop 停止后台检查，可重复调用
+func (c *Checker) Stop() {
+	c.closeOnce.Do(func() { close(c.closeCh) })
+	c.wg.Wait()
+}
+
+// Check 执行所有探测并更新健康状态，返回探测失败的错误
+func (c *Checker) Check(ctx context.Context) error {
+	c.mu.Lock()
+	probes := make([]probe, len(c.probes))
+	copy(probes, c.probes)
+	c.mu.Unlock()
+
+	var errs []error
+	for _, p := range probes {
+		probeCtx, cancel := context.WithTimeout(ctx, c.Timeout)
+		if err := p.fn(probeCtx); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p.name, err))
+		}
+		cancel()
+	}
+	err := errors.Join(errs...)
+	if err != nil {
+		c.logger.Error("health check failed", err)
+	}
+
+	c.update(err == nil)
+	return err
+}
+
+// update 更新健康状态，状态变化时通知监听者
+func (c *Checker) update(ok bool) {
+	c.mu.Lock()
+	if ok {
+		c.failures = 0
+	} else {
+		c.failures++
+	}
+
+	healthy := c.healthy
+	switch {
+	case !c.checked:
+		// 首次检查直接决定初始状态
+		healthy = ok
+	case ok:
+		healthy = true
+	case c.failures >= c.FailureThreshold:
+		healthy = false
+	}
+
+	changed := !c.checked || healthy != c.healthy
+	c.checked = true
+	c.healthy = healthy
+	listeners := make([]func(bool), len(c.listeners))
+	copy(listeners, c.listeners)
+	c.mu.Unlock()
+
+	if !changed {
+		return
+	}
+	for _, fn := range listeners {
+		fn(healthy)
+	}
+}
@@ -0,0 +1,145 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"os"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"common/applog"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestMain(m *testing.M) {
+	// 探测失败时记录日志
+	dir, err := os.MkdirTemp("", "healthcheck_test")
+	if err != nil {
+		panic(err)
+	}
+	if err := applog.InitLoggers(applog.LogConfig{Path: dir, LogFile: "test", MaxAge: 1}); err != nil {
+		panic(err)
+	}
+	code := m.Run()
+	_ = os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// testServer 与服务中的用法一致：健康状态写入 grpc health 服务，serving 时写入注册中心，not_serving 时注销
+type testServer struct {
+	health *health.Server
+	events []string
+}
+
+func newTestServer(c *Checker) *testServer {
+	s := &testServer{health: health.NewServer()}
+	// 启动时不可用，探测通过后才可用并注册
+	s.health.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	c.OnChange(func(healthy bool) {
+		status, event := healthpb.HealthCheckResponse_NOT_SERVING, "not_serving"
+		if healthy {
+			status, event = healthpb.HealthCheckResponse_SERVING, "serving"
+		}
+		s.health.SetServingStatus("", status)
+		s.events = append(s.events, event)
+	})
+	return s
+}
+
+func (s *testServer) status(t *testing.T) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := s.health.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.Status
+}
+
+func newTestChecker(fail *atomic.Bool) *Checker {
+	c := NewChecker(time.Hour, time.Second, applog.WrapGDPLogger(context.Background()))
+	c.AddProbe("mysql", func(context.Context) error {
+		if fail.Load() {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+	return c
+}
+
+func TestProbeFailureSetsNotServing(t *testing.T) {
+	var fail atomic.Bool
+	c := newTestChecker(&fail)
+	s := newTestServer(c)
+	ctx := context.Background()
+
+	if err := c.Check(ctx); err != nil {
+		t.Fatalf("Check() error: %v", err)
+	}
+	if got := s.status(t); got != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("status = %v, want SERVING", got)
+	}
+
+	fail.Store(true)
+	// 连续失败未达到阈值时保持可用，避免抖动
+	for i := 1; i < c.FailureThreshold; i++ {
+		if err := c.Check(ctx); err == nil {
+			t.Fatal("Check() succeeded, want error")
+		}
+		if got := s.status(t); got != healthpb.HealthCheckResponse_SERVING {
+			t.Fatalf("status after %d failure(s) = %v, want SERVING", i, got)
+		}
+	}
+	_ = c.Check(ctx)
+	if got := s.status(t); got != healthpb.HealthCheckResponse_NOT_SERVING || c.Healthy() {
+		t.Fatalf("status after %d failures = %v, want NOT_SERVING", c.FailureThreshold, got)
+	}
+
+	// 恢复后立即可用
+	fail.Store(false)
+	_ = c.Check(ctx)
+	if got := s.status(t); got != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("status after recovery = %v, want SERVING", got)
+	}
+	want := []string{"serving", "not_serving", "serving"}
+	if !reflect.DeepEqual(s.events, want) {
+		t.Errorf("events = %v, want %v", s.events, want)
+	}
+}
+
+func TestRegisterWaitsForFirstHealthyProbe(t *testing.T) {
+	var fail atomic.Bool
+	fail.Store(true)
+	c := newTestChecker(&fail)
+	s := newTestServer(c)
+
+	c.Start()
+	defer c.Stop()
+	// 首次探测失败直接判定为不可用，不注册
+	if got := s.status(t); got != healthpb.HealthCheckResponse_NOT_SERVING || c.Healthy() {
+		t.Fatalf("status = %v, want NOT_SERVING", got)
+	}
+	if !reflect.DeepEqual(s.events, []string{"not_serving"}) {
+		t.Fatalf("events = %v, want [not_serving]", s.events)
+	}
+
+	fail.Store(false)
+	_ = c.Check(context.Background())
+	if got := s.status(t); got != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("status = %v, want SERVING", got)
+	}
+	if !reflect.DeepEqual(s.events, []string{"not_serving", "serving"}) {
+		t.Errorf("events = %v, want [not_serving serving]", s.events)
+	}
+}
+
+func TestStopTwice(t *testing.T) {
+	var fail atomic.Bool
+	c := newTestChecker(&fail)
+	c.Start()
+	c.Stop()
+	c.Stop()
+}
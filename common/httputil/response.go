@@ -17,7 +17,8 @@ type ResponseData struct {
 }
 
 const (
-	CodeOK = 0
+	CodeOK          = 0
+	CodeParamsError = 401 // 请求参数错误，与服务端的参数错误码一致
 )
 
 func SuccessJsonResponse(ctx *gin.Context, requestID string, data any) {
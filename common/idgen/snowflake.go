@@ -0,0 +1,71 @@
+package idgen
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	nodeBits     = 10
+	sequenceBits = 12
+	maxNode      = 1<<nodeBits - 1
+	maxSequence  = 1<<sequenceBits - 1
+)
+
+// epoch 起始时间 2024-01-01，41位毫秒时间戳可用约69年
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli()
+
+// Snowflake 雪花算法ID：41位毫秒时间戳 + 10位节点ID + 12位序列号，同一服务的每个实例节点ID不能相同
+type Snowflake struct {
+	mu       sync.Mutex
+	node     int64
+	lastMs   int64
+	sequence int64
+}
+
+// NewSnowflake node 取值 0-1023
+func NewSnowflake(node int64) (*Snowflake, error) {
+	if node < 0 || node > maxNode {
+		return nil, fmt.Errorf("snowflake node must be in [0, %d], got %d", maxNode, node)
+	}
+	return &Snowflake{node: node}, nil
+}
+
+// NextID 生成ID，同一毫秒内序列号用尽或时钟回拨时等待到下一毫秒
+func (s *Snowflake) NextID() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UnixMilli()
+	if now < s.lastMs {
+		// 时钟回拨，继续使用上次的时间戳，避免重复
+		now = s.lastMs
+	}
+	if now == s.lastMs {
+		s.sequence = (s.sequence + 1) & maxSequence
+		if s.sequence == 0 {
+			for now <= s.lastMs {
+				time.Sleep(100 * time.Microsecond)
+				now = time.Now().UnixMilli()
+			}
+		}
+	} else {
+		s.sequence = 0
+	}
+	s.lastMs = now
+	return (now-epoch)<<(nodeBits+sequenceBits) | s.node<<sequenceBits | s.sequence
+}
+
+var defaultSnowflake *Snowflake
+
+// Init 初始化全局ID生成器
+func Init(node int64) (err error) {
+	defaultSnowflake, err = NewSnowflake(node)
+	return err
+}
+
+// NextID 使用全局ID生成器生成ID，需先调用 Init
+func NextID() int64 {
+	return defaultSnowflake.NextID()
+}
@@ -0,0 +1,125 @@
+package jwtutils
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+
+	RoleAdmin = "admin" // 管理员，可封禁/解封用户
+)
+
+// Claims 令牌内容，jti 为令牌ID，refresh token 每次轮换都会变化
+type Claims struct {
+	UserID    uint64   `json:"userID"`
+	DeviceID  string   `json:"device"`
+	SessionID string   `json:"sid,omitempty"` // 会话ID，同一设备登录期间不变，用于吊销
+	Type      string   `json:"type"`
+	Roles     []string `json:"roles,omitempty"`
+	jwt.StandardClaims
+}
+
+// HasRole 是否拥有角色
+func (c *Claims) HasRole(role string) bool {
+	for _, r := range c.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpiresTime 过期时间
+func (c *Claims) ExpiresTime() time.Time {
+	return time.Unix(c.ExpiresAt, 0)
+}
+
+// Sign 签发令牌，未设置的 exp/iat/iss/aud/jti 按配置补全
+func (r *Keyring) Sign(c *Claims) (string, error) {
+	now := time.Now()
+	if c.IssuedAt == 0 {
+		c.IssuedAt = now.Unix()
+	}
+	if c.ExpiresAt == 0 {
+		exp := r.cfg.AccessExp()
+		if c.Type == TokenTypeRefresh {
+			exp = r.cfg.RefreshExp()
+		}
+		c.ExpiresAt = now.Add(exp).Unix()
+	}
+	if c.Issuer == "" {
+		c.Issuer = r.cfg.Issuer
+	}
+	if c.Audience == "" {
+		c.Audience = r.cfg.Audience
+	}
+	if c.Id == "" {
+		c.Id = NewID()
+	}
+	return r.sign(c.Type, c)
+}
+
+// Parse 校验签名、有效期、签发方、令牌类型和设备，错误为 ErrTokenExpired、ErrSignatureInvalid、
+// ErrTokenType、ErrDeviceMismatch 等哨兵错误，可用 ToBError 转换为业务错误
+func (r *Keyring) Parse(tokenString, tokenType, deviceID string) (*Claims, error) {
+	if tokenString == "" {
+		return nil, ErrTokenMissing
+	}
+	c := &Claims{}
+	if err := r.parse(tokenString, tokenType, c); err != nil {
+		return nil, err
+	}
+	switch {
+	case c.Type != tokenType:
+		return nil, fmt.Errorf("%w: want %s, got %q", ErrTokenType, tokenType, c.Type)
+	case c.UserID == 0:
+		return nil, fmt.Errorf("%w: userID is empty", ErrClaimsInvalid)
+	case r.cfg.Issuer != "" && !c.VerifyIssuer(r.cfg.Issuer, true):
+		return nil, fmt.Errorf("%w: issuer %q", ErrClaimsInvalid, c.Issuer)
+	case r.cfg.Audience != "" && !c.VerifyAudience(r.cfg.Audience, true):
+		return nil, fmt.Errorf("%w: audience %q", ErrClaimsInvalid, c.Audience)
+	case c.DeviceID == "" || c.DeviceID != deviceID:
+		return nil, ErrDeviceMismatch
+	}
+	return c, nil
+}
+
+// GenerateSessionTokens 生成绑定会话的 access/refresh token，refreshID 为 refresh token 的 jti
+func (r *Keyring) GenerateSessionTokens(userID uint64, deviceID, sessionID, refreshID string, roles ...string) (accessToken, refreshToken string, err error) {
+	accessToken, err = r.Sign(&Claims{
+		UserID:    userID,
+		DeviceID:  deviceID,
+		SessionID: sessionID,
+		Type:      TokenTypeAccess,
+		Roles:     roles,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	refreshToken, err = r.Sign(&Claims{
+		UserID:         userID,
+		DeviceID:       deviceID,
+		SessionID:      sessionID,
+		Type:           TokenTypeRefresh,
+		Roles:          roles,
+		StandardClaims: jwt.StandardClaims{Id: refreshID},
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return accessToken, refreshToken, nil
+}
+
+// NewID 随机生成会话ID、令牌ID
+func NewID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,55 @@
+package jwtutils
+
+import (
+	"fmt"
+	"time"
+
+	"common/conf"
+)
+
+// Config jwt配置，密钥建议使用 secret:// 引用，如 access_secret = "secret://jwt/access"
+type Config struct {
+	AccessSecret  string      `toml:"access_secret"`                    // access token HS256 签名密钥
+	RefreshSecret string      `toml:"refresh_secret"`                   // refresh token HS256 签名密钥
+	AccessExpire  int         `toml:"access_expire" default:"7200"`     // access token 有效期（秒）
+	RefreshExpire int         `toml:"refresh_expire" default:"1209600"` // refresh token 有效期（秒）
+	Issuer        string      `toml:"issuer"`                           // 签发方 iss，配置后校验
+	Audience      string      `toml:"audience"`                         // 接收方 aud，配置后校验
+	SigningKey    string      `toml:"signing_key"`                      // 签发使用的非对称密钥 kid，为空时使用 HS256
+	Keys          []KeyConfig `toml:"keys"`                             // 非对称密钥，[[jwt.keys]]
+	AllowHS256    bool        `toml:"allow_hs256"`                      // 配置了 keys 时是否仍接受 HS256 令牌，仅用于从 HS256 切换期间
+}
+
+// Validate 校验配置，至少配置一种校验令牌的密钥
+func (c *Config) Validate() error {
+	var v conf.Validation
+	if len(c.Keys) == 0 {
+		v.Required("access_secret", c.AccessSecret)
+	}
+	if c.SigningKey != "" {
+		found := false
+		for _, k := range c.Keys {
+			found = found || k.ID == c.SigningKey
+		}
+		if !found {
+			v.Add("signing_key", c.SigningKey, conf.ErrInvalidValue)
+		}
+	}
+	for i, k := range c.Keys {
+		v.Required(fmt.Sprintf("keys.%d.kid", i), k.ID)
+		if k.PrivateKey == "" && k.PublicKey == "" {
+			v.Add(fmt.Sprintf("keys.%d.public_key", i), "", conf.ErrRequired)
+		}
+	}
+	return v.Err()
+}
+
+// AccessExp access token 有效期
+func (c Config) AccessExp() time.Duration {
+	return time.Duration(c.AccessExpire) * time.Second
+}
+
+// RefreshExp refresh token 有效期
+func (c Config) RefreshExp() time.Duration {
+	return time.Duration(c.RefreshExpire) * time.Second
+}
@@ -0,0 +1,83 @@
+package jwtutils
+
+import (
+	"errors"
+	"fmt"
+
+	"common/errs"
+
+	"github.com/golang-jwt/jwt"
+)
+
+var (
+	ErrTokenMissing     = errors.New("token missing")
+	ErrTokenMalformed   = errors.New("token malformed")
+	ErrTokenExpired     = errors.New("token expired")
+	ErrSignatureInvalid = errors.New("token signature invalid")
+	ErrTokenType        = errors.New("token type mismatch")
+	ErrDeviceMismatch   = errors.New("token device mismatch")
+	ErrClaimsInvalid    = errors.New("token claims invalid")
+)
+
+// 令牌错误码，网关直接返回给客户端
+const (
+	TokenMissingCode     errs.ErrorCode = 10001001
+	TokenExpiredCode     errs.ErrorCode = 10001002
+	TokenInvalidCode     errs.ErrorCode = 10001003
+	TokenTypeCode        errs.ErrorCode = 10001006
+	DeviceMismatchCode   errs.ErrorCode = 10001007
+	SignatureInvalidCode errs.ErrorCode = 10001008
+)
+
+var (
+	TokenMissing     = errs.NewError(TokenMissingCode, "未登录")
+	TokenExpired     = errs.NewError(TokenExpiredCode, "Token已过期")
+	TokenInvalid     = errs.NewError(TokenInvalidCode, "无效的Token")
+	TokenType        = errs.NewError(TokenTypeCode, "Token类型错误")
+	DeviceMismatch   = errs.NewError(DeviceMismatchCode, "设备不匹配，请重新登录")
+	SignatureInvalid = errs.NewError(SignatureInvalidCode, "Token签名无效")
+)
+
+// Errors 令牌错误对应的业务错误
+var Errors = map[error]*errs.BError{
+	ErrTokenMissing:     TokenMissing,
+	ErrTokenMalformed:   TokenInvalid,
+	ErrTokenExpired:     TokenExpired,
+	ErrSignatureInvalid: SignatureInvalid,
+	ErrTokenType:        TokenType,
+	ErrDeviceMismatch:   DeviceMismatch,
+	ErrClaimsInvalid:    TokenInvalid,
+}
+
+// ToBError 令牌错误转换为业务错误，未知错误按无效令牌处理
+func ToBError(err error) *errs.BError {
+	for sentinel, be := range Errors {
+		if errors.Is(err, sentinel) {
+			return be
+		}
+	}
+	return TokenInvalid
+}
+
+// IsExpired 是否为 Token 过期错误
+func IsExpired(err error) bool {
+	return errors.Is(err, ErrTokenExpired)
+}
+
+// wrapValidationError jwt 库的校验错误转换为哨兵错误，同时带有签名和过期问题时按签名错误处理
+func wrapValidationError(err error) error {
+	var ve *jwt.ValidationError
+	if !errors.As(err, &ve) {
+		return fmt.Errorf("%w: %v", ErrTokenMalformed, err)
+	}
+	switch {
+	case ve.Errors&jwt.ValidationErrorMalformed != 0:
+		return fmt.Errorf("%w: %v", ErrTokenMalformed, err)
+	case ve.Errors&(jwt.ValidationErrorUnverifiable|jwt.ValidationErrorSignatureInvalid) != 0:
+		return fmt.Errorf("%w: %v", ErrSignatureInvalid, err)
+	case ve.Errors&jwt.ValidationErrorExpired != 0:
+		return fmt.Errorf("%w: %v", ErrTokenExpired, err)
+	default:
+		return fmt.Errorf("%w: %v", ErrClaimsInvalid, err)
+	}
+}
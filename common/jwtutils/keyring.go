@@ -0,0 +1,248 @@
+package jwtutils
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// KeyConfig 非对称签名密钥，签发令牌的服务配置私钥，只校验令牌的服务只配置公钥
+type KeyConfig struct {
+	ID         string `toml:"kid"`         // 密钥ID，写入令牌头的 kid
+	PrivateKey string `toml:"private_key"` // PEM 私钥或文件路径，RSA 使用 RS256，Ed25519 使用 EdDSA，建议 secret:// 引用
+	PublicKey  string `toml:"public_key"`  // PEM 公钥或文件路径，配置了私钥时可省略
+}
+
+type key struct {
+	id      string
+	method  jwt.SigningMethod
+	private crypto.PrivateKey
+	public  crypto.PublicKey
+}
+
+// Keyring 签名和校验令牌的密钥
+//
+// 配置了 signing_key 时使用对应的非对称密钥签发，否则使用 HS256 和 access_secret/refresh_secret；
+// 校验时按令牌头的 kid 选择公钥，轮换期间新旧公钥同时有效。配置了 keys 的服务只用公钥校验，
+// 持有 access_secret 的服务也无法伪造其他服务接受的令牌；从 HS256 切换期间可配置 allow_hs256 临时接受旧令牌
+type Keyring struct {
+	cfg     Config
+	signing *key
+	keys    map[string]*key
+}
+
+// NewKeyring 按配置加载密钥
+func NewKeyring(cfg Config) (*Keyring, error) {
+	r := &Keyring{cfg: cfg, keys: make(map[string]*key, len(cfg.Keys))}
+	for _, kc := range cfg.Keys {
+		k, err := loadKey(kc)
+		if err != nil {
+			return nil, fmt.Errorf("load jwt key %s failed: %w", kc.ID, err)
+		}
+		if _, ok := r.keys[k.id]; ok {
+			return nil, fmt.Errorf("duplicate jwt key %s", k.id)
+		}
+		r.keys[k.id] = k
+	}
+	if cfg.SigningKey != "" {
+		k, ok := r.keys[cfg.SigningKey]
+		if !ok {
+			return nil, fmt.Errorf("jwt signing key %s not found", cfg.SigningKey)
+		}
+		if k.private == nil {
+			return nil, fmt.Errorf("jwt signing key %s has no private key", cfg.SigningKey)
+		}
+		r.signing = k
+	}
+	return r, nil
+}
+
+// Config jwt配置
+func (r *Keyring) Config() Config {
+	return r.cfg
+}
+
+// sign 签名，非对称签名时令牌头带 kid
+func (r *Keyring) sign(tokenType string, claims jwt.Claims) (string, error) {
+	if r.signing != nil {
+		token := jwt.NewWithClaims(r.signing.method, claims)
+		token.Header["kid"] = r.signing.id
+		return token.SignedString(r.signing.private)
+	}
+	secret := r.secret(tokenType)
+	if secret == "" {
+		return "", errors.New("no jwt signing key")
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+}
+
+// parse 校验签名和有效期
+func (r *Keyring) parse(tokenString, tokenType string, claims jwt.Claims) error {
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+			secret := r.secret(tokenType)
+			if secret == "" || (len(r.keys) > 0 && !r.cfg.AllowHS256) {
+				return nil, fmt.Errorf("不支持的签名算法: %v", token.Header["alg"])
+			}
+			return []byte(secret), nil
+		}
+		kid, _ := token.Header["kid"].(string)
+		k, ok := r.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("未知的密钥: %s", kid)
+		}
+		// 防止用其他算法伪造签名
+		if token.Method.Alg() != k.method.Alg() {
+			return nil, fmt.Errorf("不支持的签名算法: %v", token.Header["alg"])
+		}
+		return k.public, nil
+	})
+	if err != nil {
+		return wrapValidationError(err)
+	}
+	return nil
+}
+
+func (r *Keyring) secret(tokenType string) string {
+	if tokenType == TokenTypeRefresh {
+		return r.cfg.RefreshSecret
+	}
+	return r.cfg.AccessSecret
+}
+
+// JWK 公钥，RFC 7517
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`   // RSA
+	E   string `json:"e,omitempty"`   // RSA
+	Crv string `json:"crv,omitempty"` // Ed25519
+	X   string `json:"x,omitempty"`   // Ed25519
+}
+
+// JWKS 公钥集合，由网关的 /.well-known/jwks.json 提供
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS 所有校验公钥，按配置顺序
+func (r *Keyring) JWKS() JWKS {
+	set := JWKS{Keys: make([]JWK, 0, len(r.cfg.Keys))}
+	for _, kc := range r.cfg.Keys {
+		k := r.keys[kc.ID]
+		jwk := JWK{Kid: k.id, Use: "sig", Alg: k.method.Alg()}
+		switch pub := k.public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
+
+func loadKey(kc KeyConfig) (*key, error) {
+	if kc.ID == "" {
+		return nil, errors.New("kid is required")
+	}
+	k := &key{id: kc.ID}
+	if kc.PrivateKey != "" {
+		block, err := readPEM(kc.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		priv, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			// 兼容 openssl genrsa 生成的 PKCS#1 格式
+			if priv, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+				return nil, fmt.Errorf("parse private key failed: %w", err)
+			}
+		}
+		k.private = priv
+		if signer, ok := priv.(crypto.Signer); ok {
+			k.public = signer.Public()
+		}
+	}
+	if kc.PublicKey != "" {
+		block, err := readPEM(kc.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		if k.public, err = x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+			return nil, fmt.Errorf("parse public key failed: %w", err)
+		}
+	}
+
+	switch k.public.(type) {
+	case *rsa.PublicKey:
+		k.method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		k.method = jwt.SigningMethodEdDSA
+	case nil:
+		return nil, errors.New("private_key or public_key is required")
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", k.public)
+	}
+	return k, nil
+}
+
+// readPEM 配置值为 PEM 内容或文件路径
+func readPEM(value string) (*pem.Block, error) {
+	data := []byte(value)
+	if !strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		var err error
+		if data, err = os.ReadFile(value); err != nil {
+			return nil, err
+		}
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid PEM")
+	}
+	return block, nil
+}
+
+// GenerateKeyPair 生成 PEM 格式的密钥对，alg 为 RS256 或 EdDSA
+func GenerateKeyPair(alg string) (privatePEM, publicPEM []byte, err error) {
+	var priv crypto.Signer
+	switch alg {
+	case jwt.SigningMethodRS256.Alg():
+		priv, err = rsa.GenerateKey(rand.Reader, 2048)
+	case jwt.SigningMethodEdDSA.Alg():
+		_, priv, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, nil, fmt.Errorf("unsupported algorithm %q", alg)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	privDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return nil, nil, err
+	}
+	pubDER, err := x509.MarshalPKIXPublicKey(priv.Public())
+	if err != nil {
+		return nil, nil, err
+	}
+	privatePEM = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER})
+	publicPEM = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})
+	return privatePEM, publicPEM, nil
+}
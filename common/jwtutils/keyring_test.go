@@ -0,0 +1,47 @@
+package jwtutils
+
+import "testing"
+
+func TestKeyringHS256(t *testing.T) {
+	priv, pub, err := GenerateKeyPair("EdDSA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const secret = "shared-secret"
+	hs256, err := NewKeyring(Config{AccessSecret: secret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer, err := NewKeyring(Config{SigningKey: "k1", Keys: []KeyConfig{{ID: "k1", PrivateKey: string(priv)}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		cfg    Config
+		signer *Keyring
+		ok     bool
+	}{
+		{"hs256 only", Config{AccessSecret: secret}, hs256, true},
+		{"keys reject hs256", Config{AccessSecret: secret, Keys: []KeyConfig{{ID: "k1", PublicKey: string(pub)}}}, hs256, false},
+		{"keys allow hs256", Config{AccessSecret: secret, AllowHS256: true, Keys: []KeyConfig{{ID: "k1", PublicKey: string(pub)}}}, hs256, true},
+		{"keys accept eddsa", Config{AccessSecret: secret, Keys: []KeyConfig{{ID: "k1", PublicKey: string(pub)}}}, issuer, true},
+		{"no secret rejects hs256", Config{Keys: []KeyConfig{{ID: "k1", PublicKey: string(pub)}}, AllowHS256: true}, hs256, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := tt.signer.Sign(&Claims{UserID: 1, DeviceID: "d", Type: TokenTypeAccess})
+			if err != nil {
+				t.Fatal(err)
+			}
+			verifier, err := NewKeyring(tt.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := verifier.Parse(token, TokenTypeAccess, "d"); (err == nil) != tt.ok {
+				t.Errorf("Parse() error = %v, want ok=%v", err, tt.ok)
+			}
+		})
+	}
+}
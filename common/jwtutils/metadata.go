@@ -0,0 +1,77 @@
+package jwtutils
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// 网关鉴权后通过 grpc metadata 向下游传递的令牌和用户信息
+const (
+	MDAuthorization = "authorization"
+	MDUserID        = "x-user-id"
+	MDDeviceID      = "x-device-id"
+
+	bearerPrefix = "Bearer "
+)
+
+// AppendToOutgoingContext 将令牌和用户信息写入 grpc 请求的 metadata，下游服务用公钥重新校验令牌
+func AppendToOutgoingContext(ctx context.Context, token string, userID uint64, deviceID string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx,
+		MDAuthorization, bearerPrefix+token,
+		MDUserID, strconv.FormatUint(userID, 10),
+		MDDeviceID, deviceID,
+	)
+}
+
+// FromIncomingContext 读取网关传递的用户信息，未经校验，需要校验时使用 ClaimsFromContext
+func FromIncomingContext(ctx context.Context) (userID uint64, deviceID string, ok bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, "", false
+	}
+	userID, err := strconv.ParseUint(first(md, MDUserID), 10, 64)
+	if err != nil || userID == 0 {
+		return 0, "", false
+	}
+	return userID, first(md, MDDeviceID), true
+}
+
+// TokenFromIncomingContext 读取网关传递的令牌和设备
+func TokenFromIncomingContext(ctx context.Context) (token, deviceID string) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ""
+	}
+	return BearerToken(first(md, MDAuthorization)), first(md, MDDeviceID)
+}
+
+// BearerToken 取出 Authorization: Bearer {token} 中的令牌
+func BearerToken(header string) string {
+	if len(header) < len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return ""
+	}
+	return strings.TrimSpace(header[len(bearerPrefix):])
+}
+
+type claimsKey struct{}
+
+// NewContext 保存校验通过的令牌信息
+func NewContext(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext 校验通过的令牌信息，未携带令牌时 ok 为 false
+func ClaimsFromContext(ctx context.Context) (claims *Claims, ok bool) {
+	claims, ok = ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
+
+func first(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
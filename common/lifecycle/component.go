@@ -0,0 +1,216 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+const (
+	defaultStartRetries    = 3
+	defaultStartBackoff    = 500  // 毫秒
+	defaultStartMaxBackoff = 5000 // 毫秒
+	defaultStartTimeout    = 60   // 秒
+)
+
+// StartupConfig 组件启动配置
+type StartupConfig struct {
+	Retries    int `toml:"retries"`     // 启动或就绪检查失败后的重试次数，负数不重试
+	Backoff    int `toml:"backoff"`     // 首次重试等待时间（毫秒），之后指数增长
+	MaxBackoff int `toml:"max_backoff"` // 最大重试等待时间（毫秒）
+	Timeout    int `toml:"timeout"`     // 整体启动超时（秒）
+}
+
+// StartFunc 启动钩子
+type StartFunc func(ctx context.Context) error
+
+// Component 组件定义
+type Component struct {
+	Name      string    // 组件名称，唯一
+	DependsOn []string  // 依赖的组件，依赖全部就绪后才启动
+	Start     StartFunc // 启动钩子，失败时按退避策略重试
+	Ready     StartFunc // 就绪检查，可选，启动后直到就绪才启动依赖它的组件
+	Stop      StopFunc  // 关闭钩子，可选，组件启动成功后才会注册
+	StopPhase Phase     // 关闭阶段，未指定时为 PhaseResource
+}
+
+// StartError 组件启动失败
+type StartError struct {
+	Component string
+	Stage     string // start / ready
+	Attempts  int
+	Err       error
+}
+
+func (e *StartError) Error() string {
+	return fmt.Sprintf("component %s %s failed after %d attempt(s): %v", e.Component, e.Stage, e.Attempts, e.Err)
+}
+
+func (e *StartError) Unwrap() error {
+	return e.Err
+}
+
+// Register 注册组件
+func (m *Manager) Register(c Component) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if c.Name == "" {
+		return errors.New("component name is required")
+	}
+	for _, exist := range m.components {
+		if exist.Name == c.Name {
+			return fmt.Errorf("component %s already registered", c.Name)
+		}
+	}
+	m.components = append(m.components, c)
+	return nil
+}
+
+// MustRegister 注册组件，失败时 panic，仅用于组件定义期的编程错误
+func (m *Manager) MustRegister(components ...Component) {
+	for _, c := range components {
+		if err := m.Register(c); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Start 按依赖顺序启动所有组件，任一组件失败时关闭已启动的组件并返回 *StartError
+func (m *Manager) Start(ctx context.Context) error {
+	m.mu.Lock()
+	components := make([]Component, len(m.components))
+	copy(components, m.components)
+	m.mu.Unlock()
+
+	ordered, err := sortComponents(components)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(m.startup.Timeout)*time.Second)
+	defer cancel()
+
+	for _, c := range ordered {
+		start := time.Now()
+		if err := m.startComponent(ctx, c); err != nil {
+			log.Printf("start %s failed: %v", c.Name, err)
+			m.rollback()
+			return err
+		}
+		if c.Stop != nil {
+			m.OnStop(c.StopPhase, c.Name, c.Stop)
+		}
+		log.Printf("start %s done in %v", c.Name, time.Since(start))
+	}
+	return nil
+}
+
+// rollback 启动失败时关闭已启动的组件
+func (m *Manager) rollback() {
+	ctx, cancel := context.WithTimeout(context.Background(), m.Timeout())
+	defer cancel()
+	if err := m.Shutdown(ctx); err != nil {
+		log.Printf("rollback started components failed: %v", err)
+	}
+}
+
+// startComponent 启动组件并等待就绪，失败时指数退避重试
+func (m *Manager) startComponent(ctx context.Context, c Component) error {
+	if c.Start != nil {
+		if err := m.retry(ctx, c.Name, "start", c.Start); err != nil {
+			return err
+		}
+	}
+	if c.Ready != nil {
+		if err := m.retry(ctx, c.Name, "ready", c.Ready); err != nil {
+			// 已启动但未就绪，释放已申请的资源
+			if c.Stop != nil {
+				stopCtx, cancel := context.WithTimeout(context.Background(), m.Timeout())
+				defer cancel()
+				if stopErr := c.Stop(stopCtx); stopErr != nil {
+					log.Printf("stop %s failed: %v", c.Name, stopErr)
+				}
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Manager) retry(ctx context.Context, name, stage string, fn StartFunc) error {
+	backoff := time.Duration(m.startup.Backoff) * time.Millisecond
+	maxBackoff := time.Duration(m.startup.MaxBackoff) * time.Millisecond
+
+	attempts := 0
+	for {
+		attempts++
+		err := fn(ctx)
+		if err == nil {
+			return nil
+		}
+		if attempts > m.startup.Retries {
+			return &StartError{Component: name, Stage: stage, Attempts: attempts, Err: err}
+		}
+
+		log.Printf("%s %s failed (attempt %d), retry in %v: %v", name, stage, attempts, backoff, err)
+		if waitErr := Delay(backoff)(ctx); waitErr != nil {
+			return &StartError{Component: name, Stage: stage, Attempts: attempts, Err: errors.Join(err, waitErr)}
+		}
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// sortComponents 按依赖关系拓扑排序，同层按注册顺序
+func sortComponents(components []Component) ([]Component, error) {
+	index := make(map[string]int, len(components))
+	for i, c := range components {
+		index[c.Name] = i
+	}
+
+	inDegree := make([]int, len(components))
+	dependents := make([][]int, len(components))
+	for i, c := range components {
+		for _, dep := range c.DependsOn {
+			j, ok := index[dep]
+			if !ok {
+				return nil, fmt.Errorf("component %s depends on unknown component %s", c.Name, dep)
+			}
+			inDegree[i]++
+			dependents[j] = append(dependents[j], i)
+		}
+	}
+
+	ordered := make([]Component, 0, len(components))
+	done := make([]bool, len(components))
+	for len(ordered) < len(components) {
+		progressed := false
+		for i, c := range components {
+			if done[i] || inDegree[i] > 0 {
+				continue
+			}
+			done[i] = true
+			progressed = true
+			ordered = append(ordered, c)
+			for _, j := range dependents[i] {
+				inDegree[j]--
+			}
+		}
+		if !progressed {
+			var cycle []string
+			for i, c := range components {
+				if !done[i] {
+					cycle = append(cycle, c.Name)
+				}
+			}
+			return nil, fmt.Errorf("circular dependency between components: %s", strings.Join(cycle, ", "))
+		}
+	}
+	return ordered, nil
+}
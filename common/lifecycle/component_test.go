@@ -0,0 +1,136 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func (r *recorder) start(name string, err error) StartFunc {
+	return func(context.Context) error {
+		r.calls = append(r.calls, "start "+name)
+		return err
+	}
+}
+
+func TestStartDependencyOrder(t *testing.T) {
+	m := newTestManager()
+	rec := &recorder{}
+	m.MustRegister(
+		Component{Name: "grpc", DependsOn: []string{"mysql", "redis"}, Start: rec.start("grpc", nil)},
+		Component{Name: "mysql", DependsOn: []string{"applog"}, Start: rec.start("mysql", nil)},
+		Component{Name: "applog", Start: rec.start("applog", nil)},
+		Component{Name: "redis", Start: rec.start("redis", nil)},
+	)
+	if err := m.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error: %v", err)
+	}
+	want := []string{"start applog", "start redis", "start mysql", "start grpc"}
+	if !reflect.DeepEqual(rec.calls, want) {
+		t.Errorf("start order = %v, want %v", rec.calls, want)
+	}
+}
+
+func TestStartUnsetPhaseStopsAsResource(t *testing.T) {
+	m := newTestManager()
+	rec := &recorder{}
+	m.MustRegister(
+		Component{Name: "mysql", Stop: rec.stop("mysql", nil)},
+		Component{Name: "grpc", DependsOn: []string{"mysql"}, Stop: rec.stop("grpc", nil), StopPhase: PhaseServer},
+		Component{Name: "applog", Stop: rec.stop("applog", nil), StopPhase: PhaseFlush},
+		Component{Name: "etcd", DependsOn: []string{"grpc"}, Stop: rec.stop("etcd", nil), StopPhase: PhaseDeregister},
+	)
+	if err := m.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error: %v", err)
+	}
+	if err := m.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error: %v", err)
+	}
+	want := []string{"etcd", "grpc", "applog", "mysql"}
+	if !reflect.DeepEqual(rec.calls, want) {
+		t.Errorf("stop order = %v, want %v", rec.calls, want)
+	}
+}
+
+func TestStartRollback(t *testing.T) {
+	errStart := errors.New("connect refused")
+	errReady := errors.New("not ready")
+	tests := []struct {
+		name       string
+		components func(rec *recorder) []Component
+		wantStage  string
+		want       []string
+	}{
+		{
+			name: "start failure stops started components",
+			components: func(rec *recorder) []Component {
+				return []Component{
+					{Name: "redis", Start: rec.start("redis", nil), Stop: rec.stop("stop redis", nil)},
+					{Name: "grpc", DependsOn: []string{"redis"}, Start: rec.start("grpc", nil), Stop: rec.stop("stop grpc", nil), StopPhase: PhaseServer},
+					{Name: "mysql", DependsOn: []string{"grpc"}, Start: rec.start("mysql", errStart), Stop: rec.stop("stop mysql", nil)},
+					{Name: "mongo", DependsOn: []string{"mysql"}, Start: rec.start("mongo", nil), Stop: rec.stop("stop mongo", nil)},
+				}
+			},
+			wantStage: "start",
+			want:      []string{"start redis", "start grpc", "start mysql", "stop grpc", "stop redis"},
+		},
+		{
+			name: "ready failure stops the component itself",
+			components: func(rec *recorder) []Component {
+				return []Component{
+					{Name: "redis", Start: rec.start("redis", nil), Stop: rec.stop("stop redis", nil)},
+					{Name: "mysql", DependsOn: []string{"redis"}, Start: rec.start("mysql", nil),
+						Ready: func(context.Context) error { return errReady }, Stop: rec.stop("stop mysql", nil)},
+				}
+			},
+			wantStage: "ready",
+			want:      []string{"start redis", "start mysql", "stop mysql", "stop redis"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestManager()
+			rec := &recorder{}
+			m.MustRegister(tt.components(rec)...)
+
+			err := m.Start(context.Background())
+			var se *StartError
+			if !errors.As(err, &se) || se.Stage != tt.wantStage || se.Attempts != 1 {
+				t.Fatalf("Start() error = %v, want StartError at %s", err, tt.wantStage)
+			}
+			if !reflect.DeepEqual(rec.calls, tt.want) {
+				t.Errorf("calls = %v, want %v", rec.calls, tt.want)
+			}
+		})
+	}
+}
+
+func TestStartRetry(t *testing.T) {
+	m := NewManager(StartupConfig{Retries: 2, Backoff: 1, MaxBackoff: 1}, ShutdownConfig{PropagationDelay: -1})
+	attempts := 0
+	m.MustRegister(Component{Name: "mysql", Start: func(context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("connect refused")
+		}
+		return nil
+	}})
+	if err := m.Start(context.Background()); err != nil || attempts != 3 {
+		t.Errorf("Start() = %v after %d attempts, want success after 3", err, attempts)
+	}
+}
+
+func TestRegisterErrors(t *testing.T) {
+	m := newTestManager()
+	if err := m.Register(Component{}); err == nil {
+		t.Error("Register(no name) succeeded, want error")
+	}
+	m.MustRegister(Component{Name: "a", DependsOn: []string{"b"}}, Component{Name: "b", DependsOn: []string{"a"}})
+	if err := m.Register(Component{Name: "a"}); err == nil {
+		t.Error("Register(duplicate) succeeded, want error")
+	}
+	if err := m.Start(context.Background()); err == nil {
+		t.Error("Start(cycle) succeeded, want error")
+	}
+}
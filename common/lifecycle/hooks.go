@@ -0,0 +1,49 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// GracefulStopGrpc 等待进行中的 RPC 完成，超时后强制关闭
+func GracefulStopGrpc(s *grpc.Server, timeout time.Duration) StopFunc {
+	return func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		done := make(chan struct{})
+		go func() {
+			s.GracefulStop()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			s.Stop()
+			<-done
+			return fmt.Errorf("grpc graceful stop timeout, force stopped: %w", ctx.Err())
+		}
+	}
+}
+
+// ShutdownHTTP 等待进行中的请求完成，超时后强制关闭
+func ShutdownHTTP(s *http.Server, timeout time.Duration) StopFunc {
+	return func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		if err := s.Shutdown(ctx); err != nil {
+			if closeErr := s.Close(); closeErr != nil {
+				return fmt.Errorf("http shutdown failed: %w, force close failed: %v", err, closeErr)
+			}
+			return fmt.Errorf("http graceful shutdown timeout, force closed: %w", err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,176 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// Phase 关闭阶段，按定义顺序依次执行
+type Phase int
+
+const (
+	// PhaseUnset 未指定阶段，组件和 OnStop 按 PhaseResource 处理
+	PhaseUnset Phase = iota
+	// PhaseDeregister 从注册中心摘除节点，执行后等待 PropagationDelay 让客户端感知
+	PhaseDeregister
+	// PhaseServer 停止 grpc/http 服务，等待进行中的请求完成
+	PhaseServer
+	// PhaseFlush 刷新日志、链路追踪等缓冲数据
+	PhaseFlush
+	// PhaseResource 关闭数据库、缓存等资源
+	PhaseResource
+)
+
+var phaseNames = map[Phase]string{
+	PhaseDeregister: "deregister",
+	PhaseServer:     "server",
+	PhaseFlush:      "flush",
+	PhaseResource:   "resource",
+}
+
+func (p Phase) String() string {
+	if name, ok := phaseNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("phase(%d)", int(p))
+}
+
+const (
+	defaultPropagationDelay = 3
+	defaultGraceTimeout     = 10
+	defaultTimeout          = 30
+)
+
+// ShutdownConfig 优雅关闭配置
+type ShutdownConfig struct {
+	PropagationDelay int `toml:"propagation_delay"` // 从注册中心摘除后等待客户端感知的时间（秒）
+	GraceTimeout     int `toml:"grace_timeout"`     // 等待进行中请求完成的超时（秒），超时后强制关闭
+	Timeout          int `toml:"timeout"`           // 整体关闭超时（秒）
+}
+
+// StopFunc 关闭钩子
+type StopFunc func(ctx context.Context) error
+
+type hook struct {
+	name string
+	fn   StopFunc
+}
+
+// Manager 生命周期管理，按依赖顺序启动组件，收到退出信号后按阶段有序关闭
+type Manager struct {
+	mu         sync.Mutex
+	components []Component
+	hooks      map[Phase][]hook
+	startup    StartupConfig
+	config     ShutdownConfig
+	stopOnce   sync.Once
+	stopErr    error
+}
+
+// NewManager 创建生命周期管理器，配置为0时使用默认值
+func NewManager(startup StartupConfig, cfg ShutdownConfig) *Manager {
+	if startup.Retries < 0 {
+		startup.Retries = 0
+	} else if startup.Retries == 0 {
+		startup.Retries = defaultStartRetries
+	}
+	if startup.Backoff <= 0 {
+		startup.Backoff = defaultStartBackoff
+	}
+	if startup.MaxBackoff < startup.Backoff {
+		startup.MaxBackoff = max(defaultStartMaxBackoff, startup.Backoff)
+	}
+	if startup.Timeout <= 0 {
+		startup.Timeout = defaultStartTimeout
+	}
+	if cfg.PropagationDelay < 0 {
+		cfg.PropagationDelay = 0
+	} else if cfg.PropagationDelay == 0 {
+		cfg.PropagationDelay = defaultPropagationDelay
+	}
+	if cfg.GraceTimeout <= 0 {
+		cfg.GraceTimeout = defaultGraceTimeout
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	return &Manager{
+		hooks:   make(map[Phase][]hook),
+		startup: startup,
+		config:  cfg,
+	}
+}
+
+// OnStop 注册关闭钩子，同一阶段内按注册的逆序执行，未指定或未知的阶段按 PhaseResource 执行
+func (m *Manager) OnStop(phase Phase, name string, fn StopFunc) {
+	if _, ok := phaseNames[phase]; !ok {
+		phase = PhaseResource
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks[phase] = append(m.hooks[phase], hook{name: name, fn: fn})
+}
+
+// GraceTimeout 等待进行中请求完成的超时
+func (m *Manager) GraceTimeout() time.Duration {
+	return time.Duration(m.config.GraceTimeout) * time.Second
+}
+
+// Timeout 整体关闭超时
+func (m *Manager) Timeout() time.Duration {
+	return time.Duration(m.config.Timeout) * time.Second
+}
+
+// Shutdown 按阶段执行关闭钩子，单个钩子失败不影响后续钩子，多次调用只执行一次
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.stopOnce.Do(func() {
+		m.stopErr = m.shutdown(ctx)
+	})
+	return m.stopErr
+}
+
+func (m *Manager) shutdown(ctx context.Context) error {
+	var errs []error
+	for _, phase := range []Phase{PhaseDeregister, PhaseServer, PhaseFlush, PhaseResource} {
+		m.mu.Lock()
+		hooks := m.hooks[phase]
+		m.mu.Unlock()
+
+		for i := len(hooks) - 1; i >= 0; i-- {
+			h := hooks[i]
+			start := time.Now()
+			if err := h.fn(ctx); err != nil {
+				log.Printf("shutdown %s/%s failed: %v", phase, h.name, err)
+				errs = append(errs, fmt.Errorf("%s/%s: %w", phase, h.name, err))
+				continue
+			}
+			log.Printf("shutdown %s/%s done in %v", phase, h.name, time.Since(start))
+		}
+
+		// 摘除节点后等待客户端感知，再停止服务
+		if phase == PhaseDeregister && len(hooks) > 0 && m.config.PropagationDelay > 0 {
+			if err := Delay(time.Duration(m.config.PropagationDelay) * time.Second)(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("%s/propagation_delay: %w", phase, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Delay 等待指定时间，ctx 结束时提前返回
+func Delay(d time.Duration) StopFunc {
+	return func(ctx context.Context) error {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// recorder 记录钩子的执行顺序
+type recorder struct {
+	calls []string
+}
+
+func (r *recorder) stop(name string, err error) StopFunc {
+	return func(context.Context) error {
+		r.calls = append(r.calls, name)
+		return err
+	}
+}
+
+// newTestManager 不等待客户端感知，不重试
+func newTestManager() *Manager {
+	return NewManager(StartupConfig{Retries: -1, Backoff: 1}, ShutdownConfig{PropagationDelay: -1})
+}
+
+func TestShutdownPhaseOrder(t *testing.T) {
+	m := newTestManager()
+	rec := &recorder{}
+	// 注册顺序与阶段顺序无关；同一阶段内逆序执行
+	m.OnStop(PhaseResource, "mysql", rec.stop("mysql", nil))
+	m.OnStop(PhaseResource, "redis", rec.stop("redis", nil))
+	m.OnStop(PhaseFlush, "applog", rec.stop("applog", nil))
+	m.OnStop(PhaseServer, "grpc", rec.stop("grpc", nil))
+	m.OnStop(PhaseUnset, "kafka", rec.stop("kafka", nil))
+	m.OnStop(PhaseDeregister, "etcd", rec.stop("etcd", nil))
+
+	if err := m.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error: %v", err)
+	}
+	want := []string{"etcd", "grpc", "applog", "kafka", "redis", "mysql"}
+	if !reflect.DeepEqual(rec.calls, want) {
+		t.Errorf("stop order = %v, want %v", rec.calls, want)
+	}
+}
+
+func TestShutdownContinuesOnError(t *testing.T) {
+	m := newTestManager()
+	rec := &recorder{}
+	errStop := errors.New("stop failed")
+	m.OnStop(PhaseServer, "grpc", rec.stop("grpc", errStop))
+	m.OnStop(PhaseResource, "mysql", rec.stop("mysql", nil))
+
+	err := m.Shutdown(context.Background())
+	if !errors.Is(err, errStop) {
+		t.Errorf("Shutdown() error = %v, want %v", err, errStop)
+	}
+	if !reflect.DeepEqual(rec.calls, []string{"grpc", "mysql"}) {
+		t.Errorf("stop order = %v, want [grpc mysql]", rec.calls)
+	}
+	// 多次调用只执行一次，返回同一个错误
+	if err2 := m.Shutdown(context.Background()); err2 != err || len(rec.calls) != 2 {
+		t.Errorf("second Shutdown() = %v, calls = %v", err2, rec.calls)
+	}
+}
+
+func TestPhaseString(t *testing.T) {
+	for p, want := range map[Phase]string{
+		PhaseDeregister: "deregister",
+		PhaseResource:   "resource",
+		PhaseUnset:      "phase(0)",
+	} {
+		if got := p.String(); got != want {
+			t.Errorf("Phase(%d).String() = %q, want %q", int(p), got, want)
+		}
+	}
+}
@@ -1,20 +1,31 @@
 package common
 
 import (
+	"context"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+
+	"common/lifecycle"
 )
 
-func Run(stop func()) {
+// Run 阻塞直到收到退出信号，然后按阶段有序关闭
+func Run(lc *lifecycle.Manager) {
 	log.Printf("Starting server ... \n")
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Printf("Shutting Down menu ... \n")
 
-	if stop != nil {
-		stop()
+	if lc == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), lc.Timeout())
+	defer cancel()
+	if err := lc.Shutdown(ctx); err != nil {
+		log.Printf("Shutdown with error: %v \n", err)
+		return
 	}
+	log.Printf("Shutdown completed \n")
 }
@@ -0,0 +1,477 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"common/sqlddl"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+const schemaTemplate = `package schema
+
+import (
+{{- range .StdImports}}
+	"{{.}}"
+{{- end}}
+{{- if .StdImports}}
+{{end}}
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+{{- if .Enums}}
+{{range .Enums}}
+// {{.Doc}}
+const (
+{{- range .Values}}
+	{{.Name}} {{.Type}} = {{.Value}} // {{.Label}}
+{{- end}}
+)
+{{end}}
+{{- end}}
+
+// {{.Type}} holds the schema definition for the {{.Type}} entity.
+type {{.Type}} struct {
+	ent.Schema
+}
+
+// Fields of the {{.Type}}.
+func ({{.Type}}) Fields() []ent.Field {
+	return []ent.Field{
+{{- range .Fields}}
+		{{.}},
+{{- end}}
+	}
+}
+
+// Edges of the {{.Type}}.
+func ({{.Type}}) Edges() []ent.Edge {
+	return {{.Edges}}
+}
+{{- if .Indexes}}
+
+// Indexes of the {{.Type}}.
+func ({{.Type}}) Indexes() []ent.Index {
+	return []ent.Index{
+{{- range .Indexes}}
+		{{.}},
+{{- end}}
+	}
+}
+{{- end}}
+
+func ({{.Type}}) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: {{printf "%q" .Table}}},
+{{- if .Comment}}
+		schema.Comment({{printf "%q" .Comment}}),
+{{- end}}
+		entsql.WithComments(true),
+	}
+}
+`
+
+var tmpl = template.Must(template.New("schema").Parse(schemaTemplate))
+
+type schemaData struct {
+	Table      string
+	Type       string
+	Comment    string
+	StdImports []string
+	Imports    []string
+	Enums      []enumData
+	Fields     []string
+	Edges      string
+	Indexes    []string
+}
+
+// enumData tinyint 状态列根据注释生成的常量
+type enumData struct {
+	Doc    string
+	Values []enumValue
+}
+
+type enumValue struct {
+	Name  string
+	Type  string
+	Value string
+	Label string
+}
+
+// generator 生成单个表的 schema，收集用到的 import
+type generator struct {
+	table   *sqlddl.Table
+	old     *existingSchema // 已有的 schema 文件，没有时为 nil
+	imports map[string]bool
+	enums   map[string]*enumData
+}
+
+// generate 生成表对应的 schema 源码，old 不为 nil 时保留其中的手写内容：
+// 状态常量名、字段和索引前的注释、字段校验方法以及 Edges()
+func generate(t *sqlddl.Table, old *existingSchema) ([]byte, error) {
+	g := &generator{
+		table: t,
+		old:   old,
+		imports: map[string]bool{
+			"entgo.io/ent":                true,
+			"entgo.io/ent/dialect/entsql": true,
+			"entgo.io/ent/schema":         true,
+			"entgo.io/ent/schema/field":   true,
+		},
+		enums: make(map[string]*enumData),
+	}
+	data := schemaData{
+		Table:   t.Name,
+		Type:    toCamelCase(t.Name),
+		Comment: t.Comment,
+	}
+	for _, c := range t.Columns {
+		if e := g.statusEnum(c); e != nil {
+			g.enums[c.Name] = e
+			data.Enums = append(data.Enums, *e)
+		}
+		f, err := g.field(c)
+		if err != nil {
+			return nil, err
+		}
+		data.Fields = append(data.Fields, f)
+	}
+	data.Indexes = g.indexes()
+	data.Edges = "[]ent.Edge{}"
+	if old != nil && old.edges != "" {
+		data.Edges = old.edges
+		if strings.Contains(old.edges, "edge.") {
+			g.imports["entgo.io/ent/schema/edge"] = true
+		}
+	}
+	for imp := range g.imports {
+		if strings.Contains(imp, ".") {
+			data.Imports = append(data.Imports, imp)
+		} else {
+			data.StdImports = append(data.StdImports, imp)
+		}
+	}
+	sort.Strings(data.StdImports)
+	sort.Strings(data.Imports)
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format: %w\n%s", err, buf.Bytes())
+	}
+	return src, nil
+}
+
+// field 生成列对应的 ent 字段
+func (g *generator) field(c *sqlddl.Column) (string, error) {
+	typ, err := g.fieldType(c)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if g.old != nil {
+		if c := g.old.fieldComments[c.Name]; c != "" {
+			b.WriteString(c + "\n\t\t")
+		}
+	}
+	b.WriteString(typ)
+	if g.old != nil {
+		b.WriteString(g.old.fieldExtras[c.Name])
+	}
+
+	isID := c.Name == "id" && len(g.table.PrimaryKey) == 1 && g.table.PrimaryKey[0] == "id"
+	switch {
+	case isID:
+		b.WriteString(".Immutable().Unique()")
+	case c.Nullable:
+		b.WriteString(".Optional()")
+	}
+	if c.Name == "created_at" {
+		b.WriteString(".Immutable()")
+	}
+	if c.Name == "password" {
+		b.WriteString(".Sensitive()")
+	}
+	if c.Default != nil {
+		def, err := g.defaultValue(c)
+		if err != nil {
+			return "", err
+		}
+		if def != "" {
+			b.WriteString(".Default(" + def + ")")
+		}
+	}
+	if c.Comment != "" {
+		b.WriteString(".Comment(" + strconv.Quote(c.Comment) + ")")
+	}
+	if c.Name != "password" {
+		b.WriteString(".StructTag(`json:\"" + c.Name + "\"`)")
+	}
+	if isID && !c.AutoIncrement {
+		b.WriteString(".\n\t\t\tAnnotations(entsql.Annotation{Incremental: new(bool)})")
+	}
+	return b.String(), nil
+}
+
+var intTypes = map[string]string{
+	"tinyint":   "Int8",
+	"smallint":  "Int16",
+	"mediumint": "Int32",
+	"int":       "Int32",
+	"bigint":    "Int64",
+}
+
+// fieldType MySQL 类型到 ent 字段构造函数，ent 默认映射不一致的类型通过 SchemaType 指定
+func (g *generator) fieldType(c *sqlddl.Column) (string, error) {
+	name := strconv.Quote(c.Name)
+	switch c.Type {
+	case "tinyint", "smallint", "mediumint", "int", "bigint":
+		if c.Type == "tinyint" && c.Size == 1 && !c.Unsigned {
+			return "field.Bool(" + name + ")", nil
+		}
+		t := intTypes[c.Type]
+		if c.Unsigned {
+			t = "U" + strings.ToLower(t[:1]) + t[1:]
+		}
+		if c.Type == "mediumint" {
+			return "field." + t + "(" + name + ")" + g.schemaType(c), nil
+		}
+		return "field." + t + "(" + name + ")", nil
+	case "varchar":
+		return fmt.Sprintf("field.String(%s).MaxLen(%d)", name, c.Size), nil
+	case "char":
+		return fmt.Sprintf("field.String(%s).MaxLen(%d)", name, c.Size) + g.schemaType(c), nil
+	case "longtext":
+		return "field.Text(" + name + ")", nil
+	case "tinytext", "text", "mediumtext":
+		return "field.Text(" + name + ")" + g.schemaType(c), nil
+	case "decimal", "numeric":
+		return "field.Float(" + name + ")" + g.schemaType(c), nil
+	case "float":
+		return "field.Float32(" + name + ")", nil
+	case "double":
+		return "field.Float(" + name + ")", nil
+	case "timestamp":
+		return "field.Time(" + name + ")", nil
+	case "datetime", "date":
+		return "field.Time(" + name + ")" + g.schemaType(c), nil
+	case "json":
+		g.imports["encoding/json"] = true
+		return "field.JSON(" + name + ", json.RawMessage{})", nil
+	case "enum":
+		quoted := make([]string, len(c.Values))
+		for i, v := range c.Values {
+			quoted[i] = strconv.Quote(v)
+		}
+		return "field.Enum(" + name + ").Values(" + strings.Join(quoted, ", ") + ")", nil
+	case "set":
+		return "field.String(" + name + ")" + g.schemaType(c), nil
+	case "binary", "varbinary", "tinyblob", "blob", "mediumblob", "longblob":
+		return "field.Bytes(" + name + ")" + g.schemaType(c), nil
+	default:
+		return "", fmt.Errorf("column %s: unsupported type %s", c.Name, c.FullType())
+	}
+}
+
+func (g *generator) schemaType(c *sqlddl.Column) string {
+	g.imports["entgo.io/ent/dialect"] = true
+	return ".SchemaType(map[string]string{dialect.MySQL: " + strconv.Quote(c.FullType()) + "})"
+}
+
+// defaultValue 默认值对应的 Go 表达式，无法表示时返回空串
+func (g *generator) defaultValue(c *sqlddl.Column) (string, error) {
+	v := *c.Default
+	switch {
+	case c.Type == "tinyint" && c.Size == 1 && !c.Unsigned:
+		return strconv.FormatBool(v != "0"), nil
+	case c.IsInteger():
+		if e := g.enums[c.Name]; e != nil {
+			for _, ev := range e.Values {
+				if ev.Value == v {
+					return ev.Name, nil
+				}
+			}
+		}
+		if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+			return "", fmt.Errorf("column %s: invalid default %s", c.Name, v)
+		}
+		return v, nil
+	case c.Type == "decimal" || c.Type == "numeric" || c.Type == "float" || c.Type == "double":
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			return "", fmt.Errorf("column %s: invalid default %s", c.Name, v)
+		}
+		return v, nil
+	case c.Type == "timestamp" || c.Type == "datetime" || c.Type == "date":
+		if strings.HasPrefix(strings.ToUpper(v), "CURRENT_TIMESTAMP") || strings.HasPrefix(strings.ToUpper(v), "NOW") {
+			g.imports["time"] = true
+			return "time.Now", nil
+		}
+		// 固定时间的默认值 ent 不支持，忽略
+		fmt.Fprintf(os.Stderr, "warning: %s.%s: default %s ignored\n", g.table.Name, c.Name, v)
+		return "", nil
+	case c.Type == "json" || strings.HasSuffix(c.Type, "blob") || strings.HasSuffix(c.Type, "binary"):
+		fmt.Fprintf(os.Stderr, "warning: %s.%s: default %s ignored\n", g.table.Name, c.Name, v)
+		return "", nil
+	default:
+		return strconv.Quote(v), nil
+	}
+}
+
+// statusPattern 匹配注释中的取值说明，如 1-正常、0：未启用
+var statusPattern = regexp.MustCompile(`(-?\d+)\s*[-:：=]\s*([^，,；;、）)\s]+)`)
+
+// statusEnum 对 tinyint 状态列，按注释中的取值说明生成常量，如 账号状态（1-正常，0-封禁）
+func (g *generator) statusEnum(c *sqlddl.Column) *enumData {
+	if c.Type != "tinyint" || c.Size == 1 {
+		return nil
+	}
+	matches := statusPattern.FindAllStringSubmatch(c.Comment, -1)
+	if len(matches) < 2 {
+		return nil
+	}
+	typ := "int8"
+	if c.Unsigned {
+		typ = "uint8"
+	}
+	prefix := toCamelCase(g.table.Name) + toCamelCase(c.Name)
+	e := &enumData{Doc: c.Comment}
+	if i := strings.IndexAny(c.Comment, "（("); i > 0 {
+		e.Doc = c.Comment[:i]
+	}
+	names, doc := g.old.enumNames(prefix)
+	if doc != "" {
+		e.Doc = doc
+	}
+	seen := make(map[string]bool)
+	for _, m := range matches {
+		if seen[m[1]] {
+			continue
+		}
+		seen[m[1]] = true
+		e.Values = append(e.Values, enumValue{
+			Name:  g.enumName(c, prefix, m[1], m[2], names),
+			Type:  typ,
+			Value: m[1],
+			Label: m[2],
+		})
+	}
+	sort.Slice(e.Values, func(i, j int) bool {
+		a, _ := strconv.Atoi(e.Values[i].Value)
+		b, _ := strconv.Atoi(e.Values[j].Value)
+		return a < b
+	})
+	return e
+}
+
+// statusLabels 常见状态说明对应的常量名后缀
+var statusLabels = map[string]string{
+	"正常":  "Normal",
+	"封禁":  "Banned",
+	"禁用":  "Disabled",
+	"注销":  "Logoff",
+	"启用":  "Enabled",
+	"未启用": "Disabled",
+	"停用":  "Disabled",
+	"删除":  "Deleted",
+	"已删除": "Deleted",
+	"草稿":  "Draft",
+	"待审核": "Pending",
+	"审核中": "Reviewing",
+	"通过":  "Approved",
+	"已通过": "Approved",
+	"拒绝":  "Rejected",
+	"已拒绝": "Rejected",
+	"隐藏":  "Hidden",
+	"私密":  "Private",
+	"公开":  "Public",
+}
+
+var identPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// enumName 状态常量名，依次使用已有文件中的常量名、按取值说明命名、按数值命名
+func (g *generator) enumName(c *sqlddl.Column, prefix, value, label string, existing map[string]string) string {
+	if name, ok := existing[value]; ok {
+		return name
+	}
+	if suffix, ok := statusLabels[label]; ok {
+		return prefix + suffix
+	}
+	if identPattern.MatchString(label) {
+		return prefix + toCamelCase(label)
+	}
+	name := prefix + strings.Replace(value, "-", "Neg", 1)
+	fmt.Fprintf(os.Stderr, "warning: %s.%s: no name for %s-%s, generated %s, rename it manually\n", g.table.Name, c.Name, value, label, name)
+	return name
+}
+
+// indexes 生成 Indexes()，索引注释 ent 不支持，作为代码注释保留
+func (g *generator) indexes() []string {
+	var out []string
+	if pk := g.table.PrimaryKey; len(pk) > 1 || (len(pk) == 1 && pk[0] != "id") {
+		fmt.Fprintf(os.Stderr, "warning: %s: primary key (%s) is not supported by ent, add it manually\n",
+			g.table.Name, strings.Join(pk, ", "))
+		out = append(out, fmt.Sprintf("// TODO 主键 (%s) 需要手动处理，ent 只支持名为 id 的单列主键\n\t\tindex.Fields(%s).Unique()",
+			strings.Join(pk, ", "), quoteAll(pk)))
+	}
+	for _, idx := range g.table.Indexes {
+		var b strings.Builder
+		comment := idx.Comment
+		if comment != "" {
+			comment = "// " + comment
+		}
+		// 已有的索引使用文件中的注释
+		if g.old != nil {
+			if c, ok := g.old.indexes[idx.Name]; ok {
+				comment = c
+			}
+		}
+		if comment != "" {
+			b.WriteString(comment + "\n\t\t")
+		}
+		b.WriteString("index.Fields(" + quoteAll(idx.Columns) + ")")
+		if idx.Unique {
+			b.WriteString(".Unique()")
+		}
+		b.WriteString(".StorageKey(" + strconv.Quote(idx.Name) + ")")
+		if idx.Type != "" {
+			b.WriteString(".Annotations(entsql.IndexType(" + strconv.Quote(strings.ToUpper(idx.Type)) + "))")
+		}
+		out = append(out, b.String())
+	}
+	if len(out) > 0 {
+		g.imports["entgo.io/ent/schema/index"] = true
+	}
+	return out
+}
+
+func quoteAll(ss []string) string {
+	quoted := make([]string, len(ss))
+	for i, s := range ss {
+		quoted[i] = strconv.Quote(s)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func toCamelCase(s string) string {
+	parts := strings.Split(s, "_")
+	caser := cases.Title(language.English)
+	for i, part := range parts {
+		if len(part) > 0 {
+			parts[i] = caser.String(strings.ToLower(part))
+		}
+	}
+	return strings.Join(parts, "")
+}
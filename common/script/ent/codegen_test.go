@@ -0,0 +1,192 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"common/sqlddl"
+)
+
+var update = flag.Bool("update", false, "更新 testdata 中的 golden 文件")
+
+// 服务中维护的建表语句和 schema，按文档中的流程重新生成时不应有变化
+var (
+	serviceSQL    = filepath.Join("..", "..", "..", "user", "internal", "sql", "all.sql")
+	serviceSchema = filepath.Join("..", "..", "..", "user", "internal", "ent", "schema")
+)
+
+func parseTestTables(t *testing.T) []*sqlddl.Table {
+	t.Helper()
+	tables, err := sqlddl.ParseFile(filepath.Join("..", "..", "sqlddl", "testdata", "all.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tables
+}
+
+// TestGenerateGolden 没有已有文件时生成的 schema
+func TestGenerateGolden(t *testing.T) {
+	for _, table := range parseTestTables(t) {
+		t.Run(table.Name, func(t *testing.T) {
+			got, err := generate(table, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			golden := filepath.Join("testdata", table.Name+".go.golden")
+			if *update {
+				if err := os.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("differs from %s, run go test -update to accept:\n%s", golden, unifiedDiff(golden, string(want), string(got)))
+			}
+		})
+	}
+}
+
+// TestGenerateKeepsServiceSchema 对服务中的 schema 重新生成，常量名、Edges 和手写注释保持不变
+func TestGenerateKeepsServiceSchema(t *testing.T) {
+	tables, err := sqlddl.ParseFile(serviceSQL)
+	if os.IsNotExist(err) {
+		t.Skip("service schema not found")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, table := range tables {
+		t.Run(table.Name, func(t *testing.T) {
+			path := filepath.Join(serviceSchema, table.Name+".go")
+			old, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			existing, err := parseExisting(old)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := existing.checkSupported(path); err != nil {
+				t.Fatal(err)
+			}
+			got, err := generate(table, existing)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(old) {
+				t.Errorf("regenerating changes %s:\n%s", path, unifiedDiff(path, string(old), string(got)))
+			}
+		})
+	}
+}
+
+const existingSrc = `package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// 文章状态
+const (
+	PostStateHidden int8 = 0 // 隐藏
+	PostStateShown  int8 = 1 // 显示
+)
+
+type Post struct {
+	ent.Schema
+}
+
+func (Post) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("id").Immutable().Unique(),
+		// 标题不能为空
+		// 长度由数据库限制
+		field.String("title").MaxLen(100).NotEmpty().Comment("old"),
+		field.Int8("state").Default(PostStateShown), // 行尾注释
+		field.Uint32("user_id").Positive(),
+	}
+}
+
+func (Post) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("posts").Field("user_id").Unique(),
+	}
+}
+`
+
+func TestGenerateMergesExisting(t *testing.T) {
+	tables, err := sqlddl.Parse("CREATE TABLE `post` (" +
+		"`id` int unsigned NOT NULL AUTO_INCREMENT," +
+		"`title` varchar(100) NOT NULL COMMENT '标题'," +
+		"`state` tinyint NOT NULL DEFAULT 1 COMMENT '文章状态（0-隐藏，1-显示，2-删除，3-置顶）'," +
+		"`user_id` int unsigned NOT NULL," +
+		"PRIMARY KEY (`id`))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	existing, err := parseExisting([]byte(existingSrc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := generate(tables[0], existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(src)
+	for _, want := range []string{
+		"// 文章状态\nconst (",
+		"PostStateHidden  int8 = 0 // 隐藏",
+		"PostStateShown   int8 = 1 // 显示",
+		"PostStateDeleted int8 = 2 // 删除", // 按常见说明命名
+		"PostState3       int8 = 3 // 置顶", // 无法命名时使用数值
+		"// 标题不能为空\n\t\t// 长度由数据库限制\n\t\tfield.String(\"title\").MaxLen(100).NotEmpty().Comment(\"标题\")",
+		"field.Int8(\"state\").Default(PostStateShown)",
+		"field.Uint32(\"user_id\").Positive()",
+		"edge.From(\"user\", User.Type).Ref(\"posts\").Field(\"user_id\").Unique(),",
+		"\"entgo.io/ent/schema/edge\"",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated schema missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "行尾注释") {
+		t.Errorf("trailing comment of previous field should not move:\n%s", got)
+	}
+}
+
+func TestCheckSupported(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		ok   bool
+	}{
+		{"generated", existingSrc, true},
+		{"mixin", existingSrc + "\nfunc (Post) Mixin() []ent.Mixin { return nil }\n", false},
+		{"hooks", existingSrc + "\nfunc (Post) Hooks() []ent.Hook { return nil }\n", false},
+		{"helper func", existingSrc + "\nfunc helper() {}\n", false},
+		{"var", existingSrc + "\nvar x = 1\n", false},
+		{"const not int", existingSrc + "\nconst name = \"post\"\n", false},
+		{"edges not a literal", strings.Replace(existingSrc, "return []ent.Edge{", "if true {\n\t\treturn nil\n\t}\n\treturn []ent.Edge{", 1), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := parseExisting([]byte(tt.src))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := e.checkSupported("post.go"); (err == nil) != tt.ok {
+				t.Errorf("checkSupported() error = %v, want ok=%v", err, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// keepMethods 字段上手写的校验、类型方法，建表语句中没有对应信息，重新生成时保留
+var keepMethods = map[string]bool{
+	"NotEmpty":     true,
+	"Positive":     true,
+	"Negative":     true,
+	"NonNegative":  true,
+	"Min":          true,
+	"Max":          true,
+	"Range":        true,
+	"MinLen":       true,
+	"Match":        true,
+	"Validate":     true,
+	"Nillable":     true,
+	"GoType":       true,
+	"ValueScanner": true,
+}
+
+// generatedMethods 生成器会生成的 schema 方法，其他方法（Mixin、Hooks、Policy 等）需要手动维护
+var generatedMethods = map[string]bool{
+	"Fields":      true,
+	"Edges":       true,
+	"Indexes":     true,
+	"Annotations": true,
+}
+
+// existingSchema 已有 schema 文件中需要保留的手写内容
+type existingSchema struct {
+	consts        []constBlock
+	fieldComments map[string]string // 字段名 -> 字段前的注释
+	fieldExtras   map[string]string // 字段名 -> 手写的方法调用，如 .NotEmpty()
+	indexes       map[string]string // 索引名 -> 索引前的注释，没有注释时为空串
+	edges         string            // Edges() 返回的表达式
+	unsupported   []string          // 生成器无法生成的声明
+}
+
+type constBlock struct {
+	doc   string
+	names map[string]string // 值 -> 常量名
+}
+
+// enumNames 按常量名前缀查找已有的状态常量，返回值到常量名的映射和常量块的注释
+func (e *existingSchema) enumNames(prefix string) (map[string]string, string) {
+	if e == nil {
+		return nil, ""
+	}
+	for _, b := range e.consts {
+		for _, name := range b.names {
+			if strings.HasPrefix(name, prefix) {
+				return b.names, b.doc
+			}
+		}
+	}
+	return nil, ""
+}
+
+// parseExisting 解析已有的 schema 文件
+func parseExisting(src []byte) (*existingSchema, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	e := &existingSchema{
+		fieldComments: make(map[string]string),
+		fieldExtras:   make(map[string]string),
+		indexes:       make(map[string]string),
+	}
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			switch d.Tok {
+			case token.IMPORT, token.TYPE:
+			case token.CONST:
+				b, ok := parseConstBlock(d)
+				if !ok {
+					e.unsupported = append(e.unsupported, "const "+declNames(d))
+					continue
+				}
+				e.consts = append(e.consts, b)
+			default:
+				e.unsupported = append(e.unsupported, d.Tok.String()+" "+declNames(d))
+			}
+		case *ast.FuncDecl:
+			if d.Recv == nil || !generatedMethods[d.Name.Name] {
+				e.unsupported = append(e.unsupported, "func "+d.Name.Name)
+				continue
+			}
+			elts, ret := returnedElements(d)
+			if ret == nil {
+				e.unsupported = append(e.unsupported, "func "+d.Name.Name)
+				continue
+			}
+			switch d.Name.Name {
+			case "Fields":
+				for i, elt := range elts {
+					name, ok := rootArg(elt, "field")
+					if !ok {
+						continue
+					}
+					if c := leadingComments(fset, file, elts, i, d); c != "" {
+						e.fieldComments[name] = c
+					}
+					if extras := keptCalls(fset, elt); extras != "" {
+						e.fieldExtras[name] = extras
+					}
+				}
+			case "Indexes":
+				for i, elt := range elts {
+					if key, ok := callArg(elt, "StorageKey"); ok {
+						e.indexes[key] = leadingComments(fset, file, elts, i, d)
+					}
+				}
+			case "Edges":
+				if lit, ok := ret.(*ast.CompositeLit); !ok || len(lit.Elts) > 0 {
+					e.edges = string(src[fset.Position(ret.Pos()).Offset:fset.Position(ret.End()).Offset])
+				}
+			}
+		}
+	}
+	return e, nil
+}
+
+// parseConstBlock 解析状态常量块，只支持 Name Type = 整数 的形式
+func parseConstBlock(d *ast.GenDecl) (constBlock, bool) {
+	b := constBlock{names: make(map[string]string)}
+	if d.Doc != nil {
+		b.doc = strings.TrimSpace(d.Doc.Text())
+	}
+	for _, spec := range d.Specs {
+		vs := spec.(*ast.ValueSpec)
+		if len(vs.Names) != 1 || len(vs.Values) != 1 {
+			return b, false
+		}
+		v, ok := intLiteral(vs.Values[0])
+		if !ok {
+			return b, false
+		}
+		b.names[v] = vs.Names[0].Name
+	}
+	return b, true
+}
+
+func intLiteral(expr ast.Expr) (string, bool) {
+	switch x := expr.(type) {
+	case *ast.BasicLit:
+		return x.Value, x.Kind == token.INT
+	case *ast.UnaryExpr:
+		if v, ok := intLiteral(x.X); ok && x.Op == token.SUB {
+			return "-" + v, true
+		}
+	}
+	return "", false
+}
+
+func declNames(d *ast.GenDecl) string {
+	var names []string
+	for _, spec := range d.Specs {
+		if vs, ok := spec.(*ast.ValueSpec); ok {
+			for _, n := range vs.Names {
+				names = append(names, n.Name)
+			}
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// returnedElements 方法体为 return []T{...} 时返回其中的元素
+func returnedElements(d *ast.FuncDecl) ([]ast.Expr, ast.Expr) {
+	if d.Body == nil || len(d.Body.List) != 1 {
+		return nil, nil
+	}
+	ret, ok := d.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return nil, nil
+	}
+	if lit, ok := ret.Results[0].(*ast.CompositeLit); ok {
+		return lit.Elts, lit
+	}
+	return nil, ret.Results[0]
+}
+
+// chain 方法链中的调用，从内到外，如 field.String("a").MaxLen(1) 为 String、MaxLen
+func chain(expr ast.Expr) []*ast.CallExpr {
+	var calls []*ast.CallExpr
+	for {
+		call, ok := expr.(*ast.CallExpr)
+		if !ok {
+			break
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			break
+		}
+		calls = append([]*ast.CallExpr{call}, calls...)
+		expr = sel.X
+	}
+	return calls
+}
+
+func callName(call *ast.CallExpr) string {
+	return call.Fun.(*ast.SelectorExpr).Sel.Name
+}
+
+// rootArg 方法链以 pkg.Xxx("name") 开始时返回 name
+func rootArg(expr ast.Expr, pkg string) (string, bool) {
+	calls := chain(expr)
+	if len(calls) == 0 {
+		return "", false
+	}
+	if id, ok := calls[0].Fun.(*ast.SelectorExpr).X.(*ast.Ident); !ok || id.Name != pkg {
+		return "", false
+	}
+	return firstStringArg(calls[0])
+}
+
+// callArg 方法链中 method("arg") 的参数
+func callArg(expr ast.Expr, method string) (string, bool) {
+	for _, call := range chain(expr) {
+		if callName(call) == method {
+			return firstStringArg(call)
+		}
+	}
+	return "", false
+}
+
+func firstStringArg(call *ast.CallExpr) (string, bool) {
+	if len(call.Args) == 0 {
+		return "", false
+	}
+	lit, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+// keptCalls 字段上需要保留的方法调用，按原顺序拼接
+func keptCalls(fset *token.FileSet, expr ast.Expr) string {
+	var b strings.Builder
+	for _, call := range chain(expr) {
+		if !keepMethods[callName(call)] {
+			continue
+		}
+		b.WriteString("." + callName(call) + "(")
+		for i, arg := range call.Args {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			var buf bytes.Buffer
+			_ = printer.Fprint(&buf, fset, arg)
+			b.Write(buf.Bytes())
+		}
+		b.WriteString(")")
+	}
+	return b.String()
+}
+
+// leadingComments 元素前单独成行的注释，多行之间用换行和缩进连接
+func leadingComments(fset *token.FileSet, file *ast.File, elts []ast.Expr, i int, d *ast.FuncDecl) string {
+	from := d.Body.Lbrace
+	if i > 0 {
+		from = elts[i-1].End()
+	}
+	var lines []string
+	for _, cg := range file.Comments {
+		if cg.Pos() <= from || cg.End() >= elts[i].Pos() {
+			continue
+		}
+		// 跟在上一个元素同一行末尾的注释不属于当前元素
+		if i > 0 && fset.Position(cg.Pos()).Line == fset.Position(elts[i-1].End()).Line {
+			continue
+		}
+		for _, c := range cg.List {
+			lines = append(lines, c.Text)
+		}
+	}
+	return strings.Join(lines, "\n\t\t")
+}
+
+// checkSupported 已有文件包含生成器无法生成的内容时返回错误，避免覆盖后丢失
+func (e *existingSchema) checkSupported(path string) error {
+	if e == nil || len(e.unsupported) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s contains hand-written %s, update it manually", path, strings.Join(e.unsupported, ", "))
+}
@@ -1,325 +1,235 @@
+// gen_ent 根据 MySQL 表结构生成 ent schema
+//
+// 表结构来源（二选一）：
+//
+//	-sql internal/sql/all.sql         离线解析建表语句，不需要数据库
+//	-dsn user:pass@tcp(host:3306)/db  连接数据库读取 SHOW CREATE TABLE
+//	-config user/config/config.toml   使用服务配置中的 [mysql.master]，密码支持 secret:// 引用
+//
+// 示例（在 common 目录下）：
+//
+//	go run ./script/ent -sql ../user/internal/sql/all.sql -out ../user/internal/ent/schema -tables school,major -diff
+//
+// -diff 只输出与现有文件的差异，不写入；确认后去掉 -diff 重新执行覆盖。
+// 覆盖已有文件时保留状态常量名、字段和索引前的注释、字段校验方法（如 NotEmpty）和 Edges()，
+// 文件中有其他手写内容（Mixin、Hooks 等方法或其他声明）时不覆盖，需要手动修改
 package main
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"flag"
 	"fmt"
-	"go/format"
 	"os"
+	"path/filepath"
 	"strings"
-	"text/template"
 
-	_ "github.com/go-sql-driver/mysql"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
-)
-
-type TableColumn struct {
-	Field      string
-	Type       string
-	Null       string
-	Key        string
-	Default    sql.NullString
-	Extra      sql.NullString
-	Privileges sql.NullString
-	Comment    string
-}
-
-type TableInfo struct {
-	Name    string
-	Columns []TableColumn
-}
-
-const schemaTemplate = `package schema
+	"common/secrets"
+	"common/sqlddl"
 
-import (
-	"entgo.io/ent"
-	"entgo.io/ent/dialect/entsql"
-	"entgo.io/ent/schema"
-	"entgo.io/ent/schema/field"
+	"github.com/BurntSushi/toml"
+	_ "github.com/go-sql-driver/mysql"
 )
 
-// {{.Name | ToCamel}} holds the schema definition for the {{.Name | ToCamel}} entity.
-type {{.Name | ToCamel}} struct {
-	ent.Schema
+type options struct {
+	sqlFile string
+	dsn     string
+	config  string
+	out     string
+	tables  map[string]bool
+	diff    bool
 }
 
-// Fields of the {{.Name | ToCamel}}.
-func ({{.Name | ToCamel}}) Fields() []ent.Field {
-	return []ent.Field{
-{{- range .Columns}}
-		{{. | GenerateField}},
-{{- end}}
+func main() {
+	var (
+		o      options
+		tables string
+	)
+	flag.StringVar(&o.sqlFile, "sql", "", "建表语句文件，离线解析")
+	flag.StringVar(&o.dsn, "dsn", "", "MySQL DSN，如 root:pass@tcp(localhost:3306)/db")
+	flag.StringVar(&o.config, "config", "", "服务的 config.toml，使用 [mysql.master] 连接数据库")
+	flag.StringVar(&o.out, "out", "", "schema 输出目录，指定 -config 时默认为服务的 internal/ent/schema")
+	flag.StringVar(&tables, "tables", "", "只生成这些表，逗号分隔，默认全部")
+	flag.BoolVar(&o.diff, "diff", false, "只显示与现有文件的差异，不写入")
+	flag.Parse()
+
+	if tables != "" {
+		o.tables = make(map[string]bool)
+		for _, t := range strings.Split(tables, ",") {
+			o.tables[strings.TrimSpace(t)] = true
+		}
 	}
-}
-
-// Edges of the {{.Name | ToCamel}}.
-func ({{.Name | ToCamel}}) Edges() []ent.Edge {
-	return []ent.Edge{}
-}
-
-func ({{.Name | ToCamel}}) Annotations() []schema.Annotation {
-	return []schema.Annotation{
-		entsql.Annotation{Table: "{{.Name}}"},
+	if err := run(o); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
 }
-`
 
-func main() {
-	// 连接数据库
-	dsn := "root:root123@tcp(localhost:3306)/db1"
-	db, err := sql.Open("mysql", dsn)
-	if err != nil {
-		panic(err)
+func run(o options) error {
+	if o.out == "" && o.config != "" {
+		// config.toml 位于 {service}/config 下
+		o.out = filepath.Join(filepath.Dir(o.config), "..", "internal", "ent", "schema")
+	}
+	if o.out == "" {
+		return errors.New("-out is required")
 	}
-	defer db.Close()
 
-	// 获取所有表名
-	tables, err := getTables(db)
+	tables, err := loadTables(o)
 	if err != nil {
-		panic(err)
+		return err
 	}
-
-	// 为每个表生成 schema
-	for _, table := range tables {
-		err := generateSchema(db, table)
-		if err != nil {
-			fmt.Printf("Error generating schema for table %s: %v\n", table, err)
-		} else {
-			fmt.Printf("Successfully generated schema for table %s\n", table)
-		}
+	if len(tables) == 0 {
+		return errors.New("no table found")
 	}
-}
 
-func getTables(db *sql.DB) ([]string, error) {
-	rows, err := db.Query("SHOW TABLES")
-	if err != nil {
-		return nil, err
+	if !o.diff {
+		if err := os.MkdirAll(o.out, 0755); err != nil {
+			return err
+		}
 	}
-	defer rows.Close()
-
-	var tables []string
-	for rows.Next() {
-		var tableName string
-		if err := rows.Scan(&tableName); err != nil {
-			return nil, err
+	for _, t := range tables {
+		path := filepath.Join(o.out, t.Name+".go")
+		old, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		var existing *existingSchema
+		if len(old) > 0 {
+			if existing, err = parseExisting(old); err != nil {
+				return fmt.Errorf("parse %s: %w", path, err)
+			}
+			if err := existing.checkSupported(path); err != nil {
+				return err
+			}
+		}
+		src, err := generate(t, existing)
+		if err != nil {
+			return fmt.Errorf("generate %s: %w", t.Name, err)
+		}
+		if string(old) == string(src) {
+			fmt.Printf("%s: unchanged\n", path)
+			continue
 		}
-		tables = append(tables, tableName)
+		if o.diff {
+			fmt.Print(unifiedDiff(path, string(old), string(src)))
+			continue
+		}
+		if err := os.WriteFile(path, src, 0644); err != nil {
+			return err
+		}
+		fmt.Printf("%s: written\n", path)
 	}
-	return tables, nil
+	return nil
 }
 
-func generateSchema(db *sql.DB, tableName string) error {
-	// 获取表结构
-	columns, err := getTableColumns(db, tableName)
-	if err != nil {
-		return err
-	}
-
-	// 准备模板数据
-	tableInfo := TableInfo{
-		Name:    tableName,
-		Columns: columns,
-	}
-
-	// 解析模板
-	funcMap := template.FuncMap{
-		"ToCamel":       toCamelCase,
-		"GenerateField": generateField,
-		"EscapeString":  escapeString,
+// loadTables 按 -sql、-dsn、-config 的优先级读取表结构，并按 -tables 过滤
+func loadTables(o options) ([]*sqlddl.Table, error) {
+	var (
+		tables []*sqlddl.Table
+		err    error
+	)
+	switch {
+	case o.sqlFile != "":
+		tables, err = sqlddl.ParseFile(o.sqlFile)
+	case o.dsn != "":
+		tables, err = loadFromDB(o.dsn, o.tables)
+	case o.config != "":
+		var dsn string
+		if dsn, err = dsnFromConfig(o.config); err == nil {
+			tables, err = loadFromDB(dsn, o.tables)
+		}
+	default:
+		return nil, errors.New("one of -sql, -dsn, -config is required")
 	}
-
-	tmpl, err := template.New("schema").Funcs(funcMap).Parse(schemaTemplate)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// 生成代码
-	var buf strings.Builder
-	err = tmpl.Execute(&buf, tableInfo)
-	if err != nil {
-		return err
+	if o.tables == nil {
+		return tables, nil
 	}
-
-	// 格式化代码
-	formatted, err := format.Source([]byte(buf.String()))
-	if err != nil {
-		// 如果格式化失败，至少保存原始代码
-		formatted = []byte(buf.String())
+	var filtered []*sqlddl.Table
+	for _, t := range tables {
+		if o.tables[t.Name] {
+			filtered = append(filtered, t)
+		}
 	}
+	return filtered, nil
+}
 
-	// 确保目录存在
-	err = os.MkdirAll("./ent/schema", 0755)
+// loadFromDB 读取 SHOW CREATE TABLE 并解析
+func loadFromDB(dsn string, only map[string]bool) ([]*sqlddl.Table, error) {
+	db, err := sql.Open("mysql", dsn)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer db.Close()
 
-	// 写入文件
-	filename := fmt.Sprintf("./ent/schema/%s.go", tableName)
-	return os.WriteFile(filename, formatted, 0644)
-}
-
-func getTableColumns(db *sql.DB, tableName string) ([]TableColumn, error) {
-	query := fmt.Sprintf("SHOW FULL COLUMNS FROM `%s`", tableName)
-	rows, err := db.Query(query)
+	rows, err := db.Query("SHOW TABLES")
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-
-	var columns []TableColumn
+	var names []string
 	for rows.Next() {
-		var col TableColumn
-		var collation, extra, privileges sql.NullString
-		err := rows.Scan(
-			&col.Field, &col.Type, &collation, &col.Null, &col.Key,
-			&col.Default, &extra, &privileges, &col.Comment,
-		)
-		if err != nil {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
 			return nil, err
 		}
-		col.Extra = extra
-		col.Privileges = privileges
-		columns = append(columns, col)
-	}
-	return columns, nil
-}
-
-func generateField(col TableColumn) string {
-	// 根据 MySQL 类型生成 Ent 字段
-	fieldType := mapMySQLTypeToEntType(col.Type)
-
-	// 构建字段定义
-	fieldDef := fmt.Sprintf("field.%s(\"%s\")", fieldType, col.Field)
-
-	// 添加主键约束
-	if col.Key == "PRI" {
-		fieldDef += ".Immutable().Unique()"
-	}
-
-	// 添加可选性
-	if col.Null == "YES" {
-		fieldDef += ".Optional()"
-	}
-
-	// 添加默认值
-	if col.Default.Valid && col.Default.String != "" {
-		defaultValue := formatDefaultValue(col.Default.String, fieldType)
-		if defaultValue != "" {
-			fieldDef += fmt.Sprintf(".Default(%s)", defaultValue)
+		if only == nil || only[name] {
+			names = append(names, name)
 		}
 	}
-
-	// 添加注释（正确转义）
-	if col.Comment != "" {
-		escapedComment := escapeString(col.Comment)
-		fieldDef += fmt.Sprintf(".Comment(\"%s\")", escapedComment)
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
-	// 添加 JSON 标签
-	fieldDef += fmt.Sprintf(".StructTag(`json:\"%s\"`)", col.Field)
-
-	// 添加长度限制
-	if strings.Contains(col.Type, "varchar") {
-		if start := strings.Index(col.Type, "("); start != -1 {
-			if end := strings.Index(col.Type, ")"); end != -1 {
-				length := col.Type[start+1 : end]
-				if length != "" {
-					fieldDef += fmt.Sprintf(".MaxLen(%s)", length)
-				}
-			}
+	var ddl strings.Builder
+	for _, name := range names {
+		var table, create string
+		if err := db.QueryRow(fmt.Sprintf("SHOW CREATE TABLE `%s`", name)).Scan(&table, &create); err != nil {
+			return nil, fmt.Errorf("show create table %s: %w", name, err)
 		}
+		ddl.WriteString(create)
+		ddl.WriteString(";\n")
 	}
-
-	return fieldDef
+	return sqlddl.Parse(ddl.String())
 }
 
-func mapMySQLTypeToEntType(mysqlType string) string {
-	mysqlType = strings.ToLower(mysqlType)
-
-	switch {
-	case strings.Contains(mysqlType, "bigint"):
-		return "Int64"
-	case strings.Contains(mysqlType, "tinyint"):
-		return "Int8"
-	case strings.Contains(mysqlType, "smallint"):
-		return "Int16"
-	case strings.Contains(mysqlType, "mediumint"):
-		return "Int32"
-	case strings.Contains(mysqlType, "int"):
-		return "Int"
-	case strings.Contains(mysqlType, "varchar") || strings.Contains(mysqlType, "char"):
-		return "String"
-	case strings.Contains(mysqlType, "text") || strings.Contains(mysqlType, "mediumtext") || strings.Contains(mysqlType, "longtext"):
-		return "String"
-	case strings.Contains(mysqlType, "datetime") || strings.Contains(mysqlType, "timestamp"):
-		return "Time"
-	case strings.Contains(mysqlType, "date"):
-		return "Time"
-	case strings.Contains(mysqlType, "decimal") || strings.Contains(mysqlType, "float") || strings.Contains(mysqlType, "double"):
-		return "Float"
-	case strings.Contains(mysqlType, "enum") || strings.Contains(mysqlType, "set"):
-		return "String"
-	default:
-		return "String"
-	}
+// serviceConfig 服务配置中生成 DSN 需要的部分
+type serviceConfig struct {
+	Mysql struct {
+		Master struct {
+			Host     string `toml:"host"`
+			Port     int    `toml:"port"`
+			Username string `toml:"username"`
+			Password string `toml:"password"`
+			DBName   string `toml:"db_name"`
+		} `toml:"master"`
+		DSNParams string `toml:"dsn_params"`
+	} `toml:"mysql"`
+	Secrets secrets.Config `toml:"secrets"`
 }
 
-func formatDefaultValue(value, fieldType string) string {
-	// 处理 NULL 值
-	if strings.ToUpper(value) == "NULL" {
-		return "" // 不设置默认值
+func dsnFromConfig(path string) (string, error) {
+	var c serviceConfig
+	if _, err := toml.DecodeFile(path, &c); err != nil {
+		return "", err
 	}
-
-	switch fieldType {
-	case "String":
-		// 特殊处理空字符串
-		if value == "" {
-			return "\"\""
-		}
-		escapedValue := escapeString(value)
-		return fmt.Sprintf("\"%s\"", escapedValue)
-	case "Int", "Int8", "Int16", "Int32", "Int64":
-		if value == "" {
-			return "" // 不设置默认值
-		}
-		return value
-	case "Float":
-		if value == "" {
-			return "" // 不设置默认值
-		}
-		return value
-	case "Time":
-		// 时间类型的默认值特殊处理
-		if value == "" || strings.ToUpper(value) == "CURRENT_TIMESTAMP" {
-			return "" // 不设置默认值
-		}
-		escapedValue := escapeString(value)
-		return fmt.Sprintf("\"%s\"", escapedValue)
-	default:
-		if value == "" {
-			return "" // 不设置默认值
-		}
-		escapedValue := escapeString(value)
-		return fmt.Sprintf("\"%s\"", escapedValue)
+	provider, err := secrets.New(c.Secrets)
+	if err != nil {
+		return "", err
 	}
-}
-
-func escapeString(s string) string {
-	// 转义字符串中的特殊字符
-	s = strings.ReplaceAll(s, "\"", "\\\"")
-	s = strings.ReplaceAll(s, "\n", "\\n")
-	s = strings.ReplaceAll(s, "\r", "\\r")
-	s = strings.ReplaceAll(s, "\t", "\\t")
-	return s
-}
-
-func toCamelCase(s string) string {
-	parts := strings.Split(s, "_")
-	caser := cases.Title(language.English)
-	for i, part := range parts {
-		if len(part) > 0 {
-			parts[i] = caser.String(strings.ToLower(part))
-		}
+	m := c.Mysql.Master
+	password, err := secrets.Resolve(context.Background(), provider, m.Password)
+	if err != nil {
+		return "", err
+	}
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s", m.Username, password, m.Host, m.Port, m.DBName)
+	if c.Mysql.DSNParams != "" {
+		dsn += "?" + c.Mysql.DSNParams
 	}
-	return strings.Join(parts, "")
+	return dsn, nil
 }
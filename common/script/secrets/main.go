@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"common/jwtutils"
+	"common/secrets"
+)
+
+// 加密密钥文件工具
+//
+//	go run ./script/secrets keygen
+//	SECRETS_KEY=... go run ./script/secrets encrypt -in secrets.json -out secrets.enc
+//	SECRETS_KEY=... go run ./script/secrets decrypt -in secrets.enc
+//	go run ./script/secrets keypair -alg EdDSA -out jwt-2026  // 生成 jwt 签名密钥 jwt-2026.key、jwt-2026.pub
+//
+// secrets.json 内容为 {"mysql/master": "root123", "jwt/access": "..."}
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	fs := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
+	in := fs.String("in", "", "input file")
+	out := fs.String("out", "", "output file, stdout if empty")
+	keyEnv := fs.String("key-env", "SECRETS_KEY", "env of base64 encoded 32 bytes key")
+	alg := fs.String("alg", "EdDSA", "jwt signing algorithm, RS256 or EdDSA")
+	_ = fs.Parse(os.Args[2:])
+
+	switch os.Args[1] {
+	case "keygen":
+		key, err := secrets.GenerateKey()
+		exitIf(err)
+		fmt.Println(key)
+	case "encrypt":
+		key, data := mustInput(*keyEnv, *in)
+		// 校验 JSON 格式，避免加密后才发现无法解析
+		values := make(map[string]string)
+		exitIf(json.Unmarshal(data, &values))
+		encrypted, err := secrets.Encrypt(key, data)
+		exitIf(err)
+		exitIf(write(*out, encrypted))
+	case "decrypt":
+		key, data := mustInput(*keyEnv, *in)
+		plain, err := secrets.Decrypt(key, data)
+		exitIf(err)
+		exitIf(write(*out, plain))
+	case "keypair":
+		priv, pub, err := jwtutils.GenerateKeyPair(*alg)
+		exitIf(err)
+		if *out == "" {
+			exitIf(write("", append(priv, pub...)))
+			return
+		}
+		exitIf(write(*out+".key", priv))
+		exitIf(os.WriteFile(*out+".pub", pub, 0644))
+	default:
+		usage()
+	}
+}
+
+func mustInput(keyEnv, in string) ([]byte, []byte) {
+	if in == "" {
+		usage()
+	}
+	key, err := secrets.KeyFromEnv(keyEnv)
+	exitIf(err)
+	data, err := os.ReadFile(in)
+	exitIf(err)
+	return key, data
+}
+
+func write(out string, data []byte) error {
+	if out == "" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(out, data, 0600)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: secrets keygen | encrypt -in secrets.json [-out secrets.enc] | decrypt -in secrets.enc [-out secrets.json] | keypair [-alg EdDSA|RS256] [-out name]")
+	os.Exit(2)
+}
+
+func exitIf(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,163 @@
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// EnvProvider 从环境变量读取，mysql/master -> {PREFIX}_MYSQL_MASTER
+type EnvProvider struct {
+	prefix string
+}
+
+func NewEnvProvider(prefix string) *EnvProvider {
+	return &EnvProvider{prefix: prefix}
+}
+
+// EnvName 密钥对应的环境变量名
+func (p *EnvProvider) EnvName(name string) string {
+	name = strings.NewReplacer("/", "_", "-", "_", ".", "_").Replace(name)
+	return strings.ToUpper(p.prefix + "_" + name)
+}
+
+func (p *EnvProvider) Get(ctx context.Context, name string) (string, error) {
+	key := p.EnvName(name)
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return "", fmt.Errorf("%w: env %s is not set", ErrNotFound, key)
+	}
+	return value, nil
+}
+
+// FileProvider 每个密钥一个文件，如 docker/k8s 挂载的 secret，mysql/master -> {dir}/mysql/master
+type FileProvider struct {
+	dir string
+}
+
+func NewFileProvider(dir string) *FileProvider {
+	return &FileProvider{dir: dir}
+}
+
+func (p *FileProvider) Get(ctx context.Context, name string) (string, error) {
+	path := filepath.Join(p.dir, filepath.FromSlash(name))
+	// 防止引用跳出密钥目录
+	if rel, err := filepath.Rel(p.dir, path); err != nil || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("invalid secret name: %s", name)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("%w: %s", ErrNotFound, path)
+		}
+		return "", err
+	}
+	// 去掉编辑器或 echo 追加的换行
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// EncryptedFileProvider AES-256-GCM 加密的 JSON 文件，内容为 {"mysql/master": "..."}，
+// 文件格式为 base64(nonce || ciphertext)，密钥由环境变量提供
+type EncryptedFileProvider struct {
+	values map[string]string
+}
+
+// NewEncryptedFileProvider 读取并解密文件，key 为32字节
+func NewEncryptedFileProvider(path string, key []byte) (*EncryptedFileProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := Decrypt(key, data)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt %s failed: %w", path, err)
+	}
+	values := make(map[string]string)
+	if err := json.Unmarshal(plain, &values); err != nil {
+		return nil, fmt.Errorf("parse %s failed: %w", path, err)
+	}
+	return &EncryptedFileProvider{values: values}, nil
+}
+
+func (p *EncryptedFileProvider) Get(ctx context.Context, name string) (string, error) {
+	value, ok := p.values[name]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	return value, nil
+}
+
+// KeyFromEnv 从环境变量读取 base64 编码的32字节密钥
+func KeyFromEnv(env string) ([]byte, error) {
+	encoded, ok := os.LookupEnv(env)
+	if !ok || encoded == "" {
+		return nil, fmt.Errorf("secrets: env %s is not set", env)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("secrets: env %s is not valid base64: %w", env, err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("secrets: key in env %s must be 32 bytes, got %d", env, len(key))
+	}
+	return key, nil
+}
+
+// GenerateKey 生成 base64 编码的随机密钥
+func GenerateKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// Encrypt AES-256-GCM 加密，返回 base64(nonce || ciphertext)
+func Encrypt(key, plain []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	sealed := gcm.Seal(nonce, nonce, plain, nil)
+	out := make([]byte, base64.StdEncoding.EncodedLen(len(sealed)))
+	base64.StdEncoding.Encode(out, sealed)
+	return out, nil
+}
+
+// Decrypt 解密 Encrypt 的输出
+func Decrypt(key, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,89 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RefPrefix 配置中引用密钥的前缀，如 password = "secret://mysql/master"
+const RefPrefix = "secret://"
+
+const (
+	BackendEnv       = "env"
+	BackendFile      = "file"
+	BackendEncrypted = "encrypted"
+
+	defaultEnvPrefix = "SECRET"
+	defaultKeyEnv    = "SECRETS_KEY"
+)
+
+var ErrNotFound = errors.New("secret not found")
+
+// Provider 密钥提供者，name 为引用中 secret:// 之后的部分，如 mysql/master
+type Provider interface {
+	Get(ctx context.Context, name string) (string, error)
+}
+
+// Config 密钥配置
+type Config struct {
+	Backend   string `toml:"backend"`    // env / file / encrypted，默认 env
+	EnvPrefix string `toml:"env_prefix"` // env：环境变量前缀，mysql/master -> SECRET_MYSQL_MASTER
+	Dir       string `toml:"dir"`        // file：密钥目录，mysql/master -> {dir}/mysql/master
+	File      string `toml:"file"`       // encrypted：加密文件路径
+	KeyEnv    string `toml:"key_env"`    // encrypted：存放解密密钥（base64）的环境变量，默认 SECRETS_KEY
+}
+
+// New 按配置创建密钥提供者
+func New(cfg Config) (Provider, error) {
+	switch cfg.Backend {
+	case "", BackendEnv:
+		prefix := cfg.EnvPrefix
+		if prefix == "" {
+			prefix = defaultEnvPrefix
+		}
+		return NewEnvProvider(prefix), nil
+	case BackendFile:
+		if cfg.Dir == "" {
+			return nil, errors.New("secrets: dir is required for file backend")
+		}
+		return NewFileProvider(cfg.Dir), nil
+	case BackendEncrypted:
+		if cfg.File == "" {
+			return nil, errors.New("secrets: file is required for encrypted backend")
+		}
+		keyEnv := cfg.KeyEnv
+		if keyEnv == "" {
+			keyEnv = defaultKeyEnv
+		}
+		key, err := KeyFromEnv(keyEnv)
+		if err != nil {
+			return nil, err
+		}
+		return NewEncryptedFileProvider(cfg.File, key)
+	default:
+		return nil, fmt.Errorf("secrets: unknown backend %q", cfg.Backend)
+	}
+}
+
+// IsRef 是否为密钥引用
+func IsRef(value string) bool {
+	return strings.HasPrefix(value, RefPrefix)
+}
+
+// Resolve 解析密钥引用，非引用原样返回
+func Resolve(ctx context.Context, p Provider, value string) (string, error) {
+	if !IsRef(value) {
+		return value, nil
+	}
+	name := strings.Trim(strings.TrimPrefix(value, RefPrefix), "/")
+	if name == "" {
+		return "", fmt.Errorf("secrets: empty reference %q", value)
+	}
+	secret, err := p.Get(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("secrets: resolve %s failed: %w", name, err)
+	}
+	return secret, nil
+}
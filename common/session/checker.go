@@ -0,0 +1,99 @@
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"common/jwtutils"
+)
+
+type cacheEntry struct {
+	revoked bool
+	expires time.Time
+}
+
+// Checker 校验 access token 对应的会话是否已吊销，结果在本地缓存一段时间以减少 redis 访问
+//
+// 未吊销的结果缓存 cache_ttl 秒，吊销最多延迟该时间生效；吊销不可恢复，吊销的结果缓存到 token 过期
+type Checker struct {
+	store *Store
+	ttl   time.Duration
+	size  int
+	now   func() time.Time // 测试中替换
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+func NewChecker(store *Store, cfg Config) *Checker {
+	return &Checker{
+		store: store,
+		ttl:   time.Duration(cfg.CacheTTL) * time.Second,
+		size:  cfg.CacheSize,
+		now:   time.Now,
+		cache: make(map[string]cacheEntry),
+	}
+}
+
+// Check 会话已吊销时返回 ErrRevoked，不带会话的 token 返回 ErrSessionNotFound
+func (c *Checker) Check(ctx context.Context, claims *jwtutils.Claims) error {
+	if claims.SessionID == "" {
+		return ErrSessionNotFound
+	}
+	now := c.now()
+	if e, ok := c.get(claims.SessionID, now); ok {
+		return revokedErr(e.revoked)
+	}
+
+	revoked, err := c.store.IsRevoked(ctx, claims.UserID, claims.SessionID)
+	if err != nil {
+		return err
+	}
+	expires := now.Add(c.ttl)
+	if revoked {
+		expires = claims.ExpiresTime()
+	}
+	c.put(claims.SessionID, cacheEntry{revoked: revoked, expires: expires}, now)
+	return revokedErr(revoked)
+}
+
+func (c *Checker) get(sessionID string, now time.Time) (cacheEntry, bool) {
+	if c.ttl <= 0 {
+		return cacheEntry{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.cache[sessionID]
+	if !ok || now.After(e.expires) {
+		return cacheEntry{}, false
+	}
+	return e, true
+}
+
+func (c *Checker) put(sessionID string, e cacheEntry, now time.Time) {
+	if c.ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.size > 0 && len(c.cache) >= c.size {
+		// 先清理过期的，仍然已满时整体清空
+		for k, v := range c.cache {
+			if now.After(v.expires) {
+				delete(c.cache, k)
+			}
+		}
+		if len(c.cache) >= c.size {
+			c.cache = make(map[string]cacheEntry)
+		}
+	}
+	c.cache[sessionID] = e
+}
+
+func revokedErr(revoked bool) error {
+	if revoked {
+		return ErrRevoked
+	}
+	return nil
+}
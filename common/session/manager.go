@@ -0,0 +1,79 @@
+package session
+
+import (
+	"context"
+	"errors"
+
+	"common/jwtutils"
+)
+
+// Manager 签发和管理会话令牌，用户服务使用
+type Manager struct {
+	store   *Store
+	keyring *jwtutils.Keyring
+}
+
+func NewManager(store *Store, keyring *jwtutils.Keyring) *Manager {
+	return &Manager{store: store, keyring: keyring}
+}
+
+// Login 在设备上登录，替换该设备原有的会话，返回被挤下线的设备；roles 写入令牌，刷新时保留
+func (m *Manager) Login(ctx context.Context, userID uint64, deviceID string, roles ...string) (*Tokens, []string, error) {
+	if deviceID == "" {
+		return nil, nil, errors.New("device id is required")
+	}
+	sessionID, refreshID := jwtutils.NewID(), jwtutils.NewID()
+	evicted, err := m.store.Create(ctx, userID, deviceID, sessionID, refreshID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tokens, err := m.issue(userID, deviceID, sessionID, refreshID, roles)
+	return tokens, evicted, err
+}
+
+// Refresh 用 refresh token 换取新的令牌，旧的 refresh token 随即失效
+func (m *Manager) Refresh(ctx context.Context, refreshToken, deviceID string) (*Tokens, error) {
+	claims, err := m.keyring.Parse(refreshToken, jwtutils.TokenTypeRefresh, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	if claims.SessionID == "" || claims.Id == "" {
+		return nil, ErrSessionNotFound
+	}
+	refreshID := jwtutils.NewID()
+	if err := m.store.Rotate(ctx, claims.UserID, deviceID, claims.SessionID, claims.Id, refreshID); err != nil {
+		return nil, err
+	}
+	return m.issue(claims.UserID, deviceID, claims.SessionID, refreshID, claims.Roles)
+}
+
+// Logout 下线指定设备
+func (m *Manager) Logout(ctx context.Context, userID uint64, deviceID string) error {
+	_, err := m.store.Revoke(ctx, userID, deviceID)
+	return err
+}
+
+// LogoutAll 下线用户的所有设备，如修改密码、封禁后
+func (m *Manager) LogoutAll(ctx context.Context, userID uint64) error {
+	_, err := m.store.Revoke(ctx, userID)
+	return err
+}
+
+// Sessions 用户当前登录的设备
+func (m *Manager) Sessions(ctx context.Context, userID uint64) ([]Session, error) {
+	return m.store.List(ctx, userID)
+}
+
+func (m *Manager) issue(userID uint64, deviceID, sessionID, refreshID string, roles []string) (*Tokens, error) {
+	access, refresh, err := m.keyring.GenerateSessionTokens(userID, deviceID, sessionID, refreshID, roles...)
+	if err != nil {
+		return nil, err
+	}
+	cfg := m.keyring.Config()
+	return &Tokens{
+		AccessToken:   access,
+		RefreshToken:  refresh,
+		AccessExpire:  int64(cfg.AccessExpire),
+		RefreshExpire: int64(cfg.RefreshExpire),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package session
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+	ErrRefreshReused   = errors.New("refresh token reused")
+	ErrRevoked         = errors.New("session revoked")
+)
+
+// Config 会话配置
+type Config struct {
+	MaxSessions int `toml:"max_sessions" default:"5"`    // 每个用户最多同时登录的设备数，超过后下线最早登录的设备，0 不限制
+	CacheTTL    int `toml:"cache_ttl" default:"5"`       // 吊销检查的本地缓存时间（秒），吊销最多延迟该时间生效，0 不缓存
+	CacheSize   int `toml:"cache_size" default:"100000"` // 本地缓存最大条数
+}
+
+// Session 一个设备上的登录会话
+type Session struct {
+	DeviceID    string
+	SessionID   string
+	CreatedAt   time.Time
+	RefreshedAt time.Time
+}
+
+// Tokens 登录或刷新后下发的令牌
+type Tokens struct {
+	AccessToken   string
+	RefreshToken  string
+	AccessExpire  int64 // access token 有效期（秒）
+	RefreshExpire int64 // refresh token 有效期（秒）
+}
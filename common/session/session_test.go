@@ -0,0 +1,156 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"common/jwtutils"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+type testEnv struct {
+	store   *Store
+	manager *Manager
+	keyring *jwtutils.Keyring
+}
+
+func newTestEnv(t *testing.T, cfg Config) *testEnv {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	jwtCfg := jwtutils.Config{
+		AccessSecret:  "test-access",
+		RefreshSecret: "test-refresh",
+		AccessExpire:  3600,
+		RefreshExpire: 7200,
+	}
+	keyring, err := jwtutils.NewKeyring(jwtCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := NewStore(client, cfg, jwtCfg)
+	return &testEnv{store: store, manager: NewManager(store, keyring), keyring: keyring}
+}
+
+func (e *testEnv) accessClaims(t *testing.T, tokens *Tokens, deviceID string) *jwtutils.Claims {
+	t.Helper()
+	claims, err := e.keyring.Parse(tokens.AccessToken, jwtutils.TokenTypeAccess, deviceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return claims
+}
+
+func TestRefreshReuseRevokesSession(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(t, Config{MaxSessions: 5})
+
+	first, _, err := env.manager.Login(ctx, 1, "phone")
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := env.accessClaims(t, first, "phone")
+	second, err := env.manager.Refresh(ctx, first.RefreshToken, "phone")
+	if err != nil {
+		t.Fatalf("Refresh() error: %v", err)
+	}
+
+	// 已轮换的 refresh token 再次使用，视为被盗用
+	if _, err := env.manager.Refresh(ctx, first.RefreshToken, "phone"); !errors.Is(err, ErrRefreshReused) {
+		t.Fatalf("Refresh(reused) error = %v, want %v", err, ErrRefreshReused)
+	}
+	revoked, err := env.store.IsRevoked(ctx, 1, claims.SessionID)
+	if err != nil || !revoked {
+		t.Errorf("IsRevoked() = %v, %v, want true", revoked, err)
+	}
+	sessions, err := env.store.List(ctx, 1)
+	if err != nil || len(sessions) != 0 {
+		t.Errorf("List() = %v, %v, want no session", sessions, err)
+	}
+	// 会话吊销后新的 refresh token 也失效
+	if _, err := env.manager.Refresh(ctx, second.RefreshToken, "phone"); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("Refresh(rotated) error = %v, want %v", err, ErrSessionNotFound)
+	}
+}
+
+func TestLoginEvictsOldest(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(t, Config{MaxSessions: 2})
+
+	var oldest *jwtutils.Claims
+	var evicted []string
+	for _, device := range []string{"a", "b", "c"} {
+		tokens, ev, err := env.manager.Login(ctx, 1, device)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if oldest == nil {
+			oldest = env.accessClaims(t, tokens, device)
+		}
+		evicted = append(evicted, ev...)
+		// 登录时间精确到毫秒，保证先后顺序
+		time.Sleep(2 * time.Millisecond)
+	}
+	if len(evicted) != 1 || evicted[0] != "a" {
+		t.Fatalf("evicted = %v, want [a]", evicted)
+	}
+	sessions, err := env.store.List(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var devices []string
+	for _, s := range sessions {
+		devices = append(devices, s.DeviceID)
+	}
+	if len(devices) != 2 || devices[0] != "b" || devices[1] != "c" {
+		t.Errorf("devices = %v, want [b c]", devices)
+	}
+	if revoked, err := env.store.IsRevoked(ctx, 1, oldest.SessionID); err != nil || !revoked {
+		t.Errorf("IsRevoked(evicted) = %v, %v, want true", revoked, err)
+	}
+}
+
+func TestCheckerRevokedAfterCacheExpires(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(t, Config{MaxSessions: 5})
+	checker := NewChecker(env.store, Config{CacheTTL: 5, CacheSize: 10})
+	now := time.Now()
+	checker.now = func() time.Time { return now }
+
+	tokens, _, err := env.manager.Login(ctx, 1, "phone")
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := env.accessClaims(t, tokens, "phone")
+	if err := checker.Check(ctx, claims); err != nil {
+		t.Fatalf("Check() error: %v", err)
+	}
+	if err := env.manager.Logout(ctx, 1, "phone"); err != nil {
+		t.Fatal(err)
+	}
+
+	// 缓存有效期内仍使用缓存的结果
+	now = now.Add(4 * time.Second)
+	if err := checker.Check(ctx, claims); err != nil {
+		t.Errorf("Check() within cache ttl error = %v, want nil", err)
+	}
+	now = now.Add(2 * time.Second)
+	if err := checker.Check(ctx, claims); !errors.Is(err, ErrRevoked) {
+		t.Errorf("Check() after cache ttl error = %v, want %v", err, ErrRevoked)
+	}
+	// 吊销的结果缓存到 token 过期
+	now = now.Add(time.Minute)
+	if err := checker.Check(ctx, claims); !errors.Is(err, ErrRevoked) {
+		t.Errorf("Check() cached revoked error = %v, want %v", err, ErrRevoked)
+	}
+
+	if err := checker.Check(ctx, &jwtutils.Claims{UserID: 1}); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("Check(no session) error = %v, want %v", err, ErrSessionNotFound)
+	}
+}
@@ -0,0 +1,227 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"common/jwtutils"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 同一用户的键使用相同的 hash tag，保证在 redis 集群的同一个 slot，脚本中可以拼接键名
+//
+//	session:{uid}:devices       zset 设备ID -> 登录时间
+//	session:{uid}:d:{device}    hash sid/rid/created/refreshed，有效期同 refresh token
+//	session:{uid}:revoked:{sid} 已吊销的会话，有效期同 access token
+const keyPrefix = "session:"
+
+// createScript 创建会话，替换该设备原有的会话，超过最大设备数时下线最早登录的设备
+var createScript = redis.NewScript(`
+local devices, key = KEYS[1], KEYS[2]
+local device, sid, rid, now, ttl, max, revokeTTL, revokedPrefix, devicePrefix =
+	ARGV[1], ARGV[2], ARGV[3], ARGV[4], ARGV[5], tonumber(ARGV[6]), ARGV[7], ARGV[8], ARGV[9]
+
+local old = redis.call('HGET', key, 'sid')
+if old then
+	redis.call('SET', revokedPrefix .. old, 1, 'EX', revokeTTL)
+end
+redis.call('DEL', key)
+redis.call('HSET', key, 'sid', sid, 'rid', rid, 'created', now, 'refreshed', now)
+redis.call('EXPIRE', key, ttl)
+
+-- 清理已过期的设备
+for _, d in ipairs(redis.call('ZRANGE', devices, 0, -1)) do
+	if redis.call('EXISTS', devicePrefix .. d) == 0 then
+		redis.call('ZREM', devices, d)
+	end
+end
+redis.call('ZADD', devices, now, device)
+redis.call('EXPIRE', devices, ttl)
+
+local evicted = {}
+local n = redis.call('ZCARD', devices) - max
+if max > 0 and n > 0 then
+	for _, d in ipairs(redis.call('ZRANGE', devices, 0, n - 1)) do
+		local s = redis.call('HGET', devicePrefix .. d, 'sid')
+		if s then
+			redis.call('SET', revokedPrefix .. s, 1, 'EX', revokeTTL)
+		end
+		redis.call('DEL', devicePrefix .. d)
+		redis.call('ZREM', devices, d)
+		table.insert(evicted, d)
+	end
+end
+return evicted
+`)
+
+// rotateScript 轮换 refresh token：返回 1 成功，0 会话不存在，-1 旧 token 被重复使用，会话已吊销
+var rotateScript = redis.NewScript(`
+local key, devices = KEYS[1], KEYS[2]
+local device, sid, rid, newRid, now, ttl, revokeTTL, revokedPrefix =
+	ARGV[1], ARGV[2], ARGV[3], ARGV[4], ARGV[5], ARGV[6], ARGV[7], ARGV[8]
+
+local cur = redis.call('HMGET', key, 'sid', 'rid')
+if not cur[1] or cur[1] ~= sid then
+	return 0
+end
+if cur[2] ~= rid then
+	redis.call('SET', revokedPrefix .. sid, 1, 'EX', revokeTTL)
+	redis.call('DEL', key)
+	redis.call('ZREM', devices, device)
+	return -1
+end
+redis.call('HSET', key, 'rid', newRid, 'refreshed', now)
+redis.call('EXPIRE', key, ttl)
+redis.call('EXPIRE', devices, ttl)
+return 1
+`)
+
+// revokeScript 下线设备，ARGV 为空时下线所有设备
+var revokeScript = redis.NewScript(`
+local devices = KEYS[1]
+local revokeTTL, revokedPrefix, devicePrefix = ARGV[1], ARGV[2], ARGV[3]
+
+local targets = {}
+for i = 4, #ARGV do
+	table.insert(targets, ARGV[i])
+end
+if #targets == 0 then
+	targets = redis.call('ZRANGE', devices, 0, -1)
+end
+
+local n = 0
+for _, d in ipairs(targets) do
+	local sid = redis.call('HGET', devicePrefix .. d, 'sid')
+	if sid then
+		redis.call('SET', revokedPrefix .. sid, 1, 'EX', revokeTTL)
+		n = n + 1
+	end
+	redis.call('DEL', devicePrefix .. d)
+	redis.call('ZREM', devices, d)
+end
+return n
+`)
+
+// Store 基于 redis 的会话存储
+type Store struct {
+	client redis.UniversalClient
+	cfg    Config
+	jwt    jwtutils.Config
+}
+
+func NewStore(client redis.UniversalClient, cfg Config, jwt jwtutils.Config) *Store {
+	return &Store{client: client, cfg: cfg, jwt: jwt}
+}
+
+// Create 创建会话，返回因超过最大设备数被下线的设备
+func (s *Store) Create(ctx context.Context, userID uint64, deviceID, sessionID, refreshID string) (evicted []string, err error) {
+	res, err := createScript.Run(ctx, s.client,
+		[]string{devicesKey(userID), deviceKey(userID, deviceID)},
+		deviceID, sessionID, refreshID, time.Now().UnixMilli(), s.jwt.RefreshExpire,
+		s.cfg.MaxSessions, s.jwt.AccessExpire, revokedKey(userID, ""), deviceKey(userID, ""),
+	).StringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("create session failed: %w", err)
+	}
+	return res, nil
+}
+
+// Rotate 校验 refresh token 是否为当前有效的一个并替换为 newRefreshID，
+// 已轮换过的 refresh token 再次使用视为被盗用，吊销整个会话
+func (s *Store) Rotate(ctx context.Context, userID uint64, deviceID, sessionID, refreshID, newRefreshID string) error {
+	res, err := rotateScript.Run(ctx, s.client,
+		[]string{deviceKey(userID, deviceID), devicesKey(userID)},
+		deviceID, sessionID, refreshID, newRefreshID, time.Now().UnixMilli(), s.jwt.RefreshExpire,
+		s.jwt.AccessExpire, revokedKey(userID, ""),
+	).Int()
+	if err != nil {
+		return fmt.Errorf("rotate session failed: %w", err)
+	}
+	switch res {
+	case 0:
+		return ErrSessionNotFound
+	case -1:
+		return ErrRefreshReused
+	}
+	return nil
+}
+
+// Revoke 下线指定设备，不传设备时下线所有设备，返回下线的会话数
+func (s *Store) Revoke(ctx context.Context, userID uint64, deviceIDs ...string) (int, error) {
+	args := []any{s.jwt.AccessExpire, revokedKey(userID, ""), deviceKey(userID, "")}
+	for _, d := range deviceIDs {
+		args = append(args, d)
+	}
+	n, err := revokeScript.Run(ctx, s.client, []string{devicesKey(userID)}, args...).Int()
+	if err != nil {
+		return 0, fmt.Errorf("revoke session failed: %w", err)
+	}
+	return n, nil
+}
+
+// List 用户当前登录的设备，按登录时间排序
+func (s *Store) List(ctx context.Context, userID uint64) ([]Session, error) {
+	devices, err := s.client.ZRange(ctx, devicesKey(userID), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("list session failed: %w", err)
+	}
+	if len(devices) == 0 {
+		return nil, nil
+	}
+
+	cmds := make([]*redis.MapStringStringCmd, len(devices))
+	_, err = s.client.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for i, d := range devices {
+			cmds[i] = p.HGetAll(ctx, deviceKey(userID, d))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list session failed: %w", err)
+	}
+
+	sessions := make([]Session, 0, len(devices))
+	for i, d := range devices {
+		v := cmds[i].Val()
+		if v["sid"] == "" {
+			continue // 已过期
+		}
+		sessions = append(sessions, Session{
+			DeviceID:    d,
+			SessionID:   v["sid"],
+			CreatedAt:   parseMilli(v["created"]),
+			RefreshedAt: parseMilli(v["refreshed"]),
+		})
+	}
+	return sessions, nil
+}
+
+// IsRevoked 会话是否已吊销
+func (s *Store) IsRevoked(ctx context.Context, userID uint64, sessionID string) (bool, error) {
+	n, err := s.client.Exists(ctx, revokedKey(userID, sessionID)).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return false, fmt.Errorf("check session failed: %w", err)
+	}
+	return n > 0, nil
+}
+
+func devicesKey(userID uint64) string {
+	return fmt.Sprintf("%s{%d}:devices", keyPrefix, userID)
+}
+
+func deviceKey(userID uint64, deviceID string) string {
+	return fmt.Sprintf("%s{%d}:d:%s", keyPrefix, userID, deviceID)
+}
+
+func revokedKey(userID uint64, sessionID string) string {
+	return fmt.Sprintf("%s{%d}:revoked:%s", keyPrefix, userID, sessionID)
+}
+
+func parseMilli(v string) time.Time {
+	ms, _ := strconv.ParseInt(v, 10, 64)
+	return time.UnixMilli(ms)
+}
@@ -0,0 +1,580 @@
+// Package sqlddl 解析 MySQL 的 CREATE TABLE 语句，用于离线生成 ent schema 和检查 schema 与 SQL 是否一致，
+// 只支持建表语句中常用的列、主键、索引和表选项，其他语句忽略
+package sqlddl
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Table 表定义
+type Table struct {
+	Name       string
+	Comment    string
+	Columns    []*Column
+	PrimaryKey []string
+	Indexes    []*Index
+}
+
+// Column 列定义
+type Column struct {
+	Name          string
+	Type          string   // 小写的基础类型，如 int、varchar
+	Size          int64    // varchar(50)、decimal(10,2) 中的第一个参数，未指定为0
+	Scale         int64    // decimal(10,2) 中的2
+	Values        []string // enum/set 的取值
+	Unsigned      bool
+	Nullable      bool    // 未声明 NOT NULL，主键列除外
+	Default       *string // 默认值，无默认值或 DEFAULT NULL 时为 nil
+	AutoIncrement bool
+	Comment       string
+}
+
+// Index 索引，不含主键
+type Index struct {
+	Name    string
+	Unique  bool
+	Type    string // 普通索引为空，fulltext / spatial
+	Columns []string
+	Comment string
+}
+
+// Column 按名称查找列
+func (t *Table) Column(name string) *Column {
+	for _, c := range t.Columns {
+		if strings.EqualFold(c.Name, name) {
+			return c
+		}
+	}
+	return nil
+}
+
+// Index 按名称查找索引
+func (t *Table) Index(name string) *Index {
+	for _, idx := range t.Indexes {
+		if strings.EqualFold(idx.Name, name) {
+			return idx
+		}
+	}
+	return nil
+}
+
+// IsInteger 是否为整数类型
+func (c *Column) IsInteger() bool {
+	switch c.Type {
+	case "tinyint", "smallint", "mediumint", "int", "bigint":
+		return true
+	}
+	return false
+}
+
+// FullType 规范化的列类型，整数类型忽略显示宽度（tinyint(1) 除外），如 int unsigned、varchar(50)
+func (c *Column) FullType() string {
+	t := c.Type
+	switch {
+	case len(c.Values) > 0:
+		quoted := make([]string, len(c.Values))
+		for i, v := range c.Values {
+			quoted[i] = "'" + strings.ReplaceAll(v, "'", "''") + "'"
+		}
+		t += "(" + strings.Join(quoted, ",") + ")"
+	case c.IsInteger():
+		if c.Type == "tinyint" && c.Size == 1 {
+			t += "(1)"
+		}
+	case c.Scale > 0:
+		t += fmt.Sprintf("(%d,%d)", c.Size, c.Scale)
+	case c.Size > 0:
+		t += fmt.Sprintf("(%d)", c.Size)
+	}
+	if c.Unsigned {
+		t += " unsigned"
+	}
+	return t
+}
+
+// ParseFile 解析 SQL 文件
+func ParseFile(path string) ([]*Table, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return tables, nil
+}
+
+// Parse 解析 SQL 中的 CREATE TABLE 语句，按出现顺序返回
+func Parse(ddl string) ([]*Table, error) {
+	tokens, err := lex(ddl)
+	if err != nil {
+		return nil, err
+	}
+	var tables []*Table
+	for _, stmt := range splitStatements(tokens) {
+		if len(stmt) < 2 || !stmt[0].is("create") {
+			continue
+		}
+		i := 1
+		if stmt[i].is("temporary") {
+			i++
+		}
+		if !stmt[i].is("table") {
+			continue
+		}
+		p := &parser{tokens: stmt, pos: i + 1}
+		t, err := p.createTable()
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, t)
+	}
+	return tables, nil
+}
+
+// splitStatements 按顶层分号切分语句
+func splitStatements(tokens []token) [][]token {
+	var (
+		stmts [][]token
+		start int
+	)
+	for i, t := range tokens {
+		if t.kind == tokPunct && t.text == ";" {
+			stmts = append(stmts, tokens[start:i])
+			start = i + 1
+		}
+	}
+	if start < len(tokens) {
+		stmts = append(stmts, tokens[start:])
+	}
+	return stmts
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.eof() {
+		return token{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+// accept 下一个 token 匹配时消耗并返回 true
+func (p *parser) accept(words ...string) bool {
+	for i, w := range words {
+		if p.pos+i >= len(p.tokens) || !p.tokens[p.pos+i].is(w) {
+			return false
+		}
+	}
+	p.pos += len(words)
+	return true
+}
+
+func (p *parser) errorf(format string, a ...any) error {
+	line := 0
+	if !p.eof() {
+		line = p.peek().line
+	} else if len(p.tokens) > 0 {
+		line = p.tokens[len(p.tokens)-1].line
+	}
+	return fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, a...))
+}
+
+func (p *parser) expect(s string) error {
+	if !p.accept(s) {
+		return p.errorf("expected %q, got %q", s, p.peek().text)
+	}
+	return nil
+}
+
+// ident 标识符，schema.table 取表名
+func (p *parser) ident() (string, error) {
+	t := p.next()
+	if t.kind != tokIdent && t.kind != tokWord {
+		return "", p.errorf("expected identifier, got %q", t.text)
+	}
+	name := t.text
+	for p.peek().is(".") {
+		p.next()
+		t = p.next()
+		if t.kind != tokIdent && t.kind != tokWord {
+			return "", p.errorf("expected identifier, got %q", t.text)
+		}
+		name = t.text
+	}
+	return name, nil
+}
+
+func (p *parser) createTable() (*Table, error) {
+	p.accept("if", "not", "exists")
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	t := &Table{Name: name}
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	for {
+		if err := p.definition(t); err != nil {
+			return nil, fmt.Errorf("table %s: %w", name, err)
+		}
+		if p.accept(",") {
+			continue
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, fmt.Errorf("table %s: %w", name, err)
+		}
+		break
+	}
+	p.tableOptions(t)
+
+	// 主键列不能为空
+	for _, col := range t.PrimaryKey {
+		if c := t.Column(col); c != nil {
+			c.Nullable = false
+		}
+	}
+	return t, nil
+}
+
+// definition 列、主键、索引或约束
+func (p *parser) definition(t *Table) error {
+	if p.accept("constraint") {
+		// CONSTRAINT [symbol] PRIMARY KEY / UNIQUE / FOREIGN KEY / CHECK
+		if tok := p.peek(); tok.kind == tokIdent || (tok.kind == tokWord && !isConstraintKeyword(tok)) {
+			p.next()
+		}
+	}
+	switch {
+	case p.accept("primary", "key"):
+		p.indexOptions(nil)
+		cols, err := p.keyParts()
+		if err != nil {
+			return err
+		}
+		t.PrimaryKey = cols
+		p.indexOptions(nil)
+		return nil
+	case p.peek().is("unique"), p.peek().is("key"), p.peek().is("index"),
+		p.peek().is("fulltext"), p.peek().is("spatial"):
+		idx, err := p.index()
+		if err != nil {
+			return err
+		}
+		t.Indexes = append(t.Indexes, idx)
+		return nil
+	case p.peek().is("foreign"), p.peek().is("check"):
+		p.skipDefinition()
+		return nil
+	}
+	col, primary, unique, err := p.column()
+	if err != nil {
+		return err
+	}
+	t.Columns = append(t.Columns, col)
+	if primary {
+		t.PrimaryKey = []string{col.Name}
+	}
+	if unique {
+		t.Indexes = append(t.Indexes, &Index{Name: col.Name, Unique: true, Columns: []string{col.Name}})
+	}
+	return nil
+}
+
+func isConstraintKeyword(t token) bool {
+	return t.is("primary") || t.is("unique") || t.is("foreign") || t.is("check")
+}
+
+func (p *parser) index() (*Index, error) {
+	idx := &Index{}
+	switch {
+	case p.accept("unique"):
+		idx.Unique = true
+	case p.accept("fulltext"):
+		idx.Type = "fulltext"
+	case p.accept("spatial"):
+		idx.Type = "spatial"
+	}
+	_ = p.accept("key") || p.accept("index")
+	if !p.peek().is("(") && !p.peek().is("using") {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		idx.Name = name
+	}
+	p.indexOptions(idx)
+	cols, err := p.keyParts()
+	if err != nil {
+		return nil, err
+	}
+	idx.Columns = cols
+	// 未命名的索引 MySQL 使用第一列的列名
+	if idx.Name == "" && len(cols) > 0 {
+		idx.Name = cols[0]
+	}
+	p.indexOptions(idx)
+	return idx, nil
+}
+
+// keyParts (col[(len)] [ASC|DESC], ...)
+func (p *parser) keyParts() ([]string, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	var cols []string
+	for {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, name)
+		if p.peek().is("(") {
+			p.skipParens()
+		}
+		_ = p.accept("asc") || p.accept("desc")
+		if p.accept(",") {
+			continue
+		}
+		return cols, p.expect(")")
+	}
+}
+
+// indexOptions USING BTREE、COMMENT 'x'、VISIBLE 等
+func (p *parser) indexOptions(idx *Index) {
+	for {
+		switch {
+		case p.accept("using"):
+			p.next()
+		case p.accept("comment"):
+			p.accept("=")
+			comment := p.next().text
+			if idx != nil {
+				idx.Comment = comment
+			}
+		case p.accept("key_block_size"):
+			p.accept("=")
+			p.next()
+		case p.accept("visible"), p.accept("invisible"):
+		default:
+			return
+		}
+	}
+}
+
+func (p *parser) column() (col *Column, primary, unique bool, err error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, false, false, err
+	}
+	col = &Column{Name: name, Nullable: true}
+	typ := p.next()
+	if typ.kind != tokWord {
+		return nil, false, false, p.errorf("column %s: expected type, got %q", name, typ.text)
+	}
+	col.Type = normalizeType(strings.ToLower(typ.text))
+	if col.Type == "double" {
+		p.accept("precision")
+	}
+	if p.peek().is("(") {
+		if err := p.typeArgs(col); err != nil {
+			return nil, false, false, err
+		}
+	}
+	if col.Type == "bool" || col.Type == "boolean" {
+		col.Type, col.Size = "tinyint", 1
+	}
+
+	for !p.eof() && !p.peek().is(",") && !p.peek().is(")") {
+		switch {
+		case p.accept("unsigned"):
+			col.Unsigned = true
+		case p.accept("signed"), p.accept("zerofill"):
+		case p.accept("not", "null"):
+			col.Nullable = false
+		case p.accept("null"):
+			col.Nullable = true
+		case p.accept("default"):
+			col.Default, err = p.defaultValue()
+			if err != nil {
+				return nil, false, false, err
+			}
+		case p.accept("auto_increment"):
+			col.AutoIncrement = true
+		case p.accept("comment"):
+			col.Comment = p.next().text
+		case p.accept("primary", "key"), p.accept("key"):
+			primary = true
+		case p.accept("unique"):
+			p.accept("key")
+			unique = true
+		case p.accept("character", "set"), p.accept("charset"), p.accept("collate"):
+			p.accept("=")
+			p.next()
+		case p.accept("on", "update"):
+			p.expression()
+		case p.accept("generated", "always"), p.accept("as"):
+			p.accept("as")
+			p.skipParens()
+		default:
+			// 不关心的属性，如 STORED、VIRTUAL、COLUMN_FORMAT
+			p.next()
+		}
+	}
+	return col, primary, unique, nil
+}
+
+// typeArgs (50)、(10,2)、('a','b')
+func (p *parser) typeArgs(col *Column) error {
+	p.next()
+	var args []token
+	for !p.eof() && !p.peek().is(")") {
+		t := p.next()
+		if t.kind != tokPunct {
+			args = append(args, t)
+		}
+	}
+	if err := p.expect(")"); err != nil {
+		return err
+	}
+	if col.Type == "enum" || col.Type == "set" {
+		for _, a := range args {
+			col.Values = append(col.Values, a.text)
+		}
+		return nil
+	}
+	for i, a := range args {
+		n, err := strconv.ParseInt(a.text, 10, 64)
+		if err != nil {
+			return p.errorf("column %s: invalid type argument %q", col.Name, a.text)
+		}
+		if i == 0 {
+			col.Size = n
+		} else {
+			col.Scale = n
+		}
+	}
+	return nil
+}
+
+// defaultValue DEFAULT 后的值，NULL 返回 nil，CURRENT_TIMESTAMP 等表达式原样返回
+func (p *parser) defaultValue() (*string, error) {
+	if p.eof() {
+		return nil, p.errorf("expected default value")
+	}
+	if p.accept("null") {
+		return nil, nil
+	}
+	t := p.peek()
+	switch {
+	case t.kind == tokString:
+		p.next()
+		return &t.text, nil
+	case t.is("("):
+		start := p.pos
+		p.skipParens()
+		v := joinTokens(p.tokens[start:p.pos])
+		return &v, nil
+	default:
+		v := p.expression()
+		return &v, nil
+	}
+}
+
+// expression 单个词及可选的参数，如 CURRENT_TIMESTAMP(3)、-1
+func (p *parser) expression() string {
+	start := p.pos
+	p.next()
+	if p.peek().is("(") {
+		p.skipParens()
+	}
+	return joinTokens(p.tokens[start:p.pos])
+}
+
+func (p *parser) tableOptions(t *Table) {
+	for !p.eof() {
+		switch {
+		case p.accept("comment"):
+			p.accept("=")
+			t.Comment = p.next().text
+		case p.accept("default"):
+		default:
+			// ENGINE=InnoDB、CHARSET=utf8mb4 等
+			p.next()
+			if p.accept("=") {
+				p.next()
+			}
+		}
+	}
+}
+
+// skipDefinition 跳过到当前定义结束
+func (p *parser) skipDefinition() {
+	for !p.eof() && !p.peek().is(",") && !p.peek().is(")") {
+		if p.peek().is("(") {
+			p.skipParens()
+			continue
+		}
+		p.next()
+	}
+}
+
+// skipParens 跳过一组括号及其内容
+func (p *parser) skipParens() {
+	depth := 0
+	for !p.eof() {
+		t := p.next()
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			depth--
+			if depth == 0 {
+				return
+			}
+		}
+	}
+}
+
+func joinTokens(tokens []token) string {
+	var b strings.Builder
+	for _, t := range tokens {
+		if t.kind == tokString {
+			b.WriteString("'" + strings.ReplaceAll(t.text, "'", "''") + "'")
+			continue
+		}
+		b.WriteString(t.text)
+	}
+	return b.String()
+}
+
+// normalizeType 同义类型统一
+func normalizeType(t string) string {
+	switch t {
+	case "integer":
+		return "int"
+	case "dec", "numeric", "fixed":
+		return "decimal"
+	case "real":
+		return "double"
+	}
+	return t
+}
@@ -0,0 +1,92 @@
+package sqlddl
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "更新 testdata 中的 golden 文件")
+
+func TestParseGolden(t *testing.T) {
+	tables, err := ParseFile(filepath.Join("testdata", "all.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := json.MarshalIndent(tables, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = append(got, '\n')
+
+	golden := filepath.Join("testdata", "all.golden.json")
+	if *update {
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("parse result differs from %s, run go test -update to accept:\n%s", golden, got)
+	}
+}
+
+func TestParseColumns(t *testing.T) {
+	tests := []struct {
+		name     string
+		column   string
+		fullType string
+		nullable bool
+		def      string // "<nil>" 表示没有默认值
+	}{
+		{"int unsigned", "`a` int(11) unsigned NOT NULL", "int unsigned", false, "<nil>"},
+		{"tinyint bool", "`a` tinyint(1) DEFAULT '0'", "tinyint(1)", true, "0"},
+		{"varchar", "`a` varchar(50) DEFAULT NULL", "varchar(50)", true, "<nil>"},
+		{"decimal", "`a` decimal(10,2) NOT NULL DEFAULT 0.00", "decimal(10,2)", false, "0.00"},
+		{"enum", "`a` enum('x','y''z') NOT NULL DEFAULT 'x'", "enum('x','y''z')", false, "x"},
+		{"timestamp", "`a` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP", "timestamp", false, "CURRENT_TIMESTAMP"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tables, err := Parse("CREATE TABLE `t` (" + tt.column + ");")
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := tables[0].Column("a")
+			def := "<nil>"
+			if c.Default != nil {
+				def = *c.Default
+			}
+			if c.FullType() != tt.fullType || c.Nullable != tt.nullable || def != tt.def {
+				t.Errorf("got type=%q nullable=%v default=%q, want type=%q nullable=%v default=%q",
+					c.FullType(), c.Nullable, def, tt.fullType, tt.nullable, tt.def)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, ddl := range []string{
+		"CREATE TABLE `t` (`a` int",
+		"CREATE TABLE `t` (`a` int, PRIMARY KEY (`a`)",
+		"CREATE TABLE `t` (`a` varchar(50) COMMENT 'x);",
+	} {
+		if _, err := Parse(ddl); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", ddl)
+		}
+	}
+	// 非建表语句忽略
+	tables, err := Parse("DROP TABLE IF EXISTS `t`; INSERT INTO `t` VALUES (1);")
+	if err != nil || len(tables) != 0 {
+		t.Errorf("Parse() = %v, %v, want no table", tables, err)
+	}
+	if _, err := Parse(strings.Repeat("-- comment\n", 3)); err != nil {
+		t.Errorf("Parse(comments) error: %v", err)
+	}
+}
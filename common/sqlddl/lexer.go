@@ -0,0 +1,156 @@
+package sqlddl
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokWord   tokenKind = iota // 关键字、未加引号的标识符、数字
+	tokIdent                   // `反引号` 标识符
+	tokString                  // '字符串'
+	tokPunct                   // ( ) , ; = .
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	line int
+}
+
+// is 是否为关键字或符号，关键字不区分大小写
+func (t token) is(s string) bool {
+	return (t.kind == tokWord || t.kind == tokPunct) && strings.EqualFold(t.text, s)
+}
+
+// lex 切分 DDL，跳过 -- # /* */ 注释
+func lex(src string) ([]token, error) {
+	var (
+		tokens []token
+		line   = 1
+		rs     = []rune(src)
+	)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case r == '\n':
+			line++
+			i++
+		case unicode.IsSpace(r):
+			i++
+		case r == '#' || (r == '-' && i+1 < len(rs) && rs[i+1] == '-'):
+			for i < len(rs) && rs[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(rs) && rs[i+1] == '*':
+			j := i + 2
+			for j+1 < len(rs) && !(rs[j] == '*' && rs[j+1] == '/') {
+				if rs[j] == '\n' {
+					line++
+				}
+				j++
+			}
+			if j+1 >= len(rs) {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+			i = j + 2
+		case r == '`':
+			j := i + 1
+			var b strings.Builder
+			for ; j < len(rs); j++ {
+				if rs[j] == '`' {
+					// `` 转义为 `
+					if j+1 < len(rs) && rs[j+1] == '`' {
+						b.WriteRune('`')
+						j++
+						continue
+					}
+					break
+				}
+				b.WriteRune(rs[j])
+			}
+			if j >= len(rs) {
+				return nil, fmt.Errorf("line %d: unterminated identifier", line)
+			}
+			tokens = append(tokens, token{kind: tokIdent, text: b.String(), line: line})
+			i = j + 1
+		case r == '\'' || r == '"':
+			s, n, err := readString(rs[i:], r)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			tokens = append(tokens, token{kind: tokString, text: s, line: line})
+			line += strings.Count(string(rs[i:i+n]), "\n")
+			i += n
+		case strings.ContainsRune("(),;=.", r):
+			tokens = append(tokens, token{kind: tokPunct, text: string(r), line: line})
+			i++
+		default:
+			j := i
+			for j < len(rs) && !unicode.IsSpace(rs[j]) && !strings.ContainsRune("(),;=`'\"", rs[j]) {
+				// 标识符中的 . 单独切分，数字中的 . 保留
+				if rs[j] == '.' && !isNumber(string(rs[i:j])) {
+					break
+				}
+				j++
+			}
+			if j == i {
+				return nil, fmt.Errorf("line %d: unexpected character %q", line, r)
+			}
+			tokens = append(tokens, token{kind: tokWord, text: string(rs[i:j]), line: line})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+// readString 读取引号字符串，支持连续两个引号和反斜杠转义，返回内容和消耗的字符数
+func readString(rs []rune, quote rune) (string, int, error) {
+	var b strings.Builder
+	for j := 1; j < len(rs); j++ {
+		switch rs[j] {
+		case '\\':
+			if j+1 < len(rs) {
+				j++
+				switch rs[j] {
+				case 'n':
+					b.WriteRune('\n')
+				case 't':
+					b.WriteRune('\t')
+				case 'r':
+					b.WriteRune('\r')
+				case '0':
+					b.WriteRune(0)
+				default:
+					b.WriteRune(rs[j])
+				}
+			}
+		case quote:
+			if j+1 < len(rs) && rs[j+1] == quote {
+				b.WriteRune(quote)
+				j++
+				continue
+			}
+			return b.String(), j + 1, nil
+		default:
+			b.WriteRune(rs[j])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	s = strings.TrimPrefix(s, "-")
+	for _, r := range s {
+		if !unicode.IsDigit(r) && r != '.' {
+			return false
+		}
+	}
+	return s != ""
+}
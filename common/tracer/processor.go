@@ -2,17 +2,27 @@ package tracer
 
 import (
 	"context"
+	"sync/atomic"
 
 	"go.opentelemetry.io/otel/codes"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
+// ErrorOnlySpanProcessor 开启时只导出出错的 Span，可通过 SetEnabled 运行时切换
 type ErrorOnlySpanProcessor struct {
-	next sdktrace.SpanProcessor
+	next    sdktrace.SpanProcessor
+	enabled atomic.Bool
 }
 
 func NewErrorOnlySpanProcessor(next sdktrace.SpanProcessor) *ErrorOnlySpanProcessor {
-	return &ErrorOnlySpanProcessor{next: next}
+	p := &ErrorOnlySpanProcessor{next: next}
+	p.enabled.Store(true)
+	return p
+}
+
+// SetEnabled 开启/关闭只导出出错的 Span
+func (p *ErrorOnlySpanProcessor) SetEnabled(enabled bool) {
+	p.enabled.Store(enabled)
 }
 
 // OnStart 开始 Span 时，委托给下一个处理器
@@ -22,6 +32,11 @@ func (p *ErrorOnlySpanProcessor) OnStart(ctx context.Context, s sdktrace.ReadWri
 
 // OnEnd 结束 Span 时，判断错误并导出
 func (p *ErrorOnlySpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	if !p.enabled.Load() {
+		p.next.OnEnd(s)
+		return
+	}
+
 	hasError := false
 
 	for _, kv := range s.Attributes() {
@@ -2,6 +2,8 @@ package tracer
 
 import (
 	"context"
+	"errors"
+	"sync"
 
 	"go.opentelemetry.io/otel/exporters/jaeger"
 	"go.opentelemetry.io/otel/sdk/resource"
@@ -10,6 +12,12 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+var (
+	providersMu sync.Mutex
+	providers   []*sdktrace.TracerProvider // 已创建的 provider，关闭时统一刷新
+	processors  []*ErrorOnlySpanProcessor  // 已创建的处理器，用于运行时切换只导出错误
+)
+
 func JaegerTraceProvider(endpoints, serviceName, environmentKey string, isOpenOnlyErrSampler bool) (*sdktrace.TracerProvider, error) {
 	exp, err := jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(endpoints)))
 	if err != nil {
@@ -17,13 +25,9 @@ func JaegerTraceProvider(endpoints, serviceName, environmentKey string, isOpenOn
 	}
 
 	batchProcessor := sdktrace.NewBatchSpanProcessor(exp)
-	var spanProcessor sdktrace.SpanProcessor
-
-	if isOpenOnlyErrSampler {
-		spanProcessor = NewErrorOnlySpanProcessor(batchProcessor)
-	} else {
-		spanProcessor = batchProcessor
-	}
+	// 始终包装一层，便于运行时切换是否只导出错误
+	spanProcessor := NewErrorOnlySpanProcessor(batchProcessor)
+	spanProcessor.SetEnabled(isOpenOnlyErrSampler)
 
 	tp := sdktrace.NewTracerProvider(
 		sdktrace.WithSampler(sdktrace.AlwaysSample()),
@@ -35,9 +39,40 @@ func JaegerTraceProvider(endpoints, serviceName, environmentKey string, isOpenOn
 		)),
 	)
 
+	providersMu.Lock()
+	providers = append(providers, tp)
+	processors = append(processors, spanProcessor)
+	providersMu.Unlock()
+
 	return tp, nil
 }
 
+// SetOnlySamplerError 运行时切换所有 provider 是否只导出出错的 Span
+func SetOnlySamplerError(enabled bool) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+	for _, p := range processors {
+		p.SetEnabled(enabled)
+	}
+}
+
+// Shutdown 刷新并关闭所有已创建的 provider，确保缓冲的 span 被导出
+func Shutdown(ctx context.Context) error {
+	providersMu.Lock()
+	tps := providers
+	providers = nil
+	processors = nil
+	providersMu.Unlock()
+
+	var errs []error
+	for _, tp := range tps {
+		if err := tp.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
 func GetTraceIDs(ctx context.Context) (traceID string, spanID string) {
 	// 从 context 中获取当前 Span
 	span := trace.SpanFromContext(ctx)
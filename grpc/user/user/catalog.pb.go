@@ -0,0 +1,826 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.3
+// source: catalog.proto
+
+package user_service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type School struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LogoUrl       string                 `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"` // 1-启用，0-未启用
+	CreatedAt     uint32                 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *School) Reset() {
+	*x = School{}
+	mi := &file_catalog_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *School) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*School) ProtoMessage() {}
+
+func (x *School) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use School.ProtoReflect.Descriptor instead.
+func (*School) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *School) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *School) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *School) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *School) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *School) GetCreatedAt() uint32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type Major struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Major) Reset() {
+	*x = Major{}
+	mi := &file_catalog_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Major) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Major) ProtoMessage() {}
+
+func (x *Major) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Major.ProtoReflect.Descriptor instead.
+func (*Major) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Major) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Major) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Major) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// 分页查询，page 从1开始，page_size 默认20、最大100；keyword 按名称模糊搜索
+// include_disabled 为 true 时包含未启用的学校，需要 admin 角色
+type ListSchoolsReq struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Page            int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize        int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Keyword         string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	IncludeDisabled bool                   `protobuf:"varint,4,opt,name=include_disabled,json=includeDisabled,proto3" json:"include_disabled,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListSchoolsReq) Reset() {
+	*x = ListSchoolsReq{}
+	mi := &file_catalog_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchoolsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchoolsReq) ProtoMessage() {}
+
+func (x *ListSchoolsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchoolsReq.ProtoReflect.Descriptor instead.
+func (*ListSchoolsReq) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSchoolsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSchoolsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSchoolsReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ListSchoolsReq) GetIncludeDisabled() bool {
+	if x != nil {
+		return x.IncludeDisabled
+	}
+	return false
+}
+
+type ListSchoolsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*School              `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchoolsResp) Reset() {
+	*x = ListSchoolsResp{}
+	mi := &file_catalog_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchoolsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchoolsResp) ProtoMessage() {}
+
+func (x *ListSchoolsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchoolsResp.ProtoReflect.Descriptor instead.
+func (*ListSchoolsResp) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListSchoolsResp) GetList() []*School {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListSchoolsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ListMajorsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Keyword       string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMajorsReq) Reset() {
+	*x = ListMajorsReq{}
+	mi := &file_catalog_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMajorsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMajorsReq) ProtoMessage() {}
+
+func (x *ListMajorsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMajorsReq.ProtoReflect.Descriptor instead.
+func (*ListMajorsReq) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMajorsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMajorsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMajorsReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+type ListMajorsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*Major               `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMajorsResp) Reset() {
+	*x = ListMajorsResp{}
+	mi := &file_catalog_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMajorsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMajorsResp) ProtoMessage() {}
+
+func (x *ListMajorsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMajorsResp.ProtoReflect.Descriptor instead.
+func (*ListMajorsResp) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMajorsResp) GetList() []*Major {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListMajorsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetSchoolMajorsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SchoolId      uint32                 `protobuf:"varint,1,opt,name=school_id,json=schoolId,proto3" json:"school_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSchoolMajorsReq) Reset() {
+	*x = GetSchoolMajorsReq{}
+	mi := &file_catalog_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSchoolMajorsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchoolMajorsReq) ProtoMessage() {}
+
+func (x *GetSchoolMajorsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchoolMajorsReq.ProtoReflect.Descriptor instead.
+func (*GetSchoolMajorsReq) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetSchoolMajorsReq) GetSchoolId() uint32 {
+	if x != nil {
+		return x.SchoolId
+	}
+	return 0
+}
+
+type MajorList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*Major               `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MajorList) Reset() {
+	*x = MajorList{}
+	mi := &file_catalog_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MajorList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MajorList) ProtoMessage() {}
+
+func (x *MajorList) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MajorList.ProtoReflect.Descriptor instead.
+func (*MajorList) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MajorList) GetList() []*Major {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type CreateSchoolReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LogoUrl       string                 `protobuf:"bytes,2,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSchoolReq) Reset() {
+	*x = CreateSchoolReq{}
+	mi := &file_catalog_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSchoolReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSchoolReq) ProtoMessage() {}
+
+func (x *CreateSchoolReq) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSchoolReq.ProtoReflect.Descriptor instead.
+func (*CreateSchoolReq) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateSchoolReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSchoolReq) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+type CreateMajorReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMajorReq) Reset() {
+	*x = CreateMajorReq{}
+	mi := &file_catalog_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMajorReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMajorReq) ProtoMessage() {}
+
+func (x *CreateMajorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMajorReq.ProtoReflect.Descriptor instead.
+func (*CreateMajorReq) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateMajorReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMajorReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// 为学校添加专业，已存在的关联忽略
+type AddSchoolMajorsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SchoolId      uint32                 `protobuf:"varint,1,opt,name=school_id,json=schoolId,proto3" json:"school_id,omitempty"`
+	MajorIds      []uint32               `protobuf:"varint,2,rep,packed,name=major_ids,json=majorIds,proto3" json:"major_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSchoolMajorsReq) Reset() {
+	*x = AddSchoolMajorsReq{}
+	mi := &file_catalog_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSchoolMajorsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSchoolMajorsReq) ProtoMessage() {}
+
+func (x *AddSchoolMajorsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSchoolMajorsReq.ProtoReflect.Descriptor instead.
+func (*AddSchoolMajorsReq) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddSchoolMajorsReq) GetSchoolId() uint32 {
+	if x != nil {
+		return x.SchoolId
+	}
+	return 0
+}
+
+func (x *AddSchoolMajorsReq) GetMajorIds() []uint32 {
+	if x != nil {
+		return x.MajorIds
+	}
+	return nil
+}
+
+// 启用/停用学校，status 为 1-启用，0-停用
+type SetSchoolStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SchoolId      uint32                 `protobuf:"varint,1,opt,name=school_id,json=schoolId,proto3" json:"school_id,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSchoolStatusReq) Reset() {
+	*x = SetSchoolStatusReq{}
+	mi := &file_catalog_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSchoolStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSchoolStatusReq) ProtoMessage() {}
+
+func (x *SetSchoolStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSchoolStatusReq.ProtoReflect.Descriptor instead.
+func (*SetSchoolStatusReq) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetSchoolStatusReq) GetSchoolId() uint32 {
+	if x != nil {
+		return x.SchoolId
+	}
+	return 0
+}
+
+func (x *SetSchoolStatusReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+var File_catalog_proto protoreflect.FileDescriptor
+
+const file_catalog_proto_rawDesc = "" +
+	"\n" +
+	"\rcatalog.proto\x12\x04user\x1a\n" +
+	"user.proto\"~\n" +
+	"\x06School\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\rR\tcreatedAt\"M\n" +
+	"\x05Major\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\x86\x01\n" +
+	"\x0eListSchoolsReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x18\n" +
+	"\akeyword\x18\x03 \x01(\tR\akeyword\x12)\n" +
+	"\x10include_disabled\x18\x04 \x01(\bR\x0fincludeDisabled\"I\n" +
+	"\x0fListSchoolsResp\x12 \n" +
+	"\x04list\x18\x01 \x03(\v2\f.user.SchoolR\x04list\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"Z\n" +
+	"\rListMajorsReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x18\n" +
+	"\akeyword\x18\x03 \x01(\tR\akeyword\"G\n" +
+	"\x0eListMajorsResp\x12\x1f\n" +
+	"\x04list\x18\x01 \x03(\v2\v.user.MajorR\x04list\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"1\n" +
+	"\x12GetSchoolMajorsReq\x12\x1b\n" +
+	"\tschool_id\x18\x01 \x01(\rR\bschoolId\",\n" +
+	"\tMajorList\x12\x1f\n" +
+	"\x04list\x18\x01 \x03(\v2\v.user.MajorR\x04list\"@\n" +
+	"\x0fCreateSchoolReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\blogo_url\x18\x02 \x01(\tR\alogoUrl\"F\n" +
+	"\x0eCreateMajorReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"N\n" +
+	"\x12AddSchoolMajorsReq\x12\x1b\n" +
+	"\tschool_id\x18\x01 \x01(\rR\bschoolId\x12\x1b\n" +
+	"\tmajor_ids\x18\x02 \x03(\rR\bmajorIds\"I\n" +
+	"\x12SetSchoolStatusReq\x12\x1b\n" +
+	"\tschool_id\x18\x01 \x01(\rR\bschoolId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status2\xa5\x03\n" +
+	"\aCatalog\x12<\n" +
+	"\vListSchools\x12\x14.user.ListSchoolsReq\x1a\x15.user.ListSchoolsResp\"\x00\x129\n" +
+	"\n" +
+	"ListMajors\x12\x13.user.ListMajorsReq\x1a\x14.user.ListMajorsResp\"\x00\x12>\n" +
+	"\x0fGetSchoolMajors\x12\x18.user.GetSchoolMajorsReq\x1a\x0f.user.MajorList\"\x00\x125\n" +
+	"\fCreateSchool\x12\x15.user.CreateSchoolReq\x1a\f.user.School\"\x00\x122\n" +
+	"\vCreateMajor\x12\x14.user.CreateMajorReq\x1a\v.user.Major\"\x00\x12:\n" +
+	"\x0fAddSchoolMajors\x12\x18.user.AddSchoolMajorsReq\x1a\v.user.Empty\"\x00\x12:\n" +
+	"\x0fSetSchoolStatus\x12\x18.user.SetSchoolStatusReq\x1a\v.user.Empty\"\x00B\x0eZ\fuser.serviceb\x06proto3"
+
+var (
+	file_catalog_proto_rawDescOnce sync.Once
+	file_catalog_proto_rawDescData []byte
+)
+
+func file_catalog_proto_rawDescGZIP() []byte {
+	file_catalog_proto_rawDescOnce.Do(func() {
+		file_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_proto_rawDesc), len(file_catalog_proto_rawDesc)))
+	})
+	return file_catalog_proto_rawDescData
+}
+
+var file_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_catalog_proto_goTypes = []any{
+	(*School)(nil),             // 0: user.School
+	(*Major)(nil),              // 1: user.Major
+	(*ListSchoolsReq)(nil),     // 2: user.ListSchoolsReq
+	(*ListSchoolsResp)(nil),    // 3: user.ListSchoolsResp
+	(*ListMajorsReq)(nil),      // 4: user.ListMajorsReq
+	(*ListMajorsResp)(nil),     // 5: user.ListMajorsResp
+	(*GetSchoolMajorsReq)(nil), // 6: user.GetSchoolMajorsReq
+	(*MajorList)(nil),          // 7: user.MajorList
+	(*CreateSchoolReq)(nil),    // 8: user.CreateSchoolReq
+	(*CreateMajorReq)(nil),     // 9: user.CreateMajorReq
+	(*AddSchoolMajorsReq)(nil), // 10: user.AddSchoolMajorsReq
+	(*SetSchoolStatusReq)(nil), // 11: user.SetSchoolStatusReq
+	(*Empty)(nil),              // 12: user.Empty
+}
+var file_catalog_proto_depIdxs = []int32{
+	0,  // 0: user.ListSchoolsResp.list:type_name -> user.School
+	1,  // 1: user.ListMajorsResp.list:type_name -> user.Major
+	1,  // 2: user.MajorList.list:type_name -> user.Major
+	2,  // 3: user.Catalog.ListSchools:input_type -> user.ListSchoolsReq
+	4,  // 4: user.Catalog.ListMajors:input_type -> user.ListMajorsReq
+	6,  // 5: user.Catalog.GetSchoolMajors:input_type -> user.GetSchoolMajorsReq
+	8,  // 6: user.Catalog.CreateSchool:input_type -> user.CreateSchoolReq
+	9,  // 7: user.Catalog.CreateMajor:input_type -> user.CreateMajorReq
+	10, // 8: user.Catalog.AddSchoolMajors:input_type -> user.AddSchoolMajorsReq
+	11, // 9: user.Catalog.SetSchoolStatus:input_type -> user.SetSchoolStatusReq
+	3,  // 10: user.Catalog.ListSchools:output_type -> user.ListSchoolsResp
+	5,  // 11: user.Catalog.ListMajors:output_type -> user.ListMajorsResp
+	7,  // 12: user.Catalog.GetSchoolMajors:output_type -> user.MajorList
+	0,  // 13: user.Catalog.CreateSchool:output_type -> user.School
+	1,  // 14: user.Catalog.CreateMajor:output_type -> user.Major
+	12, // 15: user.Catalog.AddSchoolMajors:output_type -> user.Empty
+	12, // 16: user.Catalog.SetSchoolStatus:output_type -> user.Empty
+	10, // [10:17] is the sub-list for method output_type
+	3,  // [3:10] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_catalog_proto_init() }
+func file_catalog_proto_init() {
+	if File_catalog_proto != nil {
+		return
+	}
+	file_user_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_proto_rawDesc), len(file_catalog_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_proto_goTypes,
+		DependencyIndexes: file_catalog_proto_depIdxs,
+		MessageInfos:      file_catalog_proto_msgTypes,
+	}.Build()
+	File_catalog_proto = out.File
+	file_catalog_proto_goTypes = nil
+	file_catalog_proto_depIdxs = nil
+}
@@ -0,0 +1,353 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: catalog.proto
+
+package user_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Catalog_ListSchools_FullMethodName     = "/user.Catalog/ListSchools"
+	Catalog_ListMajors_FullMethodName      = "/user.Catalog/ListMajors"
+	Catalog_GetSchoolMajors_FullMethodName = "/user.Catalog/GetSchoolMajors"
+	Catalog_CreateSchool_FullMethodName    = "/user.Catalog/CreateSchool"
+	Catalog_CreateMajor_FullMethodName     = "/user.Catalog/CreateMajor"
+	Catalog_AddSchoolMajors_FullMethodName = "/user.Catalog/AddSchoolMajors"
+	Catalog_SetSchoolStatus_FullMethodName = "/user.Catalog/SetSchoolStatus"
+)
+
+// CatalogClient is the client API for Catalog service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 学校、专业目录，查询接口公开，创建和启停需要 admin 角色
+type CatalogClient interface {
+	ListSchools(ctx context.Context, in *ListSchoolsReq, opts ...grpc.CallOption) (*ListSchoolsResp, error)
+	ListMajors(ctx context.Context, in *ListMajorsReq, opts ...grpc.CallOption) (*ListMajorsResp, error)
+	GetSchoolMajors(ctx context.Context, in *GetSchoolMajorsReq, opts ...grpc.CallOption) (*MajorList, error)
+	CreateSchool(ctx context.Context, in *CreateSchoolReq, opts ...grpc.CallOption) (*School, error)
+	CreateMajor(ctx context.Context, in *CreateMajorReq, opts ...grpc.CallOption) (*Major, error)
+	AddSchoolMajors(ctx context.Context, in *AddSchoolMajorsReq, opts ...grpc.CallOption) (*Empty, error)
+	SetSchoolStatus(ctx context.Context, in *SetSchoolStatusReq, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type catalogClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCatalogClient(cc grpc.ClientConnInterface) CatalogClient {
+	return &catalogClient{cc}
+}
+
+func (c *catalogClient) ListSchools(ctx context.Context, in *ListSchoolsReq, opts ...grpc.CallOption) (*ListSchoolsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSchoolsResp)
+	err := c.cc.Invoke(ctx, Catalog_ListSchools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) ListMajors(ctx context.Context, in *ListMajorsReq, opts ...grpc.CallOption) (*ListMajorsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMajorsResp)
+	err := c.cc.Invoke(ctx, Catalog_ListMajors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) GetSchoolMajors(ctx context.Context, in *GetSchoolMajorsReq, opts ...grpc.CallOption) (*MajorList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MajorList)
+	err := c.cc.Invoke(ctx, Catalog_GetSchoolMajors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) CreateSchool(ctx context.Context, in *CreateSchoolReq, opts ...grpc.CallOption) (*School, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(School)
+	err := c.cc.Invoke(ctx, Catalog_CreateSchool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) CreateMajor(ctx context.Context, in *CreateMajorReq, opts ...grpc.CallOption) (*Major, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Major)
+	err := c.cc.Invoke(ctx, Catalog_CreateMajor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) AddSchoolMajors(ctx context.Context, in *AddSchoolMajorsReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Catalog_AddSchoolMajors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) SetSchoolStatus(ctx context.Context, in *SetSchoolStatusReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Catalog_SetSchoolStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CatalogServer is the server API for Catalog service.
+// All implementations must embed UnimplementedCatalogServer
+// for forward compatibility.
+//
+// 学校、专业目录，查询接口公开，创建和启停需要 admin 角色
+type CatalogServer interface {
+	ListSchools(context.Context, *ListSchoolsReq) (*ListSchoolsResp, error)
+	ListMajors(context.Context, *ListMajorsReq) (*ListMajorsResp, error)
+	GetSchoolMajors(context.Context, *GetSchoolMajorsReq) (*MajorList, error)
+	CreateSchool(context.Context, *CreateSchoolReq) (*School, error)
+	CreateMajor(context.Context, *CreateMajorReq) (*Major, error)
+	AddSchoolMajors(context.Context, *AddSchoolMajorsReq) (*Empty, error)
+	SetSchoolStatus(context.Context, *SetSchoolStatusReq) (*Empty, error)
+	mustEmbedUnimplementedCatalogServer()
+}
+
+// UnimplementedCatalogServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCatalogServer struct{}
+
+func (UnimplementedCatalogServer) ListSchools(context.Context, *ListSchoolsReq) (*ListSchoolsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchools not implemented")
+}
+func (UnimplementedCatalogServer) ListMajors(context.Context, *ListMajorsReq) (*ListMajorsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMajors not implemented")
+}
+func (UnimplementedCatalogServer) GetSchoolMajors(context.Context, *GetSchoolMajorsReq) (*MajorList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchoolMajors not implemented")
+}
+func (UnimplementedCatalogServer) CreateSchool(context.Context, *CreateSchoolReq) (*School, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchool not implemented")
+}
+func (UnimplementedCatalogServer) CreateMajor(context.Context, *CreateMajorReq) (*Major, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMajor not implemented")
+}
+func (UnimplementedCatalogServer) AddSchoolMajors(context.Context, *AddSchoolMajorsReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSchoolMajors not implemented")
+}
+func (UnimplementedCatalogServer) SetSchoolStatus(context.Context, *SetSchoolStatusReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSchoolStatus not implemented")
+}
+func (UnimplementedCatalogServer) mustEmbedUnimplementedCatalogServer() {}
+func (UnimplementedCatalogServer) testEmbeddedByValue()                 {}
+
+// UnsafeCatalogServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CatalogServer will
+// result in compilation errors.
+type UnsafeCatalogServer interface {
+	mustEmbedUnimplementedCatalogServer()
+}
+
+func RegisterCatalogServer(s grpc.ServiceRegistrar, srv CatalogServer) {
+	// If the following call pancis, it indicates UnimplementedCatalogServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Catalog_ServiceDesc, srv)
+}
+
+func _Catalog_ListSchools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchoolsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).ListSchools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_ListSchools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).ListSchools(ctx, req.(*ListSchoolsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_ListMajors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMajorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).ListMajors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_ListMajors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).ListMajors(ctx, req.(*ListMajorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_GetSchoolMajors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchoolMajorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).GetSchoolMajors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_GetSchoolMajors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).GetSchoolMajors(ctx, req.(*GetSchoolMajorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_CreateSchool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSchoolReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).CreateSchool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_CreateSchool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).CreateSchool(ctx, req.(*CreateSchoolReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_CreateMajor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMajorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).CreateMajor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_CreateMajor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).CreateMajor(ctx, req.(*CreateMajorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_AddSchoolMajors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSchoolMajorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).AddSchoolMajors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_AddSchoolMajors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).AddSchoolMajors(ctx, req.(*AddSchoolMajorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_SetSchoolStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSchoolStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).SetSchoolStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Catalog_SetSchoolStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).SetSchoolStatus(ctx, req.(*SetSchoolStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Catalog_ServiceDesc is the grpc.ServiceDesc for Catalog service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Catalog_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.Catalog",
+	HandlerType: (*CatalogServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSchools",
+			Handler:    _Catalog_ListSchools_Handler,
+		},
+		{
+			MethodName: "ListMajors",
+			Handler:    _Catalog_ListMajors_Handler,
+		},
+		{
+			MethodName: "GetSchoolMajors",
+			Handler:    _Catalog_GetSchoolMajors_Handler,
+		},
+		{
+			MethodName: "CreateSchool",
+			Handler:    _Catalog_CreateSchool_Handler,
+		},
+		{
+			MethodName: "CreateMajor",
+			Handler:    _Catalog_CreateMajor_Handler,
+		},
+		{
+			MethodName: "AddSchoolMajors",
+			Handler:    _Catalog_AddSchoolMajors_Handler,
+		},
+		{
+			MethodName: "SetSchoolStatus",
+			Handler:    _Catalog_SetSchoolStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog.proto",
+}
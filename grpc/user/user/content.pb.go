@@ -0,0 +1,2349 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.3
+// source: content.proto
+
+package user_service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 帖子，匿名帖子对作者以外的用户不返回 user_id
+type Post struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SchoolId      uint32                 `protobuf:"varint,3,opt,name=school_id,json=schoolId,proto3" json:"school_id,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Images        []string               `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`
+	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Anonymous     bool                   `protobuf:"varint,8,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	AnonymousName string                 `protobuf:"bytes,9,opt,name=anonymous_name,json=anonymousName,proto3" json:"anonymous_name,omitempty"`
+	CreateTime    uint32                 `protobuf:"varint,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    uint32                 `protobuf:"varint,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Status        int32                  `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"` // 1-正常，2-审核中，3-审核失败，4-仅自己可见
+	LikeCount     int64                  `protobuf:"varint,13,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	CommentCount  int64                  `protobuf:"varint,14,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	CollectCount  int64                  `protobuf:"varint,15,opt,name=collect_count,json=collectCount,proto3" json:"collect_count,omitempty"`
+	Mine          bool                   `protobuf:"varint,16,opt,name=mine,proto3" json:"mine,omitempty"` // 是否为当前用户发布
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	mi := &file_content_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Post) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Post) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Post) GetSchoolId() uint32 {
+	if x != nil {
+		return x.SchoolId
+	}
+	return 0
+}
+
+func (x *Post) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Post) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Post) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Post) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Post) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *Post) GetAnonymousName() string {
+	if x != nil {
+		return x.AnonymousName
+	}
+	return ""
+}
+
+func (x *Post) GetCreateTime() uint32 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *Post) GetUpdateTime() uint32 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *Post) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Post) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *Post) GetCommentCount() int64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *Post) GetCollectCount() int64 {
+	if x != nil {
+		return x.CollectCount
+	}
+	return 0
+}
+
+func (x *Post) GetMine() bool {
+	if x != nil {
+		return x.Mine
+	}
+	return false
+}
+
+// 草稿，只有作者可见
+type Draft struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Images        []string               `protobuf:"bytes,5,rep,name=images,proto3" json:"images,omitempty"`
+	UpdateTime    uint32                 `protobuf:"varint,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Draft) Reset() {
+	*x = Draft{}
+	mi := &file_content_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Draft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Draft) ProtoMessage() {}
+
+func (x *Draft) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Draft.ProtoReflect.Descriptor instead.
+func (*Draft) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Draft) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Draft) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Draft) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Draft) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Draft) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Draft) GetUpdateTime() uint32 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+// 保存草稿，id 为空时新建，否则覆盖已有草稿
+type SaveDraftReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Images        []string               `protobuf:"bytes,5,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveDraftReq) Reset() {
+	*x = SaveDraftReq{}
+	mi := &file_content_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveDraftReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDraftReq) ProtoMessage() {}
+
+func (x *SaveDraftReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDraftReq.ProtoReflect.Descriptor instead.
+func (*SaveDraftReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SaveDraftReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SaveDraftReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SaveDraftReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SaveDraftReq) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SaveDraftReq) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// 游标分页，cursor 为上一页返回的 next_cursor，首页为空；limit 默认20、最大100
+type ListDraftsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDraftsReq) Reset() {
+	*x = ListDraftsReq{}
+	mi := &file_content_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDraftsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDraftsReq) ProtoMessage() {}
+
+func (x *ListDraftsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDraftsReq.ProtoReflect.Descriptor instead.
+func (*ListDraftsReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListDraftsReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListDraftsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// next_cursor 为空表示没有更多
+type DraftList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*Draft               `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftList) Reset() {
+	*x = DraftList{}
+	mi := &file_content_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftList) ProtoMessage() {}
+
+func (x *DraftList) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftList.ProtoReflect.Descriptor instead.
+func (*DraftList) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DraftList) GetList() []*Draft {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *DraftList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type DeleteDraftReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDraftReq) Reset() {
+	*x = DeleteDraftReq{}
+	mi := &file_content_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDraftReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDraftReq) ProtoMessage() {}
+
+func (x *DeleteDraftReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDraftReq.ProtoReflect.Descriptor instead.
+func (*DeleteDraftReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteDraftReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 发布草稿，发布后草稿删除；private 为 true 时仅自己可见
+type PublishDraftReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Anonymous     bool                   `protobuf:"varint,2,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	AnonymousName string                 `protobuf:"bytes,3,opt,name=anonymous_name,json=anonymousName,proto3" json:"anonymous_name,omitempty"` // 匿名时的称呼，为空使用默认称呼
+	Private       bool                   `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishDraftReq) Reset() {
+	*x = PublishDraftReq{}
+	mi := &file_content_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishDraftReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishDraftReq) ProtoMessage() {}
+
+func (x *PublishDraftReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishDraftReq.ProtoReflect.Descriptor instead.
+func (*PublishDraftReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PublishDraftReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PublishDraftReq) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *PublishDraftReq) GetAnonymousName() string {
+	if x != nil {
+		return x.AnonymousName
+	}
+	return ""
+}
+
+func (x *PublishDraftReq) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type GetPostReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostReq) Reset() {
+	*x = GetPostReq{}
+	mi := &file_content_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostReq) ProtoMessage() {}
+
+func (x *GetPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostReq.ProtoReflect.Descriptor instead.
+func (*GetPostReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPostReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 按学校、标签、发布者筛选，条件可组合，都为空时查询全部；按发布时间倒序
+// 查询自己发布的帖子时包含仅自己可见和审核中的帖子
+type ListPostsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SchoolId      uint32                 `protobuf:"varint,1,opt,name=school_id,json=schoolId,proto3" json:"school_id,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostsReq) Reset() {
+	*x = ListPostsReq{}
+	mi := &file_content_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsReq) ProtoMessage() {}
+
+func (x *ListPostsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsReq.ProtoReflect.Descriptor instead.
+func (*ListPostsReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListPostsReq) GetSchoolId() uint32 {
+	if x != nil {
+		return x.SchoolId
+	}
+	return 0
+}
+
+func (x *ListPostsReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListPostsReq) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListPostsReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListPostsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PostList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*Post                `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostList) Reset() {
+	*x = PostList{}
+	mi := &file_content_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostList) ProtoMessage() {}
+
+func (x *PostList) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostList.ProtoReflect.Descriptor instead.
+func (*PostList) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PostList) GetList() []*Post {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *PostList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type DeletePostReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostReq) Reset() {
+	*x = DeletePostReq{}
+	mi := &file_content_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostReq) ProtoMessage() {}
+
+func (x *DeletePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostReq.ProtoReflect.Descriptor instead.
+func (*DeletePostReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeletePostReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 设置仅自己可见，只能在正常和仅自己可见之间切换
+type SetPostVisibilityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Private       bool                   `protobuf:"varint,2,opt,name=private,proto3" json:"private,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPostVisibilityReq) Reset() {
+	*x = SetPostVisibilityReq{}
+	mi := &file_content_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPostVisibilityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPostVisibilityReq) ProtoMessage() {}
+
+func (x *SetPostVisibilityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPostVisibilityReq.ProtoReflect.Descriptor instead.
+func (*SetPostVisibilityReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetPostVisibilityReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetPostVisibilityReq) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+// 作者修改帖子内容，字段含义同草稿；标签变化时同步修改标签的帖子数
+type UpdatePostReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Images        []string               `protobuf:"bytes,5,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePostReq) Reset() {
+	*x = UpdatePostReq{}
+	mi := &file_content_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostReq) ProtoMessage() {}
+
+func (x *UpdatePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostReq.ProtoReflect.Descriptor instead.
+func (*UpdatePostReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdatePostReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePostReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdatePostReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdatePostReq) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdatePostReq) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// 标签，名称去掉开头的 #、合并连续空白、英文转小写后存储；post_count 为使用该标签的未删除帖子数，
+// 热门标签中为统计时间内使用该标签的帖子数
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PostCount     int64                  `protobuf:"varint,3,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	IsOfficial    bool                   `protobuf:"varint,4,opt,name=is_official,json=isOfficial,proto3" json:"is_official,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_content_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Tag) GetPostCount() int64 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+func (x *Tag) GetIsOfficial() bool {
+	if x != nil {
+		return x.IsOfficial
+	}
+	return false
+}
+
+// 按前缀补全标签，官方标签在前，其余按帖子数倒序；prefix 为空时返回官方标签。limit 默认10、最大50
+type SearchTagsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTagsReq) Reset() {
+	*x = SearchTagsReq{}
+	mi := &file_content_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTagsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTagsReq) ProtoMessage() {}
+
+func (x *SearchTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTagsReq.ProtoReflect.Descriptor instead.
+func (*SearchTagsReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchTagsReq) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SearchTagsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 最近 hours 小时（默认24，最大720）内正常帖子使用最多的标签，结果缓存5分钟
+type TrendingTagsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hours         int32                  `protobuf:"varint,1,opt,name=hours,proto3" json:"hours,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingTagsReq) Reset() {
+	*x = TrendingTagsReq{}
+	mi := &file_content_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingTagsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingTagsReq) ProtoMessage() {}
+
+func (x *TrendingTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingTagsReq.ProtoReflect.Descriptor instead.
+func (*TrendingTagsReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TrendingTagsReq) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *TrendingTagsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TagList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*Tag                 `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagList) Reset() {
+	*x = TagList{}
+	mi := &file_content_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagList) ProtoMessage() {}
+
+func (x *TagList) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagList.ProtoReflect.Descriptor instead.
+func (*TagList) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TagList) GetList() []*Tag {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 设置或取消官方标签，标签不存在时创建；description 为空时不修改
+type SetTagOfficialReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Official      bool                   `protobuf:"varint,2,opt,name=official,proto3" json:"official,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTagOfficialReq) Reset() {
+	*x = SetTagOfficialReq{}
+	mi := &file_content_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTagOfficialReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTagOfficialReq) ProtoMessage() {}
+
+func (x *SetTagOfficialReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTagOfficialReq.ProtoReflect.Descriptor instead.
+func (*SetTagOfficialReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetTagOfficialReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetTagOfficialReq) GetOfficial() bool {
+	if x != nil {
+		return x.Official
+	}
+	return false
+}
+
+func (x *SetTagOfficialReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// 将 source 合并到 target：帖子中的 source 替换为 target，之后发布、修改帖子和按标签查询时 source 自动改为 target
+type MergeTagsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsReq) Reset() {
+	*x = MergeTagsReq{}
+	mi := &file_content_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsReq) ProtoMessage() {}
+
+func (x *MergeTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsReq.ProtoReflect.Descriptor instead.
+func (*MergeTagsReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MergeTagsReq) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *MergeTagsReq) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// 推荐帖子，page 从1开始，page_size 默认20、最大100；第一页重新计算推荐列表，翻页时使用同一份列表
+type FeedReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedReq) Reset() {
+	*x = FeedReq{}
+	mi := &file_content_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedReq) ProtoMessage() {}
+
+func (x *FeedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedReq.ProtoReflect.Descriptor instead.
+func (*FeedReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FeedReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *FeedReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// personalized 为 false 时为热门列表（未登录或没有最近的行为）
+type FeedResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*Post                `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	Personalized  bool                   `protobuf:"varint,3,opt,name=personalized,proto3" json:"personalized,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedResp) Reset() {
+	*x = FeedResp{}
+	mi := &file_content_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedResp) ProtoMessage() {}
+
+func (x *FeedResp) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedResp.ProtoReflect.Descriptor instead.
+func (*FeedResp) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FeedResp) GetList() []*Post {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *FeedResp) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *FeedResp) GetPersonalized() bool {
+	if x != nil {
+		return x.Personalized
+	}
+	return false
+}
+
+// 点赞、收藏、取消，重复操作不报错，计数不重复增减
+type PostActionReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostActionReq) Reset() {
+	*x = PostActionReq{}
+	mi := &file_content_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostActionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostActionReq) ProtoMessage() {}
+
+func (x *PostActionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostActionReq.ProtoReflect.Descriptor instead.
+func (*PostActionReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PostActionReq) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+// 评论，匿名评论对作者以外的用户不返回 user_id；
+// 已删除的评论保留在楼中作为占位，不返回 user_id 和原内容
+type Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PostId        string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	ParentId      string                 `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 回复所在的顶层评论，顶层评论为空
+	Anonymous     bool                   `protobuf:"varint,6,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	CreateTime    uint32                 `protobuf:"varint,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	Status        int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`                            // 1-正常，0-删除
+	Mine          bool                   `protobuf:"varint,9,opt,name=mine,proto3" json:"mine,omitempty"`                                // 是否为当前用户发表
+	ReplyToId     string                 `protobuf:"bytes,10,opt,name=reply_to_id,json=replyToId,proto3" json:"reply_to_id,omitempty"`   // 被回复的评论，回复楼中楼时与 parent_id 不同
+	ReplyCount    int64                  `protobuf:"varint,11,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"` // 回复数，含已删除的回复
+	Replies       []*Comment             `protobuf:"bytes,12,rep,name=replies,proto3" json:"replies,omitempty"`                          // 顶层评论列表中附带的前几条回复
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_content_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Comment) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Comment) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *Comment) GetCreateTime() uint32 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *Comment) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Comment) GetMine() bool {
+	if x != nil {
+		return x.Mine
+	}
+	return false
+}
+
+func (x *Comment) GetReplyToId() string {
+	if x != nil {
+		return x.ReplyToId
+	}
+	return ""
+}
+
+func (x *Comment) GetReplyCount() int64 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *Comment) GetReplies() []*Comment {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+// parent_id 不为空时回复评论，post_id 可以为空；回复楼中楼时归到所在的顶层评论下
+type CreateCommentReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Anonymous     bool                   `protobuf:"varint,3,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentReq) Reset() {
+	*x = CreateCommentReq{}
+	mi := &file_content_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentReq) ProtoMessage() {}
+
+func (x *CreateCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentReq.ProtoReflect.Descriptor instead.
+func (*CreateCommentReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateCommentReq) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *CreateCommentReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateCommentReq) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *CreateCommentReq) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+// 按时间倒序查询帖子的顶层评论，每条附带最早的 reply_limit 条回复（默认3，最大10）
+type ListCommentsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	ReplyLimit    int32                  `protobuf:"varint,4,opt,name=reply_limit,json=replyLimit,proto3" json:"reply_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsReq) Reset() {
+	*x = ListCommentsReq{}
+	mi := &file_content_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsReq) ProtoMessage() {}
+
+func (x *ListCommentsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsReq.ProtoReflect.Descriptor instead.
+func (*ListCommentsReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListCommentsReq) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ListCommentsReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListCommentsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCommentsReq) GetReplyLimit() int32 {
+	if x != nil {
+		return x.ReplyLimit
+	}
+	return 0
+}
+
+// 按时间正序查询顶层评论的回复
+type ListRepliesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRepliesReq) Reset() {
+	*x = ListRepliesReq{}
+	mi := &file_content_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRepliesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepliesReq) ProtoMessage() {}
+
+func (x *ListRepliesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepliesReq.ProtoReflect.Descriptor instead.
+func (*ListRepliesReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListRepliesReq) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *ListRepliesReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListRepliesReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CommentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*Comment             `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentList) Reset() {
+	*x = CommentList{}
+	mi := &file_content_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentList) ProtoMessage() {}
+
+func (x *CommentList) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentList.ProtoReflect.Descriptor instead.
+func (*CommentList) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CommentList) GetList() []*Comment {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *CommentList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type DeleteCommentReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentReq) Reset() {
+	*x = DeleteCommentReq{}
+	mi := &file_content_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentReq) ProtoMessage() {}
+
+func (x *DeleteCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentReq.ProtoReflect.Descriptor instead.
+func (*DeleteCommentReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteCommentReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 重新统计帖子的点赞、评论、收藏数，fix 为 false 时只报告差异
+type ReconcileCountersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fix           bool                   `protobuf:"varint,1,opt,name=fix,proto3" json:"fix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileCountersReq) Reset() {
+	*x = ReconcileCountersReq{}
+	mi := &file_content_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileCountersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileCountersReq) ProtoMessage() {}
+
+func (x *ReconcileCountersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileCountersReq.ProtoReflect.Descriptor instead.
+func (*ReconcileCountersReq) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReconcileCountersReq) GetFix() bool {
+	if x != nil {
+		return x.Fix
+	}
+	return false
+}
+
+type CounterDrift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Stored        int64                  `protobuf:"varint,3,opt,name=stored,proto3" json:"stored,omitempty"` // 帖子中记录的计数
+	Actual        int64                  `protobuf:"varint,4,opt,name=actual,proto3" json:"actual,omitempty"` // 按点赞、收藏、评论记录统计的计数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CounterDrift) Reset() {
+	*x = CounterDrift{}
+	mi := &file_content_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CounterDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterDrift) ProtoMessage() {}
+
+func (x *CounterDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterDrift.ProtoReflect.Descriptor instead.
+func (*CounterDrift) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CounterDrift) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *CounterDrift) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *CounterDrift) GetStored() int64 {
+	if x != nil {
+		return x.Stored
+	}
+	return 0
+}
+
+func (x *CounterDrift) GetActual() int64 {
+	if x != nil {
+		return x.Actual
+	}
+	return 0
+}
+
+// drifts 最多返回100条
+type ReconcileCountersResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checked       int64                  `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	Drifted       int64                  `protobuf:"varint,2,opt,name=drifted,proto3" json:"drifted,omitempty"`
+	Fixed         int64                  `protobuf:"varint,3,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	Drifts        []*CounterDrift        `protobuf:"bytes,4,rep,name=drifts,proto3" json:"drifts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileCountersResp) Reset() {
+	*x = ReconcileCountersResp{}
+	mi := &file_content_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileCountersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileCountersResp) ProtoMessage() {}
+
+func (x *ReconcileCountersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_content_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileCountersResp.ProtoReflect.Descriptor instead.
+func (*ReconcileCountersResp) Descriptor() ([]byte, []int) {
+	return file_content_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReconcileCountersResp) GetChecked() int64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *ReconcileCountersResp) GetDrifted() int64 {
+	if x != nil {
+		return x.Drifted
+	}
+	return 0
+}
+
+func (x *ReconcileCountersResp) GetFixed() int64 {
+	if x != nil {
+		return x.Fixed
+	}
+	return 0
+}
+
+func (x *ReconcileCountersResp) GetDrifts() []*CounterDrift {
+	if x != nil {
+		return x.Drifts
+	}
+	return nil
+}
+
+var File_content_proto protoreflect.FileDescriptor
+
+const file_content_proto_rawDesc = "" +
+	"\n" +
+	"\rcontent.proto\x12\x04user\x1a\n" +
+	"user.proto\"\xc4\x03\n" +
+	"\x04Post\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tschool_id\x18\x03 \x01(\rR\bschoolId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x16\n" +
+	"\x06images\x18\x06 \x03(\tR\x06images\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\x1c\n" +
+	"\tanonymous\x18\b \x01(\bR\tanonymous\x12%\n" +
+	"\x0eanonymous_name\x18\t \x01(\tR\ranonymousName\x12\x1f\n" +
+	"\vcreate_time\x18\n" +
+	" \x01(\rR\n" +
+	"createTime\x12\x1f\n" +
+	"\vupdate_time\x18\v \x01(\rR\n" +
+	"updateTime\x12\x16\n" +
+	"\x06status\x18\f \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\r \x01(\x03R\tlikeCount\x12#\n" +
+	"\rcomment_count\x18\x0e \x01(\x03R\fcommentCount\x12#\n" +
+	"\rcollect_count\x18\x0f \x01(\x03R\fcollectCount\x12\x12\n" +
+	"\x04mine\x18\x10 \x01(\bR\x04mine\"\x94\x01\n" +
+	"\x05Draft\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x16\n" +
+	"\x06images\x18\x05 \x03(\tR\x06images\x12\x1f\n" +
+	"\vupdate_time\x18\x06 \x01(\rR\n" +
+	"updateTime\"z\n" +
+	"\fSaveDraftReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x16\n" +
+	"\x06images\x18\x05 \x03(\tR\x06images\"=\n" +
+	"\rListDraftsReq\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"M\n" +
+	"\tDraftList\x12\x1f\n" +
+	"\x04list\x18\x01 \x03(\v2\v.user.DraftR\x04list\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\" \n" +
+	"\x0eDeleteDraftReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x80\x01\n" +
+	"\x0fPublishDraftReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tanonymous\x18\x02 \x01(\bR\tanonymous\x12%\n" +
+	"\x0eanonymous_name\x18\x03 \x01(\tR\ranonymousName\x12\x18\n" +
+	"\aprivate\x18\x04 \x01(\bR\aprivate\"\x1c\n" +
+	"\n" +
+	"GetPostReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x84\x01\n" +
+	"\fListPostsReq\x12\x1b\n" +
+	"\tschool_id\x18\x01 \x01(\rR\bschoolId\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"K\n" +
+	"\bPostList\x12\x1e\n" +
+	"\x04list\x18\x01 \x03(\v2\n" +
+	".user.PostR\x04list\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\x1f\n" +
+	"\rDeletePostReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
+	"\x14SetPostVisibilityReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aprivate\x18\x02 \x01(\bR\aprivate\"{\n" +
+	"\rUpdatePostReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x16\n" +
+	"\x06images\x18\x05 \x03(\tR\x06images\"{\n" +
+	"\x03Tag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"post_count\x18\x03 \x01(\x03R\tpostCount\x12\x1f\n" +
+	"\vis_official\x18\x04 \x01(\bR\n" +
+	"isOfficial\"=\n" +
+	"\rSearchTagsReq\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"=\n" +
+	"\x0fTrendingTagsReq\x12\x14\n" +
+	"\x05hours\x18\x01 \x01(\x05R\x05hours\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"(\n" +
+	"\aTagList\x12\x1d\n" +
+	"\x04list\x18\x01 \x03(\v2\t.user.TagR\x04list\"e\n" +
+	"\x11SetTagOfficialReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bofficial\x18\x02 \x01(\bR\bofficial\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\">\n" +
+	"\fMergeTagsReq\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\":\n" +
+	"\aFeedReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"i\n" +
+	"\bFeedResp\x12\x1e\n" +
+	"\x04list\x18\x01 \x03(\v2\n" +
+	".user.PostR\x04list\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\"\n" +
+	"\fpersonalized\x18\x03 \x01(\bR\fpersonalized\"(\n" +
+	"\rPostActionReq\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\"\xd7\x02\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1b\n" +
+	"\tparent_id\x18\x05 \x01(\tR\bparentId\x12\x1c\n" +
+	"\tanonymous\x18\x06 \x01(\bR\tanonymous\x12\x1f\n" +
+	"\vcreate_time\x18\a \x01(\rR\n" +
+	"createTime\x12\x16\n" +
+	"\x06status\x18\b \x01(\x05R\x06status\x12\x12\n" +
+	"\x04mine\x18\t \x01(\bR\x04mine\x12\x1e\n" +
+	"\vreply_to_id\x18\n" +
+	" \x01(\tR\treplyToId\x12\x1f\n" +
+	"\vreply_count\x18\v \x01(\x03R\n" +
+	"replyCount\x12'\n" +
+	"\areplies\x18\f \x03(\v2\r.user.CommentR\areplies\"\x80\x01\n" +
+	"\x10CreateCommentReq\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1c\n" +
+	"\tanonymous\x18\x03 \x01(\bR\tanonymous\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\tR\bparentId\"y\n" +
+	"\x0fListCommentsReq\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vreply_limit\x18\x04 \x01(\x05R\n" +
+	"replyLimit\"]\n" +
+	"\x0eListRepliesReq\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"Q\n" +
+	"\vCommentList\x12!\n" +
+	"\x04list\x18\x01 \x03(\v2\r.user.CommentR\x04list\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\"\n" +
+	"\x10DeleteCommentReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"(\n" +
+	"\x14ReconcileCountersReq\x12\x10\n" +
+	"\x03fix\x18\x01 \x01(\bR\x03fix\"m\n" +
+	"\fCounterDrift\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x16\n" +
+	"\x06stored\x18\x03 \x01(\x03R\x06stored\x12\x16\n" +
+	"\x06actual\x18\x04 \x01(\x03R\x06actual\"\x8d\x01\n" +
+	"\x15ReconcileCountersResp\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\x03R\achecked\x12\x18\n" +
+	"\adrifted\x18\x02 \x01(\x03R\adrifted\x12\x14\n" +
+	"\x05fixed\x18\x03 \x01(\x03R\x05fixed\x12*\n" +
+	"\x06drifts\x18\x04 \x03(\v2\x12.user.CounterDriftR\x06drifts2\xd5\t\n" +
+	"\aContent\x12.\n" +
+	"\tSaveDraft\x12\x12.user.SaveDraftReq\x1a\v.user.Draft\"\x00\x124\n" +
+	"\n" +
+	"ListDrafts\x12\x13.user.ListDraftsReq\x1a\x0f.user.DraftList\"\x00\x122\n" +
+	"\vDeleteDraft\x12\x14.user.DeleteDraftReq\x1a\v.user.Empty\"\x00\x123\n" +
+	"\fPublishDraft\x12\x15.user.PublishDraftReq\x1a\n" +
+	".user.Post\"\x00\x12)\n" +
+	"\aGetPost\x12\x10.user.GetPostReq\x1a\n" +
+	".user.Post\"\x00\x121\n" +
+	"\tListPosts\x12\x12.user.ListPostsReq\x1a\x0e.user.PostList\"\x00\x12'\n" +
+	"\x04Feed\x12\r.user.FeedReq\x1a\x0e.user.FeedResp\"\x00\x120\n" +
+	"\n" +
+	"DeletePost\x12\x13.user.DeletePostReq\x1a\v.user.Empty\"\x00\x12=\n" +
+	"\x11SetPostVisibility\x12\x1a.user.SetPostVisibilityReq\x1a\n" +
+	".user.Post\"\x00\x12/\n" +
+	"\n" +
+	"UpdatePost\x12\x13.user.UpdatePostReq\x1a\n" +
+	".user.Post\"\x00\x12.\n" +
+	"\bLikePost\x12\x13.user.PostActionReq\x1a\v.user.Empty\"\x00\x120\n" +
+	"\n" +
+	"UnlikePost\x12\x13.user.PostActionReq\x1a\v.user.Empty\"\x00\x121\n" +
+	"\vCollectPost\x12\x13.user.PostActionReq\x1a\v.user.Empty\"\x00\x123\n" +
+	"\rUncollectPost\x12\x13.user.PostActionReq\x1a\v.user.Empty\"\x00\x128\n" +
+	"\rCreateComment\x12\x16.user.CreateCommentReq\x1a\r.user.Comment\"\x00\x12:\n" +
+	"\fListComments\x12\x15.user.ListCommentsReq\x1a\x11.user.CommentList\"\x00\x128\n" +
+	"\vListReplies\x12\x14.user.ListRepliesReq\x1a\x11.user.CommentList\"\x00\x126\n" +
+	"\rDeleteComment\x12\x16.user.DeleteCommentReq\x1a\v.user.Empty\"\x00\x122\n" +
+	"\n" +
+	"SearchTags\x12\x13.user.SearchTagsReq\x1a\r.user.TagList\"\x00\x126\n" +
+	"\fTrendingTags\x12\x15.user.TrendingTagsReq\x1a\r.user.TagList\"\x00\x12N\n" +
+	"\x11ReconcileCounters\x12\x1a.user.ReconcileCountersReq\x1a\x1b.user.ReconcileCountersResp\"\x00\x126\n" +
+	"\x0eSetTagOfficial\x12\x17.user.SetTagOfficialReq\x1a\t.user.Tag\"\x00\x12,\n" +
+	"\tMergeTags\x12\x12.user.MergeTagsReq\x1a\t.user.Tag\"\x00B\x0eZ\fuser.serviceb\x06proto3"
+
+var (
+	file_content_proto_rawDescOnce sync.Once
+	file_content_proto_rawDescData []byte
+)
+
+func file_content_proto_rawDescGZIP() []byte {
+	file_content_proto_rawDescOnce.Do(func() {
+		file_content_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_content_proto_rawDesc), len(file_content_proto_rawDesc)))
+	})
+	return file_content_proto_rawDescData
+}
+
+var file_content_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_content_proto_goTypes = []any{
+	(*Post)(nil),                  // 0: user.Post
+	(*Draft)(nil),                 // 1: user.Draft
+	(*SaveDraftReq)(nil),          // 2: user.SaveDraftReq
+	(*ListDraftsReq)(nil),         // 3: user.ListDraftsReq
+	(*DraftList)(nil),             // 4: user.DraftList
+	(*DeleteDraftReq)(nil),        // 5: user.DeleteDraftReq
+	(*PublishDraftReq)(nil),       // 6: user.PublishDraftReq
+	(*GetPostReq)(nil),            // 7: user.GetPostReq
+	(*ListPostsReq)(nil),          // 8: user.ListPostsReq
+	(*PostList)(nil),              // 9: user.PostList
+	(*DeletePostReq)(nil),         // 10: user.DeletePostReq
+	(*SetPostVisibilityReq)(nil),  // 11: user.SetPostVisibilityReq
+	(*UpdatePostReq)(nil),         // 12: user.UpdatePostReq
+	(*Tag)(nil),                   // 13: user.Tag
+	(*SearchTagsReq)(nil),         // 14: user.SearchTagsReq
+	(*TrendingTagsReq)(nil),       // 15: user.TrendingTagsReq
+	(*TagList)(nil),               // 16: user.TagList
+	(*SetTagOfficialReq)(nil),     // 17: user.SetTagOfficialReq
+	(*MergeTagsReq)(nil),          // 18: user.MergeTagsReq
+	(*FeedReq)(nil),               // 19: user.FeedReq
+	(*FeedResp)(nil),              // 20: user.FeedResp
+	(*PostActionReq)(nil),         // 21: user.PostActionReq
+	(*Comment)(nil),               // 22: user.Comment
+	(*CreateCommentReq)(nil),      // 23: user.CreateCommentReq
+	(*ListCommentsReq)(nil),       // 24: user.ListCommentsReq
+	(*ListRepliesReq)(nil),        // 25: user.ListRepliesReq
+	(*CommentList)(nil),           // 26: user.CommentList
+	(*DeleteCommentReq)(nil),      // 27: user.DeleteCommentReq
+	(*ReconcileCountersReq)(nil),  // 28: user.ReconcileCountersReq
+	(*CounterDrift)(nil),          // 29: user.CounterDrift
+	(*ReconcileCountersResp)(nil), // 30: user.ReconcileCountersResp
+	(*Empty)(nil),                 // 31: user.Empty
+}
+var file_content_proto_depIdxs = []int32{
+	1,  // 0: user.DraftList.list:type_name -> user.Draft
+	0,  // 1: user.PostList.list:type_name -> user.Post
+	13, // 2: user.TagList.list:type_name -> user.Tag
+	0,  // 3: user.FeedResp.list:type_name -> user.Post
+	22, // 4: user.Comment.replies:type_name -> user.Comment
+	22, // 5: user.CommentList.list:type_name -> user.Comment
+	29, // 6: user.ReconcileCountersResp.drifts:type_name -> user.CounterDrift
+	2,  // 7: user.Content.SaveDraft:input_type -> user.SaveDraftReq
+	3,  // 8: user.Content.ListDrafts:input_type -> user.ListDraftsReq
+	5,  // 9: user.Content.DeleteDraft:input_type -> user.DeleteDraftReq
+	6,  // 10: user.Content.PublishDraft:input_type -> user.PublishDraftReq
+	7,  // 11: user.Content.GetPost:input_type -> user.GetPostReq
+	8,  // 12: user.Content.ListPosts:input_type -> user.ListPostsReq
+	19, // 13: user.Content.Feed:input_type -> user.FeedReq
+	10, // 14: user.Content.DeletePost:input_type -> user.DeletePostReq
+	11, // 15: user.Content.SetPostVisibility:input_type -> user.SetPostVisibilityReq
+	12, // 16: user.Content.UpdatePost:input_type -> user.UpdatePostReq
+	21, // 17: user.Content.LikePost:input_type -> user.PostActionReq
+	21, // 18: user.Content.UnlikePost:input_type -> user.PostActionReq
+	21, // 19: user.Content.CollectPost:input_type -> user.PostActionReq
+	21, // 20: user.Content.UncollectPost:input_type -> user.PostActionReq
+	23, // 21: user.Content.CreateComment:input_type -> user.CreateCommentReq
+	24, // 22: user.Content.ListComments:input_type -> user.ListCommentsReq
+	25, // 23: user.Content.ListReplies:input_type -> user.ListRepliesReq
+	27, // 24: user.Content.DeleteComment:input_type -> user.DeleteCommentReq
+	14, // 25: user.Content.SearchTags:input_type -> user.SearchTagsReq
+	15, // 26: user.Content.TrendingTags:input_type -> user.TrendingTagsReq
+	28, // 27: user.Content.ReconcileCounters:input_type -> user.ReconcileCountersReq
+	17, // 28: user.Content.SetTagOfficial:input_type -> user.SetTagOfficialReq
+	18, // 29: user.Content.MergeTags:input_type -> user.MergeTagsReq
+	1,  // 30: user.Content.SaveDraft:output_type -> user.Draft
+	4,  // 31: user.Content.ListDrafts:output_type -> user.DraftList
+	31, // 32: user.Content.DeleteDraft:output_type -> user.Empty
+	0,  // 33: user.Content.PublishDraft:output_type -> user.Post
+	0,  // 34: user.Content.GetPost:output_type -> user.Post
+	9,  // 35: user.Content.ListPosts:output_type -> user.PostList
+	20, // 36: user.Content.Feed:output_type -> user.FeedResp
+	31, // 37: user.Content.DeletePost:output_type -> user.Empty
+	0,  // 38: user.Content.SetPostVisibility:output_type -> user.Post
+	0,  // 39: user.Content.UpdatePost:output_type -> user.Post
+	31, // 40: user.Content.LikePost:output_type -> user.Empty
+	31, // 41: user.Content.UnlikePost:output_type -> user.Empty
+	31, // 42: user.Content.CollectPost:output_type -> user.Empty
+	31, // 43: user.Content.UncollectPost:output_type -> user.Empty
+	22, // 44: user.Content.CreateComment:output_type -> user.Comment
+	26, // 45: user.Content.ListComments:output_type -> user.CommentList
+	26, // 46: user.Content.ListReplies:output_type -> user.CommentList
+	31, // 47: user.Content.DeleteComment:output_type -> user.Empty
+	16, // 48: user.Content.SearchTags:output_type -> user.TagList
+	16, // 49: user.Content.TrendingTags:output_type -> user.TagList
+	30, // 50: user.Content.ReconcileCounters:output_type -> user.ReconcileCountersResp
+	13, // 51: user.Content.SetTagOfficial:output_type -> user.Tag
+	13, // 52: user.Content.MergeTags:output_type -> user.Tag
+	30, // [30:53] is the sub-list for method output_type
+	7,  // [7:30] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_content_proto_init() }
+func file_content_proto_init() {
+	if File_content_proto != nil {
+		return
+	}
+	file_user_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_content_proto_rawDesc), len(file_content_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   31,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_content_proto_goTypes,
+		DependencyIndexes: file_content_proto_depIdxs,
+		MessageInfos:      file_content_proto_msgTypes,
+	}.Build()
+	File_content_proto = out.File
+	file_content_proto_goTypes = nil
+	file_content_proto_depIdxs = nil
+}
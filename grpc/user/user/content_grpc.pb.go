@@ -0,0 +1,963 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: content.proto
+
+package user_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Content_SaveDraft_FullMethodName         = "/user.Content/SaveDraft"
+	Content_ListDrafts_FullMethodName        = "/user.Content/ListDrafts"
+	Content_DeleteDraft_FullMethodName       = "/user.Content/DeleteDraft"
+	Content_PublishDraft_FullMethodName      = "/user.Content/PublishDraft"
+	Content_GetPost_FullMethodName           = "/user.Content/GetPost"
+	Content_ListPosts_FullMethodName         = "/user.Content/ListPosts"
+	Content_Feed_FullMethodName              = "/user.Content/Feed"
+	Content_DeletePost_FullMethodName        = "/user.Content/DeletePost"
+	Content_SetPostVisibility_FullMethodName = "/user.Content/SetPostVisibility"
+	Content_UpdatePost_FullMethodName        = "/user.Content/UpdatePost"
+	Content_LikePost_FullMethodName          = "/user.Content/LikePost"
+	Content_UnlikePost_FullMethodName        = "/user.Content/UnlikePost"
+	Content_CollectPost_FullMethodName       = "/user.Content/CollectPost"
+	Content_UncollectPost_FullMethodName     = "/user.Content/UncollectPost"
+	Content_CreateComment_FullMethodName     = "/user.Content/CreateComment"
+	Content_ListComments_FullMethodName      = "/user.Content/ListComments"
+	Content_ListReplies_FullMethodName       = "/user.Content/ListReplies"
+	Content_DeleteComment_FullMethodName     = "/user.Content/DeleteComment"
+	Content_SearchTags_FullMethodName        = "/user.Content/SearchTags"
+	Content_TrendingTags_FullMethodName      = "/user.Content/TrendingTags"
+	Content_ReconcileCounters_FullMethodName = "/user.Content/ReconcileCounters"
+	Content_SetTagOfficial_FullMethodName    = "/user.Content/SetTagOfficial"
+	Content_MergeTags_FullMethodName         = "/user.Content/MergeTags"
+)
+
+// ContentClient is the client API for Content service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 帖子内容，写接口需要登录；删除帖子、评论需要作者本人或 admin 角色
+type ContentClient interface {
+	SaveDraft(ctx context.Context, in *SaveDraftReq, opts ...grpc.CallOption) (*Draft, error)
+	ListDrafts(ctx context.Context, in *ListDraftsReq, opts ...grpc.CallOption) (*DraftList, error)
+	DeleteDraft(ctx context.Context, in *DeleteDraftReq, opts ...grpc.CallOption) (*Empty, error)
+	PublishDraft(ctx context.Context, in *PublishDraftReq, opts ...grpc.CallOption) (*Post, error)
+	GetPost(ctx context.Context, in *GetPostReq, opts ...grpc.CallOption) (*Post, error)
+	ListPosts(ctx context.Context, in *ListPostsReq, opts ...grpc.CallOption) (*PostList, error)
+	Feed(ctx context.Context, in *FeedReq, opts ...grpc.CallOption) (*FeedResp, error)
+	DeletePost(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*Empty, error)
+	SetPostVisibility(ctx context.Context, in *SetPostVisibilityReq, opts ...grpc.CallOption) (*Post, error)
+	UpdatePost(ctx context.Context, in *UpdatePostReq, opts ...grpc.CallOption) (*Post, error)
+	LikePost(ctx context.Context, in *PostActionReq, opts ...grpc.CallOption) (*Empty, error)
+	UnlikePost(ctx context.Context, in *PostActionReq, opts ...grpc.CallOption) (*Empty, error)
+	CollectPost(ctx context.Context, in *PostActionReq, opts ...grpc.CallOption) (*Empty, error)
+	UncollectPost(ctx context.Context, in *PostActionReq, opts ...grpc.CallOption) (*Empty, error)
+	CreateComment(ctx context.Context, in *CreateCommentReq, opts ...grpc.CallOption) (*Comment, error)
+	ListComments(ctx context.Context, in *ListCommentsReq, opts ...grpc.CallOption) (*CommentList, error)
+	ListReplies(ctx context.Context, in *ListRepliesReq, opts ...grpc.CallOption) (*CommentList, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*Empty, error)
+	SearchTags(ctx context.Context, in *SearchTagsReq, opts ...grpc.CallOption) (*TagList, error)
+	TrendingTags(ctx context.Context, in *TrendingTagsReq, opts ...grpc.CallOption) (*TagList, error)
+	// 需要 admin 角色
+	ReconcileCounters(ctx context.Context, in *ReconcileCountersReq, opts ...grpc.CallOption) (*ReconcileCountersResp, error)
+	SetTagOfficial(ctx context.Context, in *SetTagOfficialReq, opts ...grpc.CallOption) (*Tag, error)
+	MergeTags(ctx context.Context, in *MergeTagsReq, opts ...grpc.CallOption) (*Tag, error)
+}
+
+type contentClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewContentClient(cc grpc.ClientConnInterface) ContentClient {
+	return &contentClient{cc}
+}
+
+func (c *contentClient) SaveDraft(ctx context.Context, in *SaveDraftReq, opts ...grpc.CallOption) (*Draft, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Draft)
+	err := c.cc.Invoke(ctx, Content_SaveDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) ListDrafts(ctx context.Context, in *ListDraftsReq, opts ...grpc.CallOption) (*DraftList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DraftList)
+	err := c.cc.Invoke(ctx, Content_ListDrafts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) DeleteDraft(ctx context.Context, in *DeleteDraftReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Content_DeleteDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) PublishDraft(ctx context.Context, in *PublishDraftReq, opts ...grpc.CallOption) (*Post, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Post)
+	err := c.cc.Invoke(ctx, Content_PublishDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) GetPost(ctx context.Context, in *GetPostReq, opts ...grpc.CallOption) (*Post, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Post)
+	err := c.cc.Invoke(ctx, Content_GetPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) ListPosts(ctx context.Context, in *ListPostsReq, opts ...grpc.CallOption) (*PostList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostList)
+	err := c.cc.Invoke(ctx, Content_ListPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) Feed(ctx context.Context, in *FeedReq, opts ...grpc.CallOption) (*FeedResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedResp)
+	err := c.cc.Invoke(ctx, Content_Feed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) DeletePost(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Content_DeletePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) SetPostVisibility(ctx context.Context, in *SetPostVisibilityReq, opts ...grpc.CallOption) (*Post, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Post)
+	err := c.cc.Invoke(ctx, Content_SetPostVisibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) UpdatePost(ctx context.Context, in *UpdatePostReq, opts ...grpc.CallOption) (*Post, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Post)
+	err := c.cc.Invoke(ctx, Content_UpdatePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) LikePost(ctx context.Context, in *PostActionReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Content_LikePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) UnlikePost(ctx context.Context, in *PostActionReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Content_UnlikePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) CollectPost(ctx context.Context, in *PostActionReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Content_CollectPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) UncollectPost(ctx context.Context, in *PostActionReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Content_UncollectPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) CreateComment(ctx context.Context, in *CreateCommentReq, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, Content_CreateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) ListComments(ctx context.Context, in *ListCommentsReq, opts ...grpc.CallOption) (*CommentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentList)
+	err := c.cc.Invoke(ctx, Content_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) ListReplies(ctx context.Context, in *ListRepliesReq, opts ...grpc.CallOption) (*CommentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentList)
+	err := c.cc.Invoke(ctx, Content_ListReplies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Content_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) SearchTags(ctx context.Context, in *SearchTagsReq, opts ...grpc.CallOption) (*TagList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagList)
+	err := c.cc.Invoke(ctx, Content_SearchTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) TrendingTags(ctx context.Context, in *TrendingTagsReq, opts ...grpc.CallOption) (*TagList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagList)
+	err := c.cc.Invoke(ctx, Content_TrendingTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) ReconcileCounters(ctx context.Context, in *ReconcileCountersReq, opts ...grpc.CallOption) (*ReconcileCountersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileCountersResp)
+	err := c.cc.Invoke(ctx, Content_ReconcileCounters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) SetTagOfficial(ctx context.Context, in *SetTagOfficialReq, opts ...grpc.CallOption) (*Tag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, Content_SetTagOfficial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) MergeTags(ctx context.Context, in *MergeTagsReq, opts ...grpc.CallOption) (*Tag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, Content_MergeTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ContentServer is the server API for Content service.
+// All implementations must embed UnimplementedContentServer
+// for forward compatibility.
+//
+// 帖子内容，写接口需要登录；删除帖子、评论需要作者本人或 admin 角色
+type ContentServer interface {
+	SaveDraft(context.Context, *SaveDraftReq) (*Draft, error)
+	ListDrafts(context.Context, *ListDraftsReq) (*DraftList, error)
+	DeleteDraft(context.Context, *DeleteDraftReq) (*Empty, error)
+	PublishDraft(context.Context, *PublishDraftReq) (*Post, error)
+	GetPost(context.Context, *GetPostReq) (*Post, error)
+	ListPosts(context.Context, *ListPostsReq) (*PostList, error)
+	Feed(context.Context, *FeedReq) (*FeedResp, error)
+	DeletePost(context.Context, *DeletePostReq) (*Empty, error)
+	SetPostVisibility(context.Context, *SetPostVisibilityReq) (*Post, error)
+	UpdatePost(context.Context, *UpdatePostReq) (*Post, error)
+	LikePost(context.Context, *PostActionReq) (*Empty, error)
+	UnlikePost(context.Context, *PostActionReq) (*Empty, error)
+	CollectPost(context.Context, *PostActionReq) (*Empty, error)
+	UncollectPost(context.Context, *PostActionReq) (*Empty, error)
+	CreateComment(context.Context, *CreateCommentReq) (*Comment, error)
+	ListComments(context.Context, *ListCommentsReq) (*CommentList, error)
+	ListReplies(context.Context, *ListRepliesReq) (*CommentList, error)
+	DeleteComment(context.Context, *DeleteCommentReq) (*Empty, error)
+	SearchTags(context.Context, *SearchTagsReq) (*TagList, error)
+	TrendingTags(context.Context, *TrendingTagsReq) (*TagList, error)
+	// 需要 admin 角色
+	ReconcileCounters(context.Context, *ReconcileCountersReq) (*ReconcileCountersResp, error)
+	SetTagOfficial(context.Context, *SetTagOfficialReq) (*Tag, error)
+	MergeTags(context.Context, *MergeTagsReq) (*Tag, error)
+	mustEmbedUnimplementedContentServer()
+}
+
+// UnimplementedContentServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedContentServer struct{}
+
+func (UnimplementedContentServer) SaveDraft(context.Context, *SaveDraftReq) (*Draft, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDraft not implemented")
+}
+func (UnimplementedContentServer) ListDrafts(context.Context, *ListDraftsReq) (*DraftList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrafts not implemented")
+}
+func (UnimplementedContentServer) DeleteDraft(context.Context, *DeleteDraftReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDraft not implemented")
+}
+func (UnimplementedContentServer) PublishDraft(context.Context, *PublishDraftReq) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDraft not implemented")
+}
+func (UnimplementedContentServer) GetPost(context.Context, *GetPostReq) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
+}
+func (UnimplementedContentServer) ListPosts(context.Context, *ListPostsReq) (*PostList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPosts not implemented")
+}
+func (UnimplementedContentServer) Feed(context.Context, *FeedReq) (*FeedResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Feed not implemented")
+}
+func (UnimplementedContentServer) DeletePost(context.Context, *DeletePostReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (UnimplementedContentServer) SetPostVisibility(context.Context, *SetPostVisibilityReq) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPostVisibility not implemented")
+}
+func (UnimplementedContentServer) UpdatePost(context.Context, *UpdatePostReq) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+}
+func (UnimplementedContentServer) LikePost(context.Context, *PostActionReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+}
+func (UnimplementedContentServer) UnlikePost(context.Context, *PostActionReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikePost not implemented")
+}
+func (UnimplementedContentServer) CollectPost(context.Context, *PostActionReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectPost not implemented")
+}
+func (UnimplementedContentServer) UncollectPost(context.Context, *PostActionReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncollectPost not implemented")
+}
+func (UnimplementedContentServer) CreateComment(context.Context, *CreateCommentReq) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedContentServer) ListComments(context.Context, *ListCommentsReq) (*CommentList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedContentServer) ListReplies(context.Context, *ListRepliesReq) (*CommentList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReplies not implemented")
+}
+func (UnimplementedContentServer) DeleteComment(context.Context, *DeleteCommentReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedContentServer) SearchTags(context.Context, *SearchTagsReq) (*TagList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTags not implemented")
+}
+func (UnimplementedContentServer) TrendingTags(context.Context, *TrendingTagsReq) (*TagList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrendingTags not implemented")
+}
+func (UnimplementedContentServer) ReconcileCounters(context.Context, *ReconcileCountersReq) (*ReconcileCountersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileCounters not implemented")
+}
+func (UnimplementedContentServer) SetTagOfficial(context.Context, *SetTagOfficialReq) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTagOfficial not implemented")
+}
+func (UnimplementedContentServer) MergeTags(context.Context, *MergeTagsReq) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedContentServer) mustEmbedUnimplementedContentServer() {}
+func (UnimplementedContentServer) testEmbeddedByValue()                 {}
+
+// UnsafeContentServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ContentServer will
+// result in compilation errors.
+type UnsafeContentServer interface {
+	mustEmbedUnimplementedContentServer()
+}
+
+func RegisterContentServer(s grpc.ServiceRegistrar, srv ContentServer) {
+	// If the following call pancis, it indicates UnimplementedContentServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Content_ServiceDesc, srv)
+}
+
+func _Content_SaveDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).SaveDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_SaveDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).SaveDraft(ctx, req.(*SaveDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_ListDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDraftsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).ListDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_ListDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).ListDrafts(ctx, req.(*ListDraftsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_DeleteDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).DeleteDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_DeleteDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).DeleteDraft(ctx, req.(*DeleteDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_PublishDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).PublishDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_PublishDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).PublishDraft(ctx, req.(*PublishDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).GetPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_GetPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).GetPost(ctx, req.(*GetPostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_ListPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).ListPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_ListPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).ListPosts(ctx, req.(*ListPostsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_Feed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).Feed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_Feed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).Feed(ctx, req.(*FeedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_DeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).DeletePost(ctx, req.(*DeletePostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_SetPostVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPostVisibilityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).SetPostVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_SetPostVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).SetPostVisibility(ctx, req.(*SetPostVisibilityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).UpdatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_UpdatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).UpdatePost(ctx, req.(*UpdatePostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostActionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).LikePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_LikePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).LikePost(ctx, req.(*PostActionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_UnlikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostActionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).UnlikePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_UnlikePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).UnlikePost(ctx, req.(*PostActionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_CollectPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostActionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).CollectPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_CollectPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).CollectPost(ctx, req.(*PostActionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_UncollectPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostActionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).UncollectPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_UncollectPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).UncollectPost(ctx, req.(*PostActionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).CreateComment(ctx, req.(*CreateCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).ListComments(ctx, req.(*ListCommentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_ListReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepliesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).ListReplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_ListReplies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).ListReplies(ctx, req.(*ListRepliesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).DeleteComment(ctx, req.(*DeleteCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_SearchTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).SearchTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_SearchTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).SearchTags(ctx, req.(*SearchTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_TrendingTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrendingTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).TrendingTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_TrendingTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).TrendingTags(ctx, req.(*TrendingTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_ReconcileCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileCountersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).ReconcileCounters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_ReconcileCounters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).ReconcileCounters(ctx, req.(*ReconcileCountersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_SetTagOfficial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTagOfficialReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).SetTagOfficial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_SetTagOfficial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).SetTagOfficial(ctx, req.(*SetTagOfficialReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Content_MergeTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).MergeTags(ctx, req.(*MergeTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Content_ServiceDesc is the grpc.ServiceDesc for Content service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Content_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.Content",
+	HandlerType: (*ContentServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SaveDraft",
+			Handler:    _Content_SaveDraft_Handler,
+		},
+		{
+			MethodName: "ListDrafts",
+			Handler:    _Content_ListDrafts_Handler,
+		},
+		{
+			MethodName: "DeleteDraft",
+			Handler:    _Content_DeleteDraft_Handler,
+		},
+		{
+			MethodName: "PublishDraft",
+			Handler:    _Content_PublishDraft_Handler,
+		},
+		{
+			MethodName: "GetPost",
+			Handler:    _Content_GetPost_Handler,
+		},
+		{
+			MethodName: "ListPosts",
+			Handler:    _Content_ListPosts_Handler,
+		},
+		{
+			MethodName: "Feed",
+			Handler:    _Content_Feed_Handler,
+		},
+		{
+			MethodName: "DeletePost",
+			Handler:    _Content_DeletePost_Handler,
+		},
+		{
+			MethodName: "SetPostVisibility",
+			Handler:    _Content_SetPostVisibility_Handler,
+		},
+		{
+			MethodName: "UpdatePost",
+			Handler:    _Content_UpdatePost_Handler,
+		},
+		{
+			MethodName: "LikePost",
+			Handler:    _Content_LikePost_Handler,
+		},
+		{
+			MethodName: "UnlikePost",
+			Handler:    _Content_UnlikePost_Handler,
+		},
+		{
+			MethodName: "CollectPost",
+			Handler:    _Content_CollectPost_Handler,
+		},
+		{
+			MethodName: "UncollectPost",
+			Handler:    _Content_UncollectPost_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _Content_CreateComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _Content_ListComments_Handler,
+		},
+		{
+			MethodName: "ListReplies",
+			Handler:    _Content_ListReplies_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _Content_DeleteComment_Handler,
+		},
+		{
+			MethodName: "SearchTags",
+			Handler:    _Content_SearchTags_Handler,
+		},
+		{
+			MethodName: "TrendingTags",
+			Handler:    _Content_TrendingTags_Handler,
+		},
+		{
+			MethodName: "ReconcileCounters",
+			Handler:    _Content_ReconcileCounters_Handler,
+		},
+		{
+			MethodName: "SetTagOfficial",
+			Handler:    _Content_SetTagOfficial_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _Content_MergeTags_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "content.proto",
+}
@@ -1,29 +1,47 @@
 package config
 
 import (
+	"fmt"
 	"path"
+	"sync"
 
 	"common/applog"
+	"common/conf"
 	"common/env"
-
-	"github.com/BurntSushi/toml"
+	"common/jwtutils"
+	"common/lifecycle"
+	"common/secrets"
+	"common/session"
 )
 
+// envPrefix 环境变量前缀，如 USER_SERVICE_MYSQL_MASTER_PASSWORD
+const envPrefix = "user_service"
+
 type Config struct {
-	Server ServerConfig     `toml:"server"`
-	Redis  RedisConfig      `toml:"redis"`
-	Mysql  MysqlConfig      `toml:"mysql"`
-	Mongo  MongoConfig      `toml:"mongo"`
-	AppLog applog.LogConfig `toml:"app_log"`
-	Jaeger JaegerConfig     `toml:"jaeger"`
-	Grpc   GrpcConfig       `toml:"grpc"`
-	Etcd   EtcdConfig       `toml:"etcd"`
+	Server   ServerConfig             `toml:"server"`
+	Redis    RedisConfig              `toml:"redis"`
+	Mysql    MysqlConfig              `toml:"mysql"`
+	Mongo    MongoConfig              `toml:"mongo"`
+	AppLog   applog.LogConfig         `toml:"app_log"`
+	Jaeger   JaegerConfig             `toml:"jaeger"`
+	Grpc     GrpcConfig               `toml:"grpc"`
+	Etcd     EtcdConfig               `toml:"etcd"`
+	Kafka    KafkaConfig              `toml:"kafka"`
+	Content  ContentConfig            `toml:"content"`
+	Dynamic  conf.WatchConfig         `toml:"dynamic"`
+	Jwt      jwtutils.Config          `toml:"jwt"`
+	Session  session.Config           `toml:"session"`
+	Secrets  secrets.Config           `toml:"secrets"`
+	Startup  lifecycle.StartupConfig  `toml:"startup"`
+	Shutdown lifecycle.ShutdownConfig `toml:"shutdown"`
 }
 
 // ServerConfig server配置
 type ServerConfig struct {
-	Name string `toml:"name"`
-	Env  string `toml:"env"`
+	Name   string  `toml:"name"`
+	Env    string  `toml:"env" default:"dev"`
+	NodeID int64   `toml:"node_id" default:"1"` // 雪花算法节点ID（0-1023），多实例部署时不能重复
+	Admins []int64 `toml:"admins"`              // 管理员用户ID，登录时签发 admin 角色
 }
 
 // RedisConfig redis配置（包含client和pool子表）
@@ -62,7 +80,10 @@ type MysqlConfig struct {
 	WriteTimeOut    int          `toml:"write_time_out"`
 	ReadTimeOut     int          `toml:"read_time_out"`
 	Master          MysqlMaster  `toml:"master"`
-	Slaves          []MysqlSlave `toml:"slaves"` // 数组对应TOML的[[mysql.slaves]]
+	Slaves          []MysqlSlave `toml:"slaves"`                             // 数组对应TOML的[[mysql.slaves]]
+	SlavePolicy     string       `toml:"slave_policy" default:"round_robin"` // 从库负载均衡：round_robin / least_conn
+	SlaveCheckTime  int          `toml:"slave_check_time" default:"5"`       // 从库健康检查间隔（秒）
+	SlaveMaxLag     int          `toml:"slave_max_lag" default:"10"`         // 复制延迟超过该值（秒）时摘除从库
 	DBDriver        string       `toml:"db_driver"`
 	ConnMaxLifeTime int          `toml:"conn_max_life_time"`
 	MaxIdleCount    int          `toml:"max_idle_count"`
@@ -71,6 +92,7 @@ type MysqlConfig struct {
 	SQLLogLen       int          `toml:"sql_log_len"`
 	SQLArgsLogLen   int          `toml:"sql_args_log_len"`
 	LogIDTransport  bool         `toml:"log_id_transport"`
+	SlowQueryTime   int          `toml:"slow_query_time" default:"200"` // 慢查询阈值（毫秒）
 	DSNParams       string       `toml:"dsn_params"`
 }
 
@@ -115,11 +137,13 @@ type JaegerConfig struct {
 
 // GrpcConfig grpc配置
 type GrpcConfig struct {
-	EtcdAddr string `toml:"etcd_addr"`
-	Addr     string `toml:"addr"`
-	Name     string `toml:"name"`
-	Version  string `toml:"version"`
-	Weight   int    `toml:"weight"`
+	EtcdAddr            string `toml:"etcd_addr"`
+	Addr                string `toml:"addr"`
+	Name                string `toml:"name"`
+	Version             string `toml:"version"`
+	Weight              int    `toml:"weight" default:"1"`
+	HealthCheckInterval int    `toml:"health_check_interval" default:"5"` // 依赖健康检查间隔（秒）
+	HealthCheckTimeout  int    `toml:"health_check_timeout" default:"2"`  // 单次依赖探测超时（秒）
 }
 
 // EtcdConfig etcd配置
@@ -127,17 +151,116 @@ type EtcdConfig struct {
 	Addrs []string `toml:"addrs"`
 }
 
-var cfg Config
+// KafkaConfig 业务消息 kafka 配置，addr 为空时不启用，事务提交后发布消息（database.Publish）不可用
+type KafkaConfig struct {
+	Addr string `toml:"addr"`
+}
+
+// ContentConfig 帖子相关配置
+type ContentConfig struct {
+	ReconcileInterval int  `toml:"reconcile_interval" default:"60"` // 校正点赞、评论、收藏计数的间隔（分钟），0 不执行
+	ReconcileBatch    int  `toml:"reconcile_batch" default:"500"`   // 每批校正的帖子数
+	ReconcileFix      bool `toml:"reconcile_fix"`                   // 是否修正差异，false 只记录日志
+
+	// 用户行为事件发送到 kafka 后由消费者批量写入 mongodb，未配置 kafka 或 topic 为空时不记录
+	BehaviorTopic         string `toml:"behavior_topic" default:"user_behavior"`
+	BehaviorGroup         string `toml:"behavior_group" default:"user_behavior_persist"` // 消费者组
+	BehaviorBatch         int    `toml:"behavior_batch" default:"200"`                   // 每批写入的事件数
+	BehaviorFlushInterval int    `toml:"behavior_flush_interval" default:"1000"`         // 未凑满一批时写入的间隔（毫秒）
+}
 
-// InitConfig 加载TOML配置文件
+// Validate 校验配置
+func (c *Config) Validate() error {
+	var v conf.Validation
+	v.Required("server.name", c.Server.Name)
+	v.Range("server.node_id", int(c.Server.NodeID), 0, 1023)
+	v.Required("redis.client.host", c.Redis.Client.Host)
+	v.Range("redis.client.port", c.Redis.Client.Port, 1, 65535)
+	v.Required("mysql.master.host", c.Mysql.Master.Host)
+	v.Range("mysql.master.port", c.Mysql.Master.Port, 1, 65535)
+	v.Required("mysql.master.db_name", c.Mysql.Master.DBName)
+	if c.Mysql.Separation {
+		v.Required("mysql.slaves", c.Mysql.Slaves)
+		v.OneOf("mysql.slave_policy", c.Mysql.SlavePolicy, "round_robin", "least_conn")
+		v.Range("mysql.slave_check_time", c.Mysql.SlaveCheckTime, 1, 3600)
+		v.Range("mysql.slave_max_lag", c.Mysql.SlaveMaxLag, 1, 3600)
+	}
+	v.Required("mongo.host", c.Mongo.Host)
+	v.Required("mongo.database", c.Mongo.Database)
+	if c.Mongo.MaxPoolSize > 0 && c.Mongo.MinPoolSize > c.Mongo.MaxPoolSize {
+		v.Add("mongo.min_pool_size", "", conf.ErrOutOfRange)
+	}
+	v.Range("content.reconcile_interval", c.Content.ReconcileInterval, 0, 7*24*60)
+	v.Range("content.reconcile_batch", c.Content.ReconcileBatch, 1, 10000)
+	if c.Kafka.Addr != "" && c.Content.BehaviorTopic != "" {
+		v.Required("content.behavior_group", c.Content.BehaviorGroup)
+		v.Range("content.behavior_batch", c.Content.BehaviorBatch, 1, 5000)
+		v.Range("content.behavior_flush_interval", c.Content.BehaviorFlushInterval, 10, 60000)
+	}
+	v.Merge("app_log", c.AppLog.Validate())
+	v.Required("grpc.addr", c.Grpc.Addr)
+	v.Required("grpc.etcd_addr", c.Grpc.EtcdAddr)
+	v.Required("grpc.name", c.Grpc.Name)
+	v.Range("grpc.weight", c.Grpc.Weight, 1, 100)
+	v.Required("etcd.addrs", c.Etcd.Addrs)
+	v.Merge("jwt", c.Jwt.Validate())
+	// 用户服务签发令牌，未配置非对称密钥时需要两个 HS256 密钥；配置了 keys 但仍用 HS256 签发时需要 allow_hs256，否则无法校验自己签发的令牌
+	if c.Jwt.SigningKey == "" {
+		v.Required("jwt.access_secret", c.Jwt.AccessSecret)
+		v.Required("jwt.refresh_secret", c.Jwt.RefreshSecret)
+		if len(c.Jwt.Keys) > 0 && !c.Jwt.AllowHS256 {
+			v.Add("jwt.allow_hs256", "false", fmt.Errorf("%w, must be true while signing with HS256 and keys are configured", conf.ErrInvalidValue))
+		}
+	}
+	v.Range("jwt.access_expire", c.Jwt.AccessExpire, 1, c.Jwt.RefreshExpire)
+	v.Range("session.max_sessions", c.Session.MaxSessions, 0, 100)
+	return v.Err()
+}
+
+// SecretProvider 配置中 secret:// 引用的密钥来源
+func (c *Config) SecretProvider() (secrets.Provider, error) {
+	return secrets.New(c.Secrets)
+}
+
+var (
+	mu       sync.RWMutex
+	cfg      Config
+	loadOpts conf.Options
+)
+
+// InitConfig 加载配置：默认值 -> TOML文件 -> 环境变量 USER_SERVICE_{SECTION}_{KEY} -> 命令行参数 -{section}.{key}
 func InitConfig(confFileName string) error {
-	filePath := path.Join(env.GetEnvConfig().ConfDir, confFileName)
-	if _, err := toml.DecodeFile(filePath, &cfg); err != nil {
+	opts := conf.Options{
+		Service: envPrefix,
+		File:    path.Join(env.GetEnvConfig().ConfDir, confFileName),
+	}
+	var c Config
+	if err := conf.Load(&c, opts); err != nil {
 		return err
 	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	cfg, loadOpts = c, opts
 	return nil
 }
 
+// NewWatcher 创建动态配置，配置变化后 GetConfig 返回新配置，订阅的配置项实时生效
+func NewWatcher() *conf.Watcher[Config] {
+	mu.RLock()
+	w := conf.NewWatcher(loadOpts, cfg)
+	mu.RUnlock()
+
+	w.OnReload(func(c Config) {
+		mu.Lock()
+		defer mu.Unlock()
+		cfg = c
+	})
+	return w
+}
+
 func GetConfig() Config {
+	mu.RLock()
+	defer mu.RUnlock()
 	return cfg
 }
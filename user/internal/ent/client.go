@@ -11,11 +11,15 @@ import (
 
 	"user/internal/ent/migrate"
 
+	"user/internal/ent/major"
+	"user/internal/ent/school"
+	"user/internal/ent/schoolmajor"
 	"user/internal/ent/user"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 
 	stdsql "database/sql"
 )
@@ -25,6 +29,12 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Major is the client for interacting with the Major builders.
+	Major *MajorClient
+	// School is the client for interacting with the School builders.
+	School *SchoolClient
+	// SchoolMajor is the client for interacting with the SchoolMajor builders.
+	SchoolMajor *SchoolMajorClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -38,6 +48,9 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Major = NewMajorClient(c.config)
+	c.School = NewSchoolClient(c.config)
+	c.SchoolMajor = NewSchoolMajorClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -129,9 +142,12 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		User:   NewUserClient(cfg),
+		ctx:         ctx,
+		config:      cfg,
+		Major:       NewMajorClient(cfg),
+		School:      NewSchoolClient(cfg),
+		SchoolMajor: NewSchoolMajorClient(cfg),
+		User:        NewUserClient(cfg),
 	}, nil
 }
 
@@ -149,16 +165,19 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		User:   NewUserClient(cfg),
+		ctx:         ctx,
+		config:      cfg,
+		Major:       NewMajorClient(cfg),
+		School:      NewSchoolClient(cfg),
+		SchoolMajor: NewSchoolMajorClient(cfg),
+		User:        NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		User.
+//		Major.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -180,18 +199,30 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.Major.Use(hooks...)
+	c.School.Use(hooks...)
+	c.SchoolMajor.Use(hooks...)
 	c.User.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Major.Intercept(interceptors...)
+	c.School.Intercept(interceptors...)
+	c.SchoolMajor.Intercept(interceptors...)
 	c.User.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *MajorMutation:
+		return c.Major.mutate(ctx, m)
+	case *SchoolMutation:
+		return c.School.mutate(ctx, m)
+	case *SchoolMajorMutation:
+		return c.SchoolMajor.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -199,6 +230,533 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// MajorClient is a client for the Major schema.
+type MajorClient struct {
+	config
+}
+
+// NewMajorClient returns a client for the Major from the given config.
+func NewMajorClient(c config) *MajorClient {
+	return &MajorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `major.Hooks(f(g(h())))`.
+func (c *MajorClient) Use(hooks ...Hook) {
+	c.hooks.Major = append(c.hooks.Major, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `major.Intercept(f(g(h())))`.
+func (c *MajorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Major = append(c.inters.Major, interceptors...)
+}
+
+// Create returns a builder for creating a Major entity.
+func (c *MajorClient) Create() *MajorCreate {
+	mutation := newMajorMutation(c.config, OpCreate)
+	return &MajorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Major entities.
+func (c *MajorClient) CreateBulk(builders ...*MajorCreate) *MajorCreateBulk {
+	return &MajorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MajorClient) MapCreateBulk(slice any, setFunc func(*MajorCreate, int)) *MajorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MajorCreateBulk{err: fmt.Errorf("calling to MajorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MajorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MajorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Major.
+func (c *MajorClient) Update() *MajorUpdate {
+	mutation := newMajorMutation(c.config, OpUpdate)
+	return &MajorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MajorClient) UpdateOne(_m *Major) *MajorUpdateOne {
+	mutation := newMajorMutation(c.config, OpUpdateOne, withMajor(_m))
+	return &MajorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MajorClient) UpdateOneID(id uint32) *MajorUpdateOne {
+	mutation := newMajorMutation(c.config, OpUpdateOne, withMajorID(id))
+	return &MajorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Major.
+func (c *MajorClient) Delete() *MajorDelete {
+	mutation := newMajorMutation(c.config, OpDelete)
+	return &MajorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MajorClient) DeleteOne(_m *Major) *MajorDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MajorClient) DeleteOneID(id uint32) *MajorDeleteOne {
+	builder := c.Delete().Where(major.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MajorDeleteOne{builder}
+}
+
+// Query returns a query builder for Major.
+func (c *MajorClient) Query() *MajorQuery {
+	return &MajorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMajor},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Major entity by its id.
+func (c *MajorClient) Get(ctx context.Context, id uint32) (*Major, error) {
+	return c.Query().Where(major.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MajorClient) GetX(ctx context.Context, id uint32) *Major {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUsers queries the users edge of a Major.
+func (c *MajorClient) QueryUsers(_m *Major) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(major.Table, major.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, major.UsersTable, major.UsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySchools queries the schools edge of a Major.
+func (c *MajorClient) QuerySchools(_m *Major) *SchoolQuery {
+	query := (&SchoolClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(major.Table, major.FieldID, id),
+			sqlgraph.To(school.Table, school.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, major.SchoolsTable, major.SchoolsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySchoolMajors queries the school_majors edge of a Major.
+func (c *MajorClient) QuerySchoolMajors(_m *Major) *SchoolMajorQuery {
+	query := (&SchoolMajorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(major.Table, major.FieldID, id),
+			sqlgraph.To(schoolmajor.Table, schoolmajor.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, major.SchoolMajorsTable, major.SchoolMajorsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MajorClient) Hooks() []Hook {
+	return c.hooks.Major
+}
+
+// Interceptors returns the client interceptors.
+func (c *MajorClient) Interceptors() []Interceptor {
+	return c.inters.Major
+}
+
+func (c *MajorClient) mutate(ctx context.Context, m *MajorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MajorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MajorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MajorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MajorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Major mutation op: %q", m.Op())
+	}
+}
+
+// SchoolClient is a client for the School schema.
+type SchoolClient struct {
+	config
+}
+
+// NewSchoolClient returns a client for the School from the given config.
+func NewSchoolClient(c config) *SchoolClient {
+	return &SchoolClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `school.Hooks(f(g(h())))`.
+func (c *SchoolClient) Use(hooks ...Hook) {
+	c.hooks.School = append(c.hooks.School, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `school.Intercept(f(g(h())))`.
+func (c *SchoolClient) Intercept(interceptors ...Interceptor) {
+	c.inters.School = append(c.inters.School, interceptors...)
+}
+
+// Create returns a builder for creating a School entity.
+func (c *SchoolClient) Create() *SchoolCreate {
+	mutation := newSchoolMutation(c.config, OpCreate)
+	return &SchoolCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of School entities.
+func (c *SchoolClient) CreateBulk(builders ...*SchoolCreate) *SchoolCreateBulk {
+	return &SchoolCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SchoolClient) MapCreateBulk(slice any, setFunc func(*SchoolCreate, int)) *SchoolCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SchoolCreateBulk{err: fmt.Errorf("calling to SchoolClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SchoolCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SchoolCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for School.
+func (c *SchoolClient) Update() *SchoolUpdate {
+	mutation := newSchoolMutation(c.config, OpUpdate)
+	return &SchoolUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SchoolClient) UpdateOne(_m *School) *SchoolUpdateOne {
+	mutation := newSchoolMutation(c.config, OpUpdateOne, withSchool(_m))
+	return &SchoolUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SchoolClient) UpdateOneID(id uint32) *SchoolUpdateOne {
+	mutation := newSchoolMutation(c.config, OpUpdateOne, withSchoolID(id))
+	return &SchoolUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for School.
+func (c *SchoolClient) Delete() *SchoolDelete {
+	mutation := newSchoolMutation(c.config, OpDelete)
+	return &SchoolDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SchoolClient) DeleteOne(_m *School) *SchoolDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SchoolClient) DeleteOneID(id uint32) *SchoolDeleteOne {
+	builder := c.Delete().Where(school.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SchoolDeleteOne{builder}
+}
+
+// Query returns a query builder for School.
+func (c *SchoolClient) Query() *SchoolQuery {
+	return &SchoolQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSchool},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a School entity by its id.
+func (c *SchoolClient) Get(ctx context.Context, id uint32) (*School, error) {
+	return c.Query().Where(school.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SchoolClient) GetX(ctx context.Context, id uint32) *School {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUsers queries the users edge of a School.
+func (c *SchoolClient) QueryUsers(_m *School) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(school.Table, school.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, school.UsersTable, school.UsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMajors queries the majors edge of a School.
+func (c *SchoolClient) QueryMajors(_m *School) *MajorQuery {
+	query := (&MajorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(school.Table, school.FieldID, id),
+			sqlgraph.To(major.Table, major.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, school.MajorsTable, school.MajorsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySchoolMajors queries the school_majors edge of a School.
+func (c *SchoolClient) QuerySchoolMajors(_m *School) *SchoolMajorQuery {
+	query := (&SchoolMajorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(school.Table, school.FieldID, id),
+			sqlgraph.To(schoolmajor.Table, schoolmajor.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, school.SchoolMajorsTable, school.SchoolMajorsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SchoolClient) Hooks() []Hook {
+	return c.hooks.School
+}
+
+// Interceptors returns the client interceptors.
+func (c *SchoolClient) Interceptors() []Interceptor {
+	return c.inters.School
+}
+
+func (c *SchoolClient) mutate(ctx context.Context, m *SchoolMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SchoolCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SchoolUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SchoolUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SchoolDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown School mutation op: %q", m.Op())
+	}
+}
+
+// SchoolMajorClient is a client for the SchoolMajor schema.
+type SchoolMajorClient struct {
+	config
+}
+
+// NewSchoolMajorClient returns a client for the SchoolMajor from the given config.
+func NewSchoolMajorClient(c config) *SchoolMajorClient {
+	return &SchoolMajorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `schoolmajor.Hooks(f(g(h())))`.
+func (c *SchoolMajorClient) Use(hooks ...Hook) {
+	c.hooks.SchoolMajor = append(c.hooks.SchoolMajor, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `schoolmajor.Intercept(f(g(h())))`.
+func (c *SchoolMajorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SchoolMajor = append(c.inters.SchoolMajor, interceptors...)
+}
+
+// Create returns a builder for creating a SchoolMajor entity.
+func (c *SchoolMajorClient) Create() *SchoolMajorCreate {
+	mutation := newSchoolMajorMutation(c.config, OpCreate)
+	return &SchoolMajorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SchoolMajor entities.
+func (c *SchoolMajorClient) CreateBulk(builders ...*SchoolMajorCreate) *SchoolMajorCreateBulk {
+	return &SchoolMajorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SchoolMajorClient) MapCreateBulk(slice any, setFunc func(*SchoolMajorCreate, int)) *SchoolMajorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SchoolMajorCreateBulk{err: fmt.Errorf("calling to SchoolMajorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SchoolMajorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SchoolMajorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SchoolMajor.
+func (c *SchoolMajorClient) Update() *SchoolMajorUpdate {
+	mutation := newSchoolMajorMutation(c.config, OpUpdate)
+	return &SchoolMajorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SchoolMajorClient) UpdateOne(_m *SchoolMajor) *SchoolMajorUpdateOne {
+	mutation := newSchoolMajorMutation(c.config, OpUpdateOne, withSchoolMajor(_m))
+	return &SchoolMajorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SchoolMajorClient) UpdateOneID(id uint32) *SchoolMajorUpdateOne {
+	mutation := newSchoolMajorMutation(c.config, OpUpdateOne, withSchoolMajorID(id))
+	return &SchoolMajorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SchoolMajor.
+func (c *SchoolMajorClient) Delete() *SchoolMajorDelete {
+	mutation := newSchoolMajorMutation(c.config, OpDelete)
+	return &SchoolMajorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SchoolMajorClient) DeleteOne(_m *SchoolMajor) *SchoolMajorDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SchoolMajorClient) DeleteOneID(id uint32) *SchoolMajorDeleteOne {
+	builder := c.Delete().Where(schoolmajor.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SchoolMajorDeleteOne{builder}
+}
+
+// Query returns a query builder for SchoolMajor.
+func (c *SchoolMajorClient) Query() *SchoolMajorQuery {
+	return &SchoolMajorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSchoolMajor},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SchoolMajor entity by its id.
+func (c *SchoolMajorClient) Get(ctx context.Context, id uint32) (*SchoolMajor, error) {
+	return c.Query().Where(schoolmajor.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SchoolMajorClient) GetX(ctx context.Context, id uint32) *SchoolMajor {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySchool queries the school edge of a SchoolMajor.
+func (c *SchoolMajorClient) QuerySchool(_m *SchoolMajor) *SchoolQuery {
+	query := (&SchoolClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(schoolmajor.Table, schoolmajor.FieldID, id),
+			sqlgraph.To(school.Table, school.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, schoolmajor.SchoolTable, schoolmajor.SchoolColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMajor queries the major edge of a SchoolMajor.
+func (c *SchoolMajorClient) QueryMajor(_m *SchoolMajor) *MajorQuery {
+	query := (&MajorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(schoolmajor.Table, schoolmajor.FieldID, id),
+			sqlgraph.To(major.Table, major.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, schoolmajor.MajorTable, schoolmajor.MajorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SchoolMajorClient) Hooks() []Hook {
+	return c.hooks.SchoolMajor
+}
+
+// Interceptors returns the client interceptors.
+func (c *SchoolMajorClient) Interceptors() []Interceptor {
+	return c.inters.SchoolMajor
+}
+
+func (c *SchoolMajorClient) mutate(ctx context.Context, m *SchoolMajorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SchoolMajorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SchoolMajorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SchoolMajorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SchoolMajorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SchoolMajor mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -307,6 +865,38 @@ func (c *UserClient) GetX(ctx context.Context, id int64) *User {
 	return obj
 }
 
+// QuerySchool queries the school edge of a User.
+func (c *UserClient) QuerySchool(_m *User) *SchoolQuery {
+	query := (&SchoolClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(school.Table, school.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, user.SchoolTable, user.SchoolColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMajor queries the major edge of a User.
+func (c *UserClient) QueryMajor(_m *User) *MajorQuery {
+	query := (&MajorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(major.Table, major.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, user.MajorTable, user.MajorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -335,10 +925,10 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		User []ent.Hook
+		Major, School, SchoolMajor, User []ent.Hook
 	}
 	inters struct {
-		User []ent.Interceptor
+		Major, School, SchoolMajor, User []ent.Interceptor
 	}
 )
 
@@ -8,6 +8,9 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"user/internal/ent/major"
+	"user/internal/ent/school"
+	"user/internal/ent/schoolmajor"
 	"user/internal/ent/user"
 
 	"entgo.io/ent"
@@ -73,7 +76,10 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			user.Table: user.ValidColumn,
+			major.Table:       major.ValidColumn,
+			school.Table:      school.ValidColumn,
+			schoolmajor.Table: schoolmajor.ValidColumn,
+			user.Table:        user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
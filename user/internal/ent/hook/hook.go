@@ -8,6 +8,42 @@ import (
 	"user/internal/ent"
 )
 
+// The MajorFunc type is an adapter to allow the use of ordinary
+// function as Major mutator.
+type MajorFunc func(context.Context, *ent.MajorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MajorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MajorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MajorMutation", m)
+}
+
+// The SchoolFunc type is an adapter to allow the use of ordinary
+// function as School mutator.
+type SchoolFunc func(context.Context, *ent.SchoolMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SchoolFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SchoolMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SchoolMutation", m)
+}
+
+// The SchoolMajorFunc type is an adapter to allow the use of ordinary
+// function as SchoolMajor mutator.
+type SchoolMajorFunc func(context.Context, *ent.SchoolMajorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SchoolMajorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SchoolMajorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SchoolMajorMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
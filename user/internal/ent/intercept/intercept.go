@@ -7,7 +7,10 @@ import (
 	"fmt"
 
 	"user/internal/ent"
+	"user/internal/ent/major"
 	"user/internal/ent/predicate"
+	"user/internal/ent/school"
+	"user/internal/ent/schoolmajor"
 	"user/internal/ent/user"
 
 	"entgo.io/ent/dialect/sql"
@@ -69,6 +72,87 @@ func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
 	return f(ctx, query)
 }
 
+// The MajorFunc type is an adapter to allow the use of ordinary function as a Querier.
+type MajorFunc func(context.Context, *ent.MajorQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f MajorFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.MajorQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.MajorQuery", q)
+}
+
+// The TraverseMajor type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseMajor func(context.Context, *ent.MajorQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseMajor) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseMajor) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MajorQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.MajorQuery", q)
+}
+
+// The SchoolFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SchoolFunc func(context.Context, *ent.SchoolQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SchoolFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SchoolQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SchoolQuery", q)
+}
+
+// The TraverseSchool type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSchool func(context.Context, *ent.SchoolQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSchool) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSchool) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SchoolQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SchoolQuery", q)
+}
+
+// The SchoolMajorFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SchoolMajorFunc func(context.Context, *ent.SchoolMajorQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SchoolMajorFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SchoolMajorQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SchoolMajorQuery", q)
+}
+
+// The TraverseSchoolMajor type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSchoolMajor func(context.Context, *ent.SchoolMajorQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSchoolMajor) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSchoolMajor) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SchoolMajorQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SchoolMajorQuery", q)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary function as a Querier.
 type UserFunc func(context.Context, *ent.UserQuery) (ent.Value, error)
 
@@ -99,6 +183,12 @@ func (f TraverseUser) Traverse(ctx context.Context, q ent.Query) error {
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q ent.Query) (Query, error) {
 	switch q := q.(type) {
+	case *ent.MajorQuery:
+		return &query[*ent.MajorQuery, predicate.Major, major.OrderOption]{typ: ent.TypeMajor, tq: q}, nil
+	case *ent.SchoolQuery:
+		return &query[*ent.SchoolQuery, predicate.School, school.OrderOption]{typ: ent.TypeSchool, tq: q}, nil
+	case *ent.SchoolMajorQuery:
+		return &query[*ent.SchoolMajorQuery, predicate.SchoolMajor, schoolmajor.OrderOption]{typ: ent.TypeSchoolMajor, tq: q}, nil
 	case *ent.UserQuery:
 		return &query[*ent.UserQuery, predicate.User, user.OrderOption]{typ: ent.TypeUser, tq: q}, nil
 	default:
@@ -6,4 +6,4 @@
 // Package internal holds a loadable version of the latest schema.
 package internal
 
-const Schema = "{\"Schema\":\"user/internal/ent/schema\",\"Package\":\"user/internal/ent\",\"Schemas\":[{\"name\":\"User\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":13,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"id\\\"\",\"unique\":true,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"ID\"},{\"name\":\"nick_name\",\"type\":{\"Type\":13,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"nick_name\\\"\",\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"用户名称\"}],\"annotations\":{\"EntSQL\":{\"table\":\"User\"}}}],\"Features\":[\"intercept\",\"privacy\",\"schema/snapshot\",\"sql/lock\",\"sql/upsert\",\"sql/modifier\",\"sql/execquery\"]}"
+const Schema = "{\"Schema\":\"user/internal/ent/schema\",\"Package\":\"user/internal/ent\",\"Schemas\":[{\"name\":\"Major\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"users\",\"type\":\"User\"},{\"name\":\"schools\",\"type\":\"School\",\"ref_name\":\"majors\",\"through\":{\"N\":\"school_majors\",\"T\":\"SchoolMajor\"},\"inverse\":true}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"id\\\"\",\"unique\":true,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"专业ID\"},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"name\\\"\",\"size\":100,\"validators\":2,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"专业名称\"},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"description\\\"\",\"size\":255,\"optional\":true,\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"专业描述\"},{\"name\":\"created_at\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"created_at\\\"\",\"optional\":true,\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"创建时间（UNIX时间戳）\"},{\"name\":\"updated_at\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"updated_at\\\"\",\"optional\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"更新时间（UNIX时间戳）\"}],\"indexes\":[{\"unique\":true,\"fields\":[\"name\"],\"storage_key\":\"uk_name\"}],\"annotations\":{\"Comment\":{\"Text\":\"专业表\"},\"EntSQL\":{\"table\":\"major\",\"with_comments\":true}}},{\"name\":\"School\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"users\",\"type\":\"User\"},{\"name\":\"majors\",\"type\":\"Major\",\"through\":{\"N\":\"school_majors\",\"T\":\"SchoolMajor\"}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"id\\\"\",\"unique\":true,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"学校ID\"},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"name\\\"\",\"size\":100,\"validators\":2,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"学校全称\"},{\"name\":\"logo_url\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"logo_url\\\"\",\"size\":255,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"学校Logo图片URL\"},{\"name\":\"status\",\"type\":{\"Type\":9,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"status\\\"\",\"default\":true,\"default_value\":1,\"default_kind\":3,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"状态（1：启用，0：未启用）\"},{\"name\":\"created_at\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"created_at\\\"\",\"optional\":true,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"创建时间（UNIX时间戳）\"},{\"name\":\"updated_at\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"updated_at\\\"\",\"optional\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"更新时间（UNIX时间戳）\"}],\"indexes\":[{\"unique\":true,\"fields\":[\"name\"],\"storage_key\":\"uk_name\"}],\"annotations\":{\"Comment\":{\"Text\":\"学校表\"},\"EntSQL\":{\"table\":\"school\",\"with_comments\":true}}},{\"name\":\"SchoolMajor\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"school\",\"type\":\"School\",\"field\":\"school_id\",\"unique\":true,\"required\":true},{\"name\":\"major\",\"type\":\"Major\",\"field\":\"major_id\",\"unique\":true,\"required\":true}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"id\\\"\",\"unique\":true,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"关联ID\"},{\"name\":\"school_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"school_id\\\"\",\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"学校ID\"},{\"name\":\"major_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"major_id\\\"\",\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"专业ID\"},{\"name\":\"created_at\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"created_at\\\"\",\"optional\":true,\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"创建时间（UNIX时间戳）\"}],\"indexes\":[{\"unique\":true,\"fields\":[\"school_id\",\"major_id\"],\"storage_key\":\"uk_school_major\"},{\"fields\":[\"school_id\"],\"storage_key\":\"idx_school_id\"},{\"fields\":[\"major_id\"],\"storage_key\":\"idx_major_id\"}],\"annotations\":{\"Comment\":{\"Text\":\"学校-专业关联表\"},\"EntSQL\":{\"table\":\"school_major\",\"with_comments\":true}}},{\"name\":\"User\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"school\",\"type\":\"School\",\"field\":\"school_id\",\"ref_name\":\"users\",\"unique\":true,\"inverse\":true},{\"name\":\"major\",\"type\":\"Major\",\"field\":\"major_id\",\"ref_name\":\"users\",\"unique\":true,\"inverse\":true}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":13,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"id\\\"\",\"unique\":true,\"immutable\":true,\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntSQL\":{\"incremental\":false}},\"comment\":\"用户唯一ID（雪花算法生成）\"},{\"name\":\"nickname\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"nickname\\\"\",\"size\":50,\"optional\":true,\"validators\":1,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"昵称\"},{\"name\":\"phone\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"phone\\\"\",\"size\":20,\"optional\":true,\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"手机号\"},{\"name\":\"password\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":100,\"optional\":true,\"validators\":1,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"comment\":\"加密存储的密码（bcrypt算法）\"},{\"name\":\"school_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"school_id\\\"\",\"optional\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"所属学校\"},{\"name\":\"major_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"major_id\\\"\",\"optional\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"所属专业ID\"},{\"name\":\"admission_grade\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"admission_grade\\\"\",\"optional\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"入学年级（如2021、2022）\"},{\"name\":\"avatar_url\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"avatar_url\\\"\",\"size\":255,\"optional\":true,\"default\":true,\"default_value\":\"default_avatar.png\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"头像URL\"},{\"name\":\"experience\",\"type\":{\"Type\":11,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"experience\\\"\",\"default\":true,\"default_value\":0,\"default_kind\":5,\"position\":{\"Index\":8,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"经验值\"},{\"name\":\"status\",\"type\":{\"Type\":9,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"status\\\"\",\"default\":true,\"default_value\":1,\"default_kind\":3,\"position\":{\"Index\":9,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"账号状态（1-正常，0-封禁，2-注销）\"},{\"name\":\"logoff_time\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"logoff_time\\\"\",\"optional\":true,\"default\":true,\"default_value\":0,\"default_kind\":10,\"position\":{\"Index\":10,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"注销时间\"},{\"name\":\"banned_time\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"banned_time\\\"\",\"optional\":true,\"default\":true,\"default_value\":0,\"default_kind\":10,\"position\":{\"Index\":11,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"封禁时间\"},{\"name\":\"unbanned_time\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"unbanned_time\\\"\",\"optional\":true,\"default\":true,\"default_value\":0,\"default_kind\":10,\"position\":{\"Index\":12,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"解封时间\"},{\"name\":\"created_at\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"created_at\\\"\",\"optional\":true,\"immutable\":true,\"position\":{\"Index\":13,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"注册时间（UNIX时间戳）\"},{\"name\":\"updated_at\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"tag\":\"json:\\\"updated_at\\\"\",\"optional\":true,\"position\":{\"Index\":14,\"MixedIn\":false,\"MixinIndex\":0},\"comment\":\"更新时间（UNIX时间戳）\"}],\"indexes\":[{\"unique\":true,\"fields\":[\"phone\",\"status\",\"logoff_time\"],\"storage_key\":\"uk_phone_status_logoff_time\"},{\"fields\":[\"school_id\"],\"storage_key\":\"idx_school_id\"}],\"annotations\":{\"Comment\":{\"Text\":\"用户表\"},\"EntSQL\":{\"table\":\"user\",\"with_comments\":true}}}],\"Features\":[\"intercept\",\"privacy\",\"schema/snapshot\",\"sql/lock\",\"sql/upsert\",\"sql/modifier\",\"sql/execquery\"]}"
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"user/internal/ent/major"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 专业表
+type Major struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 专业ID
+	ID uint32 `json:"id"`
+	// 专业名称
+	Name string `json:"name"`
+	// 专业描述
+	Description string `json:"description"`
+	// 创建时间（UNIX时间戳）
+	CreatedAt uint32 `json:"created_at"`
+	// 更新时间（UNIX时间戳）
+	UpdatedAt uint32 `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MajorQuery when eager-loading is set.
+	Edges        MajorEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MajorEdges holds the relations/edges for other nodes in the graph.
+type MajorEdges struct {
+	// Users holds the value of the users edge.
+	Users []*User `json:"users,omitempty"`
+	// Schools holds the value of the schools edge.
+	Schools []*School `json:"schools,omitempty"`
+	// SchoolMajors holds the value of the school_majors edge.
+	SchoolMajors []*SchoolMajor `json:"school_majors,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UsersOrErr returns the Users value or an error if the edge
+// was not loaded in eager-loading.
+func (e MajorEdges) UsersOrErr() ([]*User, error) {
+	if e.loadedTypes[0] {
+		return e.Users, nil
+	}
+	return nil, &NotLoadedError{edge: "users"}
+}
+
+// SchoolsOrErr returns the Schools value or an error if the edge
+// was not loaded in eager-loading.
+func (e MajorEdges) SchoolsOrErr() ([]*School, error) {
+	if e.loadedTypes[1] {
+		return e.Schools, nil
+	}
+	return nil, &NotLoadedError{edge: "schools"}
+}
+
+// SchoolMajorsOrErr returns the SchoolMajors value or an error if the edge
+// was not loaded in eager-loading.
+func (e MajorEdges) SchoolMajorsOrErr() ([]*SchoolMajor, error) {
+	if e.loadedTypes[2] {
+		return e.SchoolMajors, nil
+	}
+	return nil, &NotLoadedError{edge: "school_majors"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Major) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case major.FieldID, major.FieldCreatedAt, major.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case major.FieldName, major.FieldDescription:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Major fields.
+func (_m *Major) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case major.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case major.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case major.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case major.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = uint32(value.Int64)
+			}
+		case major.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = uint32(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Major.
+// This includes values selected through modifiers, order, etc.
+func (_m *Major) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUsers queries the "users" edge of the Major entity.
+func (_m *Major) QueryUsers() *UserQuery {
+	return NewMajorClient(_m.config).QueryUsers(_m)
+}
+
+// QuerySchools queries the "schools" edge of the Major entity.
+func (_m *Major) QuerySchools() *SchoolQuery {
+	return NewMajorClient(_m.config).QuerySchools(_m)
+}
+
+// QuerySchoolMajors queries the "school_majors" edge of the Major entity.
+func (_m *Major) QuerySchoolMajors() *SchoolMajorQuery {
+	return NewMajorClient(_m.config).QuerySchoolMajors(_m)
+}
+
+// Update returns a builder for updating this Major.
+// Note that you need to call Major.Unwrap() before calling this method if this Major
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Major) Update() *MajorUpdateOne {
+	return NewMajorClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Major entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Major) Unwrap() *Major {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Major is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Major) String() string {
+	var builder strings.Builder
+	builder.WriteString("Major(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Majors is a parsable slice of Major.
+type Majors []*Major
@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package major
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the major type in the database.
+	Label = "major"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUsers holds the string denoting the users edge name in mutations.
+	EdgeUsers = "users"
+	// EdgeSchools holds the string denoting the schools edge name in mutations.
+	EdgeSchools = "schools"
+	// EdgeSchoolMajors holds the string denoting the school_majors edge name in mutations.
+	EdgeSchoolMajors = "school_majors"
+	// Table holds the table name of the major in the database.
+	Table = "major"
+	// UsersTable is the table that holds the users relation/edge.
+	UsersTable = "user"
+	// UsersInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UsersInverseTable = "user"
+	// UsersColumn is the table column denoting the users relation/edge.
+	UsersColumn = "major_id"
+	// SchoolsTable is the table that holds the schools relation/edge. The primary key declared below.
+	SchoolsTable = "school_major"
+	// SchoolsInverseTable is the table name for the School entity.
+	// It exists in this package in order to avoid circular dependency with the "school" package.
+	SchoolsInverseTable = "school"
+	// SchoolMajorsTable is the table that holds the school_majors relation/edge.
+	SchoolMajorsTable = "school_major"
+	// SchoolMajorsInverseTable is the table name for the SchoolMajor entity.
+	// It exists in this package in order to avoid circular dependency with the "schoolmajor" package.
+	SchoolMajorsInverseTable = "school_major"
+	// SchoolMajorsColumn is the table column denoting the school_majors relation/edge.
+	SchoolMajorsColumn = "major_id"
+)
+
+// Columns holds all SQL columns for major fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldDescription,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+var (
+	// SchoolsPrimaryKey and SchoolsColumn2 are the table columns denoting the
+	// primary key for the schools relation (M2M).
+	SchoolsPrimaryKey = []string{"school_id", "major_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Major queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUsersCount orders the results by users count.
+func ByUsersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUsersStep(), opts...)
+	}
+}
+
+// ByUsers orders the results by users terms.
+func ByUsers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUsersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySchoolsCount orders the results by schools count.
+func BySchoolsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSchoolsStep(), opts...)
+	}
+}
+
+// BySchools orders the results by schools terms.
+func BySchools(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSchoolsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySchoolMajorsCount orders the results by school_majors count.
+func BySchoolMajorsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSchoolMajorsStep(), opts...)
+	}
+}
+
+// BySchoolMajors orders the results by school_majors terms.
+func BySchoolMajors(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSchoolMajorsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newUsersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UsersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UsersTable, UsersColumn),
+	)
+}
+func newSchoolsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SchoolsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, SchoolsTable, SchoolsPrimaryKey...),
+	)
+}
+func newSchoolMajorsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SchoolMajorsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SchoolMajorsTable, SchoolMajorsColumn),
+	)
+}
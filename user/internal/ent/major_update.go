@@ -0,0 +1,880 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"user/internal/ent/major"
+	"user/internal/ent/predicate"
+	"user/internal/ent/school"
+	"user/internal/ent/schoolmajor"
+	"user/internal/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MajorUpdate is the builder for updating Major entities.
+type MajorUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MajorMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MajorUpdate builder.
+func (_u *MajorUpdate) Where(ps ...predicate.Major) *MajorUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MajorUpdate) SetName(v string) *MajorUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MajorUpdate) SetNillableName(v *string) *MajorUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *MajorUpdate) SetDescription(v string) *MajorUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *MajorUpdate) SetNillableDescription(v *string) *MajorUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *MajorUpdate) ClearDescription() *MajorUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MajorUpdate) SetUpdatedAt(v uint32) *MajorUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *MajorUpdate) SetNillableUpdatedAt(v *uint32) *MajorUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *MajorUpdate) AddUpdatedAt(v int32) *MajorUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *MajorUpdate) ClearUpdatedAt() *MajorUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (_u *MajorUpdate) AddUserIDs(ids ...int64) *MajorUpdate {
+	_u.mutation.AddUserIDs(ids...)
+	return _u
+}
+
+// AddUsers adds the "users" edges to the User entity.
+func (_u *MajorUpdate) AddUsers(v ...*User) *MajorUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUserIDs(ids...)
+}
+
+// AddSchoolIDs adds the "schools" edge to the School entity by IDs.
+func (_u *MajorUpdate) AddSchoolIDs(ids ...uint32) *MajorUpdate {
+	_u.mutation.AddSchoolIDs(ids...)
+	return _u
+}
+
+// AddSchools adds the "schools" edges to the School entity.
+func (_u *MajorUpdate) AddSchools(v ...*School) *MajorUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSchoolIDs(ids...)
+}
+
+// AddSchoolMajorIDs adds the "school_majors" edge to the SchoolMajor entity by IDs.
+func (_u *MajorUpdate) AddSchoolMajorIDs(ids ...uint32) *MajorUpdate {
+	_u.mutation.AddSchoolMajorIDs(ids...)
+	return _u
+}
+
+// AddSchoolMajors adds the "school_majors" edges to the SchoolMajor entity.
+func (_u *MajorUpdate) AddSchoolMajors(v ...*SchoolMajor) *MajorUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSchoolMajorIDs(ids...)
+}
+
+// Mutation returns the MajorMutation object of the builder.
+func (_u *MajorUpdate) Mutation() *MajorMutation {
+	return _u.mutation
+}
+
+// ClearUsers clears all "users" edges to the User entity.
+func (_u *MajorUpdate) ClearUsers() *MajorUpdate {
+	_u.mutation.ClearUsers()
+	return _u
+}
+
+// RemoveUserIDs removes the "users" edge to User entities by IDs.
+func (_u *MajorUpdate) RemoveUserIDs(ids ...int64) *MajorUpdate {
+	_u.mutation.RemoveUserIDs(ids...)
+	return _u
+}
+
+// RemoveUsers removes "users" edges to User entities.
+func (_u *MajorUpdate) RemoveUsers(v ...*User) *MajorUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserIDs(ids...)
+}
+
+// ClearSchools clears all "schools" edges to the School entity.
+func (_u *MajorUpdate) ClearSchools() *MajorUpdate {
+	_u.mutation.ClearSchools()
+	return _u
+}
+
+// RemoveSchoolIDs removes the "schools" edge to School entities by IDs.
+func (_u *MajorUpdate) RemoveSchoolIDs(ids ...uint32) *MajorUpdate {
+	_u.mutation.RemoveSchoolIDs(ids...)
+	return _u
+}
+
+// RemoveSchools removes "schools" edges to School entities.
+func (_u *MajorUpdate) RemoveSchools(v ...*School) *MajorUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSchoolIDs(ids...)
+}
+
+// ClearSchoolMajors clears all "school_majors" edges to the SchoolMajor entity.
+func (_u *MajorUpdate) ClearSchoolMajors() *MajorUpdate {
+	_u.mutation.ClearSchoolMajors()
+	return _u
+}
+
+// RemoveSchoolMajorIDs removes the "school_majors" edge to SchoolMajor entities by IDs.
+func (_u *MajorUpdate) RemoveSchoolMajorIDs(ids ...uint32) *MajorUpdate {
+	_u.mutation.RemoveSchoolMajorIDs(ids...)
+	return _u
+}
+
+// RemoveSchoolMajors removes "school_majors" edges to SchoolMajor entities.
+func (_u *MajorUpdate) RemoveSchoolMajors(v ...*SchoolMajor) *MajorUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSchoolMajorIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MajorUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MajorUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MajorUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MajorUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MajorUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := major.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Major.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := major.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Major.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MajorUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MajorUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MajorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(major.Table, major.Columns, sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(major.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(major.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(major.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(major.FieldCreatedAt, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(major.FieldUpdatedAt, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(major.FieldUpdatedAt, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(major.FieldUpdatedAt, field.TypeUint32)
+	}
+	if _u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   major.UsersTable,
+			Columns: []string{major.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsersIDs(); len(nodes) > 0 && !_u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   major.UsersTable,
+			Columns: []string{major.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   major.UsersTable,
+			Columns: []string{major.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SchoolsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   major.SchoolsTable,
+			Columns: major.SchoolsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSchoolsIDs(); len(nodes) > 0 && !_u.mutation.SchoolsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   major.SchoolsTable,
+			Columns: major.SchoolsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SchoolsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   major.SchoolsTable,
+			Columns: major.SchoolsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SchoolMajorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   major.SchoolMajorsTable,
+			Columns: []string{major.SchoolMajorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSchoolMajorsIDs(); len(nodes) > 0 && !_u.mutation.SchoolMajorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   major.SchoolMajorsTable,
+			Columns: []string{major.SchoolMajorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SchoolMajorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   major.SchoolMajorsTable,
+			Columns: []string{major.SchoolMajorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{major.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MajorUpdateOne is the builder for updating a single Major entity.
+type MajorUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MajorMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *MajorUpdateOne) SetName(v string) *MajorUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MajorUpdateOne) SetNillableName(v *string) *MajorUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *MajorUpdateOne) SetDescription(v string) *MajorUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *MajorUpdateOne) SetNillableDescription(v *string) *MajorUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *MajorUpdateOne) ClearDescription() *MajorUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MajorUpdateOne) SetUpdatedAt(v uint32) *MajorUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *MajorUpdateOne) SetNillableUpdatedAt(v *uint32) *MajorUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *MajorUpdateOne) AddUpdatedAt(v int32) *MajorUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *MajorUpdateOne) ClearUpdatedAt() *MajorUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (_u *MajorUpdateOne) AddUserIDs(ids ...int64) *MajorUpdateOne {
+	_u.mutation.AddUserIDs(ids...)
+	return _u
+}
+
+// AddUsers adds the "users" edges to the User entity.
+func (_u *MajorUpdateOne) AddUsers(v ...*User) *MajorUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUserIDs(ids...)
+}
+
+// AddSchoolIDs adds the "schools" edge to the School entity by IDs.
+func (_u *MajorUpdateOne) AddSchoolIDs(ids ...uint32) *MajorUpdateOne {
+	_u.mutation.AddSchoolIDs(ids...)
+	return _u
+}
+
+// AddSchools adds the "schools" edges to the School entity.
+func (_u *MajorUpdateOne) AddSchools(v ...*School) *MajorUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSchoolIDs(ids...)
+}
+
+// AddSchoolMajorIDs adds the "school_majors" edge to the SchoolMajor entity by IDs.
+func (_u *MajorUpdateOne) AddSchoolMajorIDs(ids ...uint32) *MajorUpdateOne {
+	_u.mutation.AddSchoolMajorIDs(ids...)
+	return _u
+}
+
+// AddSchoolMajors adds the "school_majors" edges to the SchoolMajor entity.
+func (_u *MajorUpdateOne) AddSchoolMajors(v ...*SchoolMajor) *MajorUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSchoolMajorIDs(ids...)
+}
+
+// Mutation returns the MajorMutation object of the builder.
+func (_u *MajorUpdateOne) Mutation() *MajorMutation {
+	return _u.mutation
+}
+
+// ClearUsers clears all "users" edges to the User entity.
+func (_u *MajorUpdateOne) ClearUsers() *MajorUpdateOne {
+	_u.mutation.ClearUsers()
+	return _u
+}
+
+// RemoveUserIDs removes the "users" edge to User entities by IDs.
+func (_u *MajorUpdateOne) RemoveUserIDs(ids ...int64) *MajorUpdateOne {
+	_u.mutation.RemoveUserIDs(ids...)
+	return _u
+}
+
+// RemoveUsers removes "users" edges to User entities.
+func (_u *MajorUpdateOne) RemoveUsers(v ...*User) *MajorUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserIDs(ids...)
+}
+
+// ClearSchools clears all "schools" edges to the School entity.
+func (_u *MajorUpdateOne) ClearSchools() *MajorUpdateOne {
+	_u.mutation.ClearSchools()
+	return _u
+}
+
+// RemoveSchoolIDs removes the "schools" edge to School entities by IDs.
+func (_u *MajorUpdateOne) RemoveSchoolIDs(ids ...uint32) *MajorUpdateOne {
+	_u.mutation.RemoveSchoolIDs(ids...)
+	return _u
+}
+
+// RemoveSchools removes "schools" edges to School entities.
+func (_u *MajorUpdateOne) RemoveSchools(v ...*School) *MajorUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSchoolIDs(ids...)
+}
+
+// ClearSchoolMajors clears all "school_majors" edges to the SchoolMajor entity.
+func (_u *MajorUpdateOne) ClearSchoolMajors() *MajorUpdateOne {
+	_u.mutation.ClearSchoolMajors()
+	return _u
+}
+
+// RemoveSchoolMajorIDs removes the "school_majors" edge to SchoolMajor entities by IDs.
+func (_u *MajorUpdateOne) RemoveSchoolMajorIDs(ids ...uint32) *MajorUpdateOne {
+	_u.mutation.RemoveSchoolMajorIDs(ids...)
+	return _u
+}
+
+// RemoveSchoolMajors removes "school_majors" edges to SchoolMajor entities.
+func (_u *MajorUpdateOne) RemoveSchoolMajors(v ...*SchoolMajor) *MajorUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSchoolMajorIDs(ids...)
+}
+
+// Where appends a list predicates to the MajorUpdate builder.
+func (_u *MajorUpdateOne) Where(ps ...predicate.Major) *MajorUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MajorUpdateOne) Select(field string, fields ...string) *MajorUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Major entity.
+func (_u *MajorUpdateOne) Save(ctx context.Context) (*Major, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MajorUpdateOne) SaveX(ctx context.Context) *Major {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MajorUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MajorUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MajorUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := major.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Major.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := major.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Major.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MajorUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MajorUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MajorUpdateOne) sqlSave(ctx context.Context) (_node *Major, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(major.Table, major.Columns, sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Major.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, major.FieldID)
+		for _, f := range fields {
+			if !major.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != major.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(major.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(major.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(major.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(major.FieldCreatedAt, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(major.FieldUpdatedAt, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(major.FieldUpdatedAt, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(major.FieldUpdatedAt, field.TypeUint32)
+	}
+	if _u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   major.UsersTable,
+			Columns: []string{major.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsersIDs(); len(nodes) > 0 && !_u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   major.UsersTable,
+			Columns: []string{major.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   major.UsersTable,
+			Columns: []string{major.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SchoolsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   major.SchoolsTable,
+			Columns: major.SchoolsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSchoolsIDs(); len(nodes) > 0 && !_u.mutation.SchoolsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   major.SchoolsTable,
+			Columns: major.SchoolsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SchoolsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   major.SchoolsTable,
+			Columns: major.SchoolsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SchoolMajorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   major.SchoolMajorsTable,
+			Columns: []string{major.SchoolMajorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSchoolMajorsIDs(); len(nodes) > 0 && !_u.mutation.SchoolMajorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   major.SchoolMajorsTable,
+			Columns: []string{major.SchoolMajorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SchoolMajorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   major.SchoolMajorsTable,
+			Columns: []string{major.SchoolMajorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Major{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{major.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,25 +9,171 @@ import (
 )
 
 var (
-	// UserColumns holds the columns for the "User" table.
+	// MajorColumns holds the columns for the "major" table.
+	MajorColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "专业ID"},
+		{Name: "name", Type: field.TypeString, Size: 100, Comment: "专业名称"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255, Comment: "专业描述"},
+		{Name: "created_at", Type: field.TypeUint32, Nullable: true, Comment: "创建时间（UNIX时间戳）"},
+		{Name: "updated_at", Type: field.TypeUint32, Nullable: true, Comment: "更新时间（UNIX时间戳）"},
+	}
+	// MajorTable holds the schema information for the "major" table.
+	MajorTable = &schema.Table{
+		Name:       "major",
+		Comment:    "专业表",
+		Columns:    MajorColumns,
+		PrimaryKey: []*schema.Column{MajorColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uk_name",
+				Unique:  true,
+				Columns: []*schema.Column{MajorColumns[1]},
+			},
+		},
+	}
+	// SchoolColumns holds the columns for the "school" table.
+	SchoolColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "学校ID"},
+		{Name: "name", Type: field.TypeString, Size: 100, Comment: "学校全称"},
+		{Name: "logo_url", Type: field.TypeString, Size: 255, Comment: "学校Logo图片URL", Default: ""},
+		{Name: "status", Type: field.TypeInt8, Comment: "状态（1：启用，0：未启用）", Default: 1},
+		{Name: "created_at", Type: field.TypeUint32, Nullable: true, Comment: "创建时间（UNIX时间戳）"},
+		{Name: "updated_at", Type: field.TypeUint32, Nullable: true, Comment: "更新时间（UNIX时间戳）"},
+	}
+	// SchoolTable holds the schema information for the "school" table.
+	SchoolTable = &schema.Table{
+		Name:       "school",
+		Comment:    "学校表",
+		Columns:    SchoolColumns,
+		PrimaryKey: []*schema.Column{SchoolColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uk_name",
+				Unique:  true,
+				Columns: []*schema.Column{SchoolColumns[1]},
+			},
+		},
+	}
+	// SchoolMajorColumns holds the columns for the "school_major" table.
+	SchoolMajorColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "关联ID"},
+		{Name: "created_at", Type: field.TypeUint32, Nullable: true, Comment: "创建时间（UNIX时间戳）"},
+		{Name: "school_id", Type: field.TypeUint32, Comment: "学校ID"},
+		{Name: "major_id", Type: field.TypeUint32, Comment: "专业ID"},
+	}
+	// SchoolMajorTable holds the schema information for the "school_major" table.
+	SchoolMajorTable = &schema.Table{
+		Name:       "school_major",
+		Comment:    "学校-专业关联表",
+		Columns:    SchoolMajorColumns,
+		PrimaryKey: []*schema.Column{SchoolMajorColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "school_major_school_school",
+				Columns:    []*schema.Column{SchoolMajorColumns[2]},
+				RefColumns: []*schema.Column{SchoolColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "school_major_major_major",
+				Columns:    []*schema.Column{SchoolMajorColumns[3]},
+				RefColumns: []*schema.Column{MajorColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uk_school_major",
+				Unique:  true,
+				Columns: []*schema.Column{SchoolMajorColumns[2], SchoolMajorColumns[3]},
+			},
+			{
+				Name:    "idx_school_id",
+				Unique:  false,
+				Columns: []*schema.Column{SchoolMajorColumns[2]},
+			},
+			{
+				Name:    "idx_major_id",
+				Unique:  false,
+				Columns: []*schema.Column{SchoolMajorColumns[3]},
+			},
+		},
+	}
+	// UserColumns holds the columns for the "user" table.
 	UserColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeInt64, Increment: true},
-		{Name: "nick_name", Type: field.TypeInt64},
+		{Name: "id", Type: field.TypeInt64, Comment: "用户唯一ID（雪花算法生成）"},
+		{Name: "nickname", Type: field.TypeString, Nullable: true, Size: 50, Comment: "昵称"},
+		{Name: "phone", Type: field.TypeString, Nullable: true, Size: 20, Comment: "手机号"},
+		{Name: "password", Type: field.TypeString, Nullable: true, Size: 100, Comment: "加密存储的密码（bcrypt算法）"},
+		{Name: "admission_grade", Type: field.TypeInt32, Nullable: true, Comment: "入学年级（如2021、2022）"},
+		{Name: "avatar_url", Type: field.TypeString, Nullable: true, Size: 255, Comment: "头像URL", Default: "default_avatar.png"},
+		{Name: "experience", Type: field.TypeInt32, Comment: "经验值", Default: 0},
+		{Name: "status", Type: field.TypeInt8, Comment: "账号状态（1-正常，0-封禁，2-注销）", Default: 1},
+		{Name: "logoff_time", Type: field.TypeUint32, Nullable: true, Comment: "注销时间", Default: 0},
+		{Name: "banned_time", Type: field.TypeUint32, Nullable: true, Comment: "封禁时间", Default: 0},
+		{Name: "unbanned_time", Type: field.TypeUint32, Nullable: true, Comment: "解封时间", Default: 0},
+		{Name: "created_at", Type: field.TypeUint32, Nullable: true, Comment: "注册时间（UNIX时间戳）"},
+		{Name: "updated_at", Type: field.TypeUint32, Nullable: true, Comment: "更新时间（UNIX时间戳）"},
+		{Name: "major_id", Type: field.TypeUint32, Nullable: true, Comment: "所属专业ID"},
+		{Name: "school_id", Type: field.TypeUint32, Nullable: true, Comment: "所属学校"},
 	}
-	// UserTable holds the schema information for the "User" table.
+	// UserTable holds the schema information for the "user" table.
 	UserTable = &schema.Table{
-		Name:       "User",
+		Name:       "user",
+		Comment:    "用户表",
 		Columns:    UserColumns,
 		PrimaryKey: []*schema.Column{UserColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_major_users",
+				Columns:    []*schema.Column{UserColumns[13]},
+				RefColumns: []*schema.Column{MajorColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "user_school_users",
+				Columns:    []*schema.Column{UserColumns[14]},
+				RefColumns: []*schema.Column{SchoolColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uk_phone_status_logoff_time",
+				Unique:  true,
+				Columns: []*schema.Column{UserColumns[2], UserColumns[7], UserColumns[8]},
+			},
+			{
+				Name:    "idx_school_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserColumns[14]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		MajorTable,
+		SchoolTable,
+		SchoolMajorTable,
 		UserTable,
 	}
 )
 
 func init() {
+	MajorTable.Annotation = &entsql.Annotation{
+		Table: "major",
+	}
+	SchoolTable.Annotation = &entsql.Annotation{
+		Table: "school",
+	}
+	SchoolMajorTable.ForeignKeys[0].RefTable = SchoolTable
+	SchoolMajorTable.ForeignKeys[1].RefTable = MajorTable
+	SchoolMajorTable.Annotation = &entsql.Annotation{
+		Table: "school_major",
+	}
+	UserTable.ForeignKeys[0].RefTable = MajorTable
+	UserTable.ForeignKeys[1].RefTable = SchoolTable
 	UserTable.Annotation = &entsql.Annotation{
-		Table: "User",
+		Table: "user",
 	}
 }
@@ -7,7 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"user/internal/ent/major"
 	"user/internal/ent/predicate"
+	"user/internal/ent/school"
+	"user/internal/ent/schoolmajor"
 	"user/internal/ent/user"
 
 	"entgo.io/ent"
@@ -23,34 +26,50 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeUser = "User"
+	TypeMajor       = "Major"
+	TypeSchool      = "School"
+	TypeSchoolMajor = "SchoolMajor"
+	TypeUser        = "User"
 )
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// MajorMutation represents an operation that mutates the Major nodes in the graph.
+type MajorMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int64
-	nick_name     *int64
-	addnick_name  *int64
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
+	op                   Op
+	typ                  string
+	id                   *uint32
+	name                 *string
+	description          *string
+	created_at           *uint32
+	addcreated_at        *int32
+	updated_at           *uint32
+	addupdated_at        *int32
+	clearedFields        map[string]struct{}
+	users                map[int64]struct{}
+	removedusers         map[int64]struct{}
+	clearedusers         bool
+	schools              map[uint32]struct{}
+	removedschools       map[uint32]struct{}
+	clearedschools       bool
+	school_majors        map[uint32]struct{}
+	removedschool_majors map[uint32]struct{}
+	clearedschool_majors bool
+	done                 bool
+	oldValue             func(context.Context) (*Major, error)
+	predicates           []predicate.Major
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*MajorMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// majorOption allows management of the mutation configuration using functional options.
+type majorOption func(*MajorMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newMajorMutation creates new mutation for the Major entity.
+func newMajorMutation(c config, op Op, opts ...majorOption) *MajorMutation {
+	m := &MajorMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeMajor,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -59,20 +78,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int64) userOption {
-	return func(m *UserMutation) {
+// withMajorID sets the ID field of the mutation.
+func withMajorID(id uint32) majorOption {
+	return func(m *MajorMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *Major
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*Major, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().Major.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -81,10 +100,10 @@ func withUserID(id int64) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withMajor sets the old Major of the mutation.
+func withMajor(node *Major) majorOption {
+	return func(m *MajorMutation) {
+		m.oldValue = func(context.Context) (*Major, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -93,7 +112,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m MajorMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -101,7 +120,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m MajorMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -111,14 +130,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id int64) {
+// operation is only accepted on creation of Major entities.
+func (m *MajorMutation) SetID(id uint32) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int64, exists bool) {
+func (m *MajorMutation) ID() (id uint32, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -129,86 +148,417 @@ func (m *UserMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *MajorMutation) IDs(ctx context.Context) ([]uint32, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int64{id}, nil
+			return []uint32{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Major.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetNickName sets the "nick_name" field.
-func (m *UserMutation) SetNickName(i int64) {
-	m.nick_name = &i
-	m.addnick_name = nil
+// SetName sets the "name" field.
+func (m *MajorMutation) SetName(s string) {
+	m.name = &s
 }
 
-// NickName returns the value of the "nick_name" field in the mutation.
-func (m *UserMutation) NickName() (r int64, exists bool) {
-	v := m.nick_name
+// Name returns the value of the "name" field in the mutation.
+func (m *MajorMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNickName returns the old "nick_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Major entity.
+// If the Major object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MajorMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MajorMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *MajorMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *MajorMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Major entity.
+// If the Major object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MajorMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *MajorMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[major.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *MajorMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[major.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *MajorMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, major.FieldDescription)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MajorMutation) SetCreatedAt(u uint32) {
+	m.created_at = &u
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MajorMutation) CreatedAt() (r uint32, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Major entity.
+// If the Major object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldNickName(ctx context.Context) (v int64, err error) {
+func (m *MajorMutation) OldCreatedAt(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNickName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNickName requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNickName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.NickName, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddNickName adds i to the "nick_name" field.
-func (m *UserMutation) AddNickName(i int64) {
-	if m.addnick_name != nil {
-		*m.addnick_name += i
+// AddCreatedAt adds u to the "created_at" field.
+func (m *MajorMutation) AddCreatedAt(u int32) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += u
 	} else {
-		m.addnick_name = &i
+		m.addcreated_at = &u
 	}
 }
 
-// AddedNickName returns the value that was added to the "nick_name" field in this mutation.
-func (m *UserMutation) AddedNickName() (r int64, exists bool) {
-	v := m.addnick_name
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *MajorMutation) AddedCreatedAt() (r int32, exists bool) {
+	v := m.addcreated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetNickName resets all changes to the "nick_name" field.
-func (m *UserMutation) ResetNickName() {
-	m.nick_name = nil
-	m.addnick_name = nil
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *MajorMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+	m.clearedFields[major.FieldCreatedAt] = struct{}{}
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *MajorMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[major.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MajorMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+	delete(m.clearedFields, major.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MajorMutation) SetUpdatedAt(u uint32) {
+	m.updated_at = &u
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MajorMutation) UpdatedAt() (r uint32, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Major entity.
+// If the Major object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MajorMutation) OldUpdatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (m *MajorMutation) AddUpdatedAt(u int32) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += u
+	} else {
+		m.addupdated_at = &u
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *MajorMutation) AddedUpdatedAt() (r int32, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *MajorMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+	m.clearedFields[major.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *MajorMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[major.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MajorMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+	delete(m.clearedFields, major.FieldUpdatedAt)
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *MajorMutation) AddUserIDs(ids ...int64) {
+	if m.users == nil {
+		m.users = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *MajorMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *MajorMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *MajorMutation) RemoveUserIDs(ids ...int64) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *MajorMutation) RemovedUsersIDs() (ids []int64) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *MajorMutation) UsersIDs() (ids []int64) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *MajorMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// AddSchoolIDs adds the "schools" edge to the School entity by ids.
+func (m *MajorMutation) AddSchoolIDs(ids ...uint32) {
+	if m.schools == nil {
+		m.schools = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		m.schools[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSchools clears the "schools" edge to the School entity.
+func (m *MajorMutation) ClearSchools() {
+	m.clearedschools = true
+}
+
+// SchoolsCleared reports if the "schools" edge to the School entity was cleared.
+func (m *MajorMutation) SchoolsCleared() bool {
+	return m.clearedschools
+}
+
+// RemoveSchoolIDs removes the "schools" edge to the School entity by IDs.
+func (m *MajorMutation) RemoveSchoolIDs(ids ...uint32) {
+	if m.removedschools == nil {
+		m.removedschools = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		delete(m.schools, ids[i])
+		m.removedschools[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSchools returns the removed IDs of the "schools" edge to the School entity.
+func (m *MajorMutation) RemovedSchoolsIDs() (ids []uint32) {
+	for id := range m.removedschools {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SchoolsIDs returns the "schools" edge IDs in the mutation.
+func (m *MajorMutation) SchoolsIDs() (ids []uint32) {
+	for id := range m.schools {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSchools resets all changes to the "schools" edge.
+func (m *MajorMutation) ResetSchools() {
+	m.schools = nil
+	m.clearedschools = false
+	m.removedschools = nil
+}
+
+// AddSchoolMajorIDs adds the "school_majors" edge to the SchoolMajor entity by ids.
+func (m *MajorMutation) AddSchoolMajorIDs(ids ...uint32) {
+	if m.school_majors == nil {
+		m.school_majors = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		m.school_majors[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSchoolMajors clears the "school_majors" edge to the SchoolMajor entity.
+func (m *MajorMutation) ClearSchoolMajors() {
+	m.clearedschool_majors = true
+}
+
+// SchoolMajorsCleared reports if the "school_majors" edge to the SchoolMajor entity was cleared.
+func (m *MajorMutation) SchoolMajorsCleared() bool {
+	return m.clearedschool_majors
+}
+
+// RemoveSchoolMajorIDs removes the "school_majors" edge to the SchoolMajor entity by IDs.
+func (m *MajorMutation) RemoveSchoolMajorIDs(ids ...uint32) {
+	if m.removedschool_majors == nil {
+		m.removedschool_majors = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		delete(m.school_majors, ids[i])
+		m.removedschool_majors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSchoolMajors returns the removed IDs of the "school_majors" edge to the SchoolMajor entity.
+func (m *MajorMutation) RemovedSchoolMajorsIDs() (ids []uint32) {
+	for id := range m.removedschool_majors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SchoolMajorsIDs returns the "school_majors" edge IDs in the mutation.
+func (m *MajorMutation) SchoolMajorsIDs() (ids []uint32) {
+	for id := range m.school_majors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSchoolMajors resets all changes to the "school_majors" edge.
+func (m *MajorMutation) ResetSchoolMajors() {
+	m.school_majors = nil
+	m.clearedschool_majors = false
+	m.removedschool_majors = nil
+}
+
+// Where appends a list predicates to the MajorMutation builder.
+func (m *MajorMutation) Where(ps ...predicate.Major) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MajorMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *MajorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Major, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -216,27 +566,36 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *MajorMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *MajorMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (Major).
+func (m *MajorMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.nick_name != nil {
-		fields = append(fields, user.FieldNickName)
+func (m *MajorMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, major.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, major.FieldDescription)
+	}
+	if m.created_at != nil {
+		fields = append(fields, major.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, major.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -244,10 +603,16 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *MajorMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldNickName:
-		return m.NickName()
+	case major.FieldName:
+		return m.Name()
+	case major.FieldDescription:
+		return m.Description()
+	case major.FieldCreatedAt:
+		return m.CreatedAt()
+	case major.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -255,36 +620,66 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MajorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldNickName:
-		return m.OldNickName(ctx)
+	case major.FieldName:
+		return m.OldName(ctx)
+	case major.FieldDescription:
+		return m.OldDescription(ctx)
+	case major.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case major.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown Major field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *MajorMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldNickName:
-		v, ok := value.(int64)
+	case major.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case major.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case major.FieldCreatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case major.FieldUpdatedAt:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNickName(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown Major field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *MajorMutation) AddedFields() []string {
 	var fields []string
-	if m.addnick_name != nil {
-		fields = append(fields, user.FieldNickName)
+	if m.addcreated_at != nil {
+		fields = append(fields, major.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, major.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -292,10 +687,12 @@ func (m *UserMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MajorMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldNickName:
-		return m.AddedNickName()
+	case major.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case major.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
 	}
 	return nil, false
 }
@@ -303,93 +700,3407 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *MajorMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldNickName:
-		v, ok := value.(int64)
+	case major.FieldCreatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case major.FieldUpdatedAt:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddNickName(v)
+		m.AddUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown Major numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
-	return nil
+func (m *MajorMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(major.FieldDescription) {
+		fields = append(fields, major.FieldDescription)
+	}
+	if m.FieldCleared(major.FieldCreatedAt) {
+		fields = append(fields, major.FieldCreatedAt)
+	}
+	if m.FieldCleared(major.FieldUpdatedAt) {
+		fields = append(fields, major.FieldUpdatedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *MajorMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *MajorMutation) ClearField(name string) error {
+	switch name {
+	case major.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case major.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case major.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Major nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *MajorMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldNickName:
-		m.ResetNickName()
+	case major.FieldName:
+		m.ResetName()
+		return nil
+	case major.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case major.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case major.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown Major field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *MajorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.users != nil {
+		edges = append(edges, major.EdgeUsers)
+	}
+	if m.schools != nil {
+		edges = append(edges, major.EdgeSchools)
+	}
+	if m.school_majors != nil {
+		edges = append(edges, major.EdgeSchoolMajors)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *MajorMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case major.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case major.EdgeSchools:
+		ids := make([]ent.Value, 0, len(m.schools))
+		for id := range m.schools {
+			ids = append(ids, id)
+		}
+		return ids
+	case major.EdgeSchoolMajors:
+		ids := make([]ent.Value, 0, len(m.school_majors))
+		for id := range m.school_majors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *MajorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedusers != nil {
+		edges = append(edges, major.EdgeUsers)
+	}
+	if m.removedschools != nil {
+		edges = append(edges, major.EdgeSchools)
+	}
+	if m.removedschool_majors != nil {
+		edges = append(edges, major.EdgeSchoolMajors)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *MajorMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case major.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case major.EdgeSchools:
+		ids := make([]ent.Value, 0, len(m.removedschools))
+		for id := range m.removedschools {
+			ids = append(ids, id)
+		}
+		return ids
+	case major.EdgeSchoolMajors:
+		ids := make([]ent.Value, 0, len(m.removedschool_majors))
+		for id := range m.removedschool_majors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MajorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedusers {
+		edges = append(edges, major.EdgeUsers)
+	}
+	if m.clearedschools {
+		edges = append(edges, major.EdgeSchools)
+	}
+	if m.clearedschool_majors {
+		edges = append(edges, major.EdgeSchoolMajors)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MajorMutation) EdgeCleared(name string) bool {
+	switch name {
+	case major.EdgeUsers:
+		return m.clearedusers
+	case major.EdgeSchools:
+		return m.clearedschools
+	case major.EdgeSchoolMajors:
+		return m.clearedschool_majors
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MajorMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Major unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MajorMutation) ResetEdge(name string) error {
+	switch name {
+	case major.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case major.EdgeSchools:
+		m.ResetSchools()
+		return nil
+	case major.EdgeSchoolMajors:
+		m.ResetSchoolMajors()
+		return nil
+	}
+	return fmt.Errorf("unknown Major edge %s", name)
+}
+
+// SchoolMutation represents an operation that mutates the School nodes in the graph.
+type SchoolMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uint32
+	name                 *string
+	logo_url             *string
+	status               *int8
+	addstatus            *int8
+	created_at           *uint32
+	addcreated_at        *int32
+	updated_at           *uint32
+	addupdated_at        *int32
+	clearedFields        map[string]struct{}
+	users                map[int64]struct{}
+	removedusers         map[int64]struct{}
+	clearedusers         bool
+	majors               map[uint32]struct{}
+	removedmajors        map[uint32]struct{}
+	clearedmajors        bool
+	school_majors        map[uint32]struct{}
+	removedschool_majors map[uint32]struct{}
+	clearedschool_majors bool
+	done                 bool
+	oldValue             func(context.Context) (*School, error)
+	predicates           []predicate.School
+}
+
+var _ ent.Mutation = (*SchoolMutation)(nil)
+
+// schoolOption allows management of the mutation configuration using functional options.
+type schoolOption func(*SchoolMutation)
+
+// newSchoolMutation creates new mutation for the School entity.
+func newSchoolMutation(c config, op Op, opts ...schoolOption) *SchoolMutation {
+	m := &SchoolMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSchool,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSchoolID sets the ID field of the mutation.
+func withSchoolID(id uint32) schoolOption {
+	return func(m *SchoolMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *School
+		)
+		m.oldValue = func(ctx context.Context) (*School, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().School.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSchool sets the old School of the mutation.
+func withSchool(node *School) schoolOption {
+	return func(m *SchoolMutation) {
+		m.oldValue = func(context.Context) (*School, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SchoolMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SchoolMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of School entities.
+func (m *SchoolMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SchoolMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SchoolMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().School.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *SchoolMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SchoolMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the School entity.
+// If the School object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchoolMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SchoolMutation) ResetName() {
+	m.name = nil
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (m *SchoolMutation) SetLogoURL(s string) {
+	m.logo_url = &s
+}
+
+// LogoURL returns the value of the "logo_url" field in the mutation.
+func (m *SchoolMutation) LogoURL() (r string, exists bool) {
+	v := m.logo_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogoURL returns the old "logo_url" field's value of the School entity.
+// If the School object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchoolMutation) OldLogoURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogoURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogoURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogoURL: %w", err)
+	}
+	return oldValue.LogoURL, nil
+}
+
+// ResetLogoURL resets all changes to the "logo_url" field.
+func (m *SchoolMutation) ResetLogoURL() {
+	m.logo_url = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SchoolMutation) SetStatus(i int8) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SchoolMutation) Status() (r int8, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the School entity.
+// If the School object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchoolMutation) OldStatus(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *SchoolMutation) AddStatus(i int8) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *SchoolMutation) AddedStatus() (r int8, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SchoolMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SchoolMutation) SetCreatedAt(u uint32) {
+	m.created_at = &u
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SchoolMutation) CreatedAt() (r uint32, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the School entity.
+// If the School object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchoolMutation) OldCreatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (m *SchoolMutation) AddCreatedAt(u int32) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += u
+	} else {
+		m.addcreated_at = &u
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *SchoolMutation) AddedCreatedAt() (r int32, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SchoolMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+	m.clearedFields[school.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SchoolMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[school.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SchoolMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+	delete(m.clearedFields, school.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SchoolMutation) SetUpdatedAt(u uint32) {
+	m.updated_at = &u
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SchoolMutation) UpdatedAt() (r uint32, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the School entity.
+// If the School object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchoolMutation) OldUpdatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (m *SchoolMutation) AddUpdatedAt(u int32) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += u
+	} else {
+		m.addupdated_at = &u
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *SchoolMutation) AddedUpdatedAt() (r int32, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *SchoolMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+	m.clearedFields[school.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *SchoolMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[school.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SchoolMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+	delete(m.clearedFields, school.FieldUpdatedAt)
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *SchoolMutation) AddUserIDs(ids ...int64) {
+	if m.users == nil {
+		m.users = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *SchoolMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *SchoolMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *SchoolMutation) RemoveUserIDs(ids ...int64) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *SchoolMutation) RemovedUsersIDs() (ids []int64) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *SchoolMutation) UsersIDs() (ids []int64) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *SchoolMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// AddMajorIDs adds the "majors" edge to the Major entity by ids.
+func (m *SchoolMutation) AddMajorIDs(ids ...uint32) {
+	if m.majors == nil {
+		m.majors = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		m.majors[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMajors clears the "majors" edge to the Major entity.
+func (m *SchoolMutation) ClearMajors() {
+	m.clearedmajors = true
+}
+
+// MajorsCleared reports if the "majors" edge to the Major entity was cleared.
+func (m *SchoolMutation) MajorsCleared() bool {
+	return m.clearedmajors
+}
+
+// RemoveMajorIDs removes the "majors" edge to the Major entity by IDs.
+func (m *SchoolMutation) RemoveMajorIDs(ids ...uint32) {
+	if m.removedmajors == nil {
+		m.removedmajors = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		delete(m.majors, ids[i])
+		m.removedmajors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMajors returns the removed IDs of the "majors" edge to the Major entity.
+func (m *SchoolMutation) RemovedMajorsIDs() (ids []uint32) {
+	for id := range m.removedmajors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MajorsIDs returns the "majors" edge IDs in the mutation.
+func (m *SchoolMutation) MajorsIDs() (ids []uint32) {
+	for id := range m.majors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMajors resets all changes to the "majors" edge.
+func (m *SchoolMutation) ResetMajors() {
+	m.majors = nil
+	m.clearedmajors = false
+	m.removedmajors = nil
+}
+
+// AddSchoolMajorIDs adds the "school_majors" edge to the SchoolMajor entity by ids.
+func (m *SchoolMutation) AddSchoolMajorIDs(ids ...uint32) {
+	if m.school_majors == nil {
+		m.school_majors = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		m.school_majors[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSchoolMajors clears the "school_majors" edge to the SchoolMajor entity.
+func (m *SchoolMutation) ClearSchoolMajors() {
+	m.clearedschool_majors = true
+}
+
+// SchoolMajorsCleared reports if the "school_majors" edge to the SchoolMajor entity was cleared.
+func (m *SchoolMutation) SchoolMajorsCleared() bool {
+	return m.clearedschool_majors
+}
+
+// RemoveSchoolMajorIDs removes the "school_majors" edge to the SchoolMajor entity by IDs.
+func (m *SchoolMutation) RemoveSchoolMajorIDs(ids ...uint32) {
+	if m.removedschool_majors == nil {
+		m.removedschool_majors = make(map[uint32]struct{})
+	}
+	for i := range ids {
+		delete(m.school_majors, ids[i])
+		m.removedschool_majors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSchoolMajors returns the removed IDs of the "school_majors" edge to the SchoolMajor entity.
+func (m *SchoolMutation) RemovedSchoolMajorsIDs() (ids []uint32) {
+	for id := range m.removedschool_majors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SchoolMajorsIDs returns the "school_majors" edge IDs in the mutation.
+func (m *SchoolMutation) SchoolMajorsIDs() (ids []uint32) {
+	for id := range m.school_majors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSchoolMajors resets all changes to the "school_majors" edge.
+func (m *SchoolMutation) ResetSchoolMajors() {
+	m.school_majors = nil
+	m.clearedschool_majors = false
+	m.removedschool_majors = nil
+}
+
+// Where appends a list predicates to the SchoolMutation builder.
+func (m *SchoolMutation) Where(ps ...predicate.School) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SchoolMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SchoolMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.School, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SchoolMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SchoolMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (School).
+func (m *SchoolMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SchoolMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, school.FieldName)
+	}
+	if m.logo_url != nil {
+		fields = append(fields, school.FieldLogoURL)
+	}
+	if m.status != nil {
+		fields = append(fields, school.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, school.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, school.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SchoolMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case school.FieldName:
+		return m.Name()
+	case school.FieldLogoURL:
+		return m.LogoURL()
+	case school.FieldStatus:
+		return m.Status()
+	case school.FieldCreatedAt:
+		return m.CreatedAt()
+	case school.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SchoolMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case school.FieldName:
+		return m.OldName(ctx)
+	case school.FieldLogoURL:
+		return m.OldLogoURL(ctx)
+	case school.FieldStatus:
+		return m.OldStatus(ctx)
+	case school.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case school.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown School field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SchoolMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case school.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case school.FieldLogoURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogoURL(v)
+		return nil
+	case school.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case school.FieldCreatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case school.FieldUpdatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown School field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SchoolMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, school.FieldStatus)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, school.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, school.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SchoolMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case school.FieldStatus:
+		return m.AddedStatus()
+	case school.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case school.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SchoolMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case school.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case school.FieldCreatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case school.FieldUpdatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown School numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SchoolMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(school.FieldCreatedAt) {
+		fields = append(fields, school.FieldCreatedAt)
+	}
+	if m.FieldCleared(school.FieldUpdatedAt) {
+		fields = append(fields, school.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SchoolMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SchoolMutation) ClearField(name string) error {
+	switch name {
+	case school.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case school.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown School nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SchoolMutation) ResetField(name string) error {
+	switch name {
+	case school.FieldName:
+		m.ResetName()
+		return nil
+	case school.FieldLogoURL:
+		m.ResetLogoURL()
+		return nil
+	case school.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case school.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case school.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown School field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SchoolMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.users != nil {
+		edges = append(edges, school.EdgeUsers)
+	}
+	if m.majors != nil {
+		edges = append(edges, school.EdgeMajors)
+	}
+	if m.school_majors != nil {
+		edges = append(edges, school.EdgeSchoolMajors)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SchoolMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case school.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case school.EdgeMajors:
+		ids := make([]ent.Value, 0, len(m.majors))
+		for id := range m.majors {
+			ids = append(ids, id)
+		}
+		return ids
+	case school.EdgeSchoolMajors:
+		ids := make([]ent.Value, 0, len(m.school_majors))
+		for id := range m.school_majors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SchoolMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedusers != nil {
+		edges = append(edges, school.EdgeUsers)
+	}
+	if m.removedmajors != nil {
+		edges = append(edges, school.EdgeMajors)
+	}
+	if m.removedschool_majors != nil {
+		edges = append(edges, school.EdgeSchoolMajors)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SchoolMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case school.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case school.EdgeMajors:
+		ids := make([]ent.Value, 0, len(m.removedmajors))
+		for id := range m.removedmajors {
+			ids = append(ids, id)
+		}
+		return ids
+	case school.EdgeSchoolMajors:
+		ids := make([]ent.Value, 0, len(m.removedschool_majors))
+		for id := range m.removedschool_majors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SchoolMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedusers {
+		edges = append(edges, school.EdgeUsers)
+	}
+	if m.clearedmajors {
+		edges = append(edges, school.EdgeMajors)
+	}
+	if m.clearedschool_majors {
+		edges = append(edges, school.EdgeSchoolMajors)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SchoolMutation) EdgeCleared(name string) bool {
+	switch name {
+	case school.EdgeUsers:
+		return m.clearedusers
+	case school.EdgeMajors:
+		return m.clearedmajors
+	case school.EdgeSchoolMajors:
+		return m.clearedschool_majors
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SchoolMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown School unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SchoolMutation) ResetEdge(name string) error {
+	switch name {
+	case school.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case school.EdgeMajors:
+		m.ResetMajors()
+		return nil
+	case school.EdgeSchoolMajors:
+		m.ResetSchoolMajors()
+		return nil
+	}
+	return fmt.Errorf("unknown School edge %s", name)
+}
+
+// SchoolMajorMutation represents an operation that mutates the SchoolMajor nodes in the graph.
+type SchoolMajorMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint32
+	created_at    *uint32
+	addcreated_at *int32
+	clearedFields map[string]struct{}
+	school        *uint32
+	clearedschool bool
+	major         *uint32
+	clearedmajor  bool
+	done          bool
+	oldValue      func(context.Context) (*SchoolMajor, error)
+	predicates    []predicate.SchoolMajor
+}
+
+var _ ent.Mutation = (*SchoolMajorMutation)(nil)
+
+// schoolmajorOption allows management of the mutation configuration using functional options.
+type schoolmajorOption func(*SchoolMajorMutation)
+
+// newSchoolMajorMutation creates new mutation for the SchoolMajor entity.
+func newSchoolMajorMutation(c config, op Op, opts ...schoolmajorOption) *SchoolMajorMutation {
+	m := &SchoolMajorMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSchoolMajor,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSchoolMajorID sets the ID field of the mutation.
+func withSchoolMajorID(id uint32) schoolmajorOption {
+	return func(m *SchoolMajorMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SchoolMajor
+		)
+		m.oldValue = func(ctx context.Context) (*SchoolMajor, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SchoolMajor.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSchoolMajor sets the old SchoolMajor of the mutation.
+func withSchoolMajor(node *SchoolMajor) schoolmajorOption {
+	return func(m *SchoolMajorMutation) {
+		m.oldValue = func(context.Context) (*SchoolMajor, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SchoolMajorMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SchoolMajorMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SchoolMajor entities.
+func (m *SchoolMajorMutation) SetID(id uint32) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SchoolMajorMutation) ID() (id uint32, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SchoolMajorMutation) IDs(ctx context.Context) ([]uint32, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint32{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SchoolMajor.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSchoolID sets the "school_id" field.
+func (m *SchoolMajorMutation) SetSchoolID(u uint32) {
+	m.school = &u
+}
+
+// SchoolID returns the value of the "school_id" field in the mutation.
+func (m *SchoolMajorMutation) SchoolID() (r uint32, exists bool) {
+	v := m.school
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchoolID returns the old "school_id" field's value of the SchoolMajor entity.
+// If the SchoolMajor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchoolMajorMutation) OldSchoolID(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchoolID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchoolID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchoolID: %w", err)
+	}
+	return oldValue.SchoolID, nil
+}
+
+// ResetSchoolID resets all changes to the "school_id" field.
+func (m *SchoolMajorMutation) ResetSchoolID() {
+	m.school = nil
+}
+
+// SetMajorID sets the "major_id" field.
+func (m *SchoolMajorMutation) SetMajorID(u uint32) {
+	m.major = &u
+}
+
+// MajorID returns the value of the "major_id" field in the mutation.
+func (m *SchoolMajorMutation) MajorID() (r uint32, exists bool) {
+	v := m.major
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMajorID returns the old "major_id" field's value of the SchoolMajor entity.
+// If the SchoolMajor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchoolMajorMutation) OldMajorID(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMajorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMajorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMajorID: %w", err)
+	}
+	return oldValue.MajorID, nil
+}
+
+// ResetMajorID resets all changes to the "major_id" field.
+func (m *SchoolMajorMutation) ResetMajorID() {
+	m.major = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SchoolMajorMutation) SetCreatedAt(u uint32) {
+	m.created_at = &u
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SchoolMajorMutation) CreatedAt() (r uint32, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SchoolMajor entity.
+// If the SchoolMajor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchoolMajorMutation) OldCreatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (m *SchoolMajorMutation) AddCreatedAt(u int32) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += u
+	} else {
+		m.addcreated_at = &u
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *SchoolMajorMutation) AddedCreatedAt() (r int32, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SchoolMajorMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+	m.clearedFields[schoolmajor.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SchoolMajorMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[schoolmajor.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SchoolMajorMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+	delete(m.clearedFields, schoolmajor.FieldCreatedAt)
+}
+
+// ClearSchool clears the "school" edge to the School entity.
+func (m *SchoolMajorMutation) ClearSchool() {
+	m.clearedschool = true
+	m.clearedFields[schoolmajor.FieldSchoolID] = struct{}{}
+}
+
+// SchoolCleared reports if the "school" edge to the School entity was cleared.
+func (m *SchoolMajorMutation) SchoolCleared() bool {
+	return m.clearedschool
+}
+
+// SchoolIDs returns the "school" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SchoolID instead. It exists only for internal usage by the builders.
+func (m *SchoolMajorMutation) SchoolIDs() (ids []uint32) {
+	if id := m.school; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSchool resets all changes to the "school" edge.
+func (m *SchoolMajorMutation) ResetSchool() {
+	m.school = nil
+	m.clearedschool = false
+}
+
+// ClearMajor clears the "major" edge to the Major entity.
+func (m *SchoolMajorMutation) ClearMajor() {
+	m.clearedmajor = true
+	m.clearedFields[schoolmajor.FieldMajorID] = struct{}{}
+}
+
+// MajorCleared reports if the "major" edge to the Major entity was cleared.
+func (m *SchoolMajorMutation) MajorCleared() bool {
+	return m.clearedmajor
+}
+
+// MajorIDs returns the "major" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MajorID instead. It exists only for internal usage by the builders.
+func (m *SchoolMajorMutation) MajorIDs() (ids []uint32) {
+	if id := m.major; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMajor resets all changes to the "major" edge.
+func (m *SchoolMajorMutation) ResetMajor() {
+	m.major = nil
+	m.clearedmajor = false
+}
+
+// Where appends a list predicates to the SchoolMajorMutation builder.
+func (m *SchoolMajorMutation) Where(ps ...predicate.SchoolMajor) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SchoolMajorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SchoolMajorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SchoolMajor, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SchoolMajorMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SchoolMajorMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SchoolMajor).
+func (m *SchoolMajorMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SchoolMajorMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.school != nil {
+		fields = append(fields, schoolmajor.FieldSchoolID)
+	}
+	if m.major != nil {
+		fields = append(fields, schoolmajor.FieldMajorID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, schoolmajor.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SchoolMajorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case schoolmajor.FieldSchoolID:
+		return m.SchoolID()
+	case schoolmajor.FieldMajorID:
+		return m.MajorID()
+	case schoolmajor.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SchoolMajorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case schoolmajor.FieldSchoolID:
+		return m.OldSchoolID(ctx)
+	case schoolmajor.FieldMajorID:
+		return m.OldMajorID(ctx)
+	case schoolmajor.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SchoolMajor field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SchoolMajorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case schoolmajor.FieldSchoolID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchoolID(v)
+		return nil
+	case schoolmajor.FieldMajorID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMajorID(v)
+		return nil
+	case schoolmajor.FieldCreatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SchoolMajor field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SchoolMajorMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, schoolmajor.FieldCreatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SchoolMajorMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case schoolmajor.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SchoolMajorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case schoolmajor.FieldCreatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SchoolMajor numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SchoolMajorMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(schoolmajor.FieldCreatedAt) {
+		fields = append(fields, schoolmajor.FieldCreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SchoolMajorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SchoolMajorMutation) ClearField(name string) error {
+	switch name {
+	case schoolmajor.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SchoolMajor nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SchoolMajorMutation) ResetField(name string) error {
+	switch name {
+	case schoolmajor.FieldSchoolID:
+		m.ResetSchoolID()
+		return nil
+	case schoolmajor.FieldMajorID:
+		m.ResetMajorID()
+		return nil
+	case schoolmajor.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SchoolMajor field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SchoolMajorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.school != nil {
+		edges = append(edges, schoolmajor.EdgeSchool)
+	}
+	if m.major != nil {
+		edges = append(edges, schoolmajor.EdgeMajor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SchoolMajorMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case schoolmajor.EdgeSchool:
+		if id := m.school; id != nil {
+			return []ent.Value{*id}
+		}
+	case schoolmajor.EdgeMajor:
+		if id := m.major; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SchoolMajorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SchoolMajorMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SchoolMajorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedschool {
+		edges = append(edges, schoolmajor.EdgeSchool)
+	}
+	if m.clearedmajor {
+		edges = append(edges, schoolmajor.EdgeMajor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SchoolMajorMutation) EdgeCleared(name string) bool {
+	switch name {
+	case schoolmajor.EdgeSchool:
+		return m.clearedschool
+	case schoolmajor.EdgeMajor:
+		return m.clearedmajor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SchoolMajorMutation) ClearEdge(name string) error {
+	switch name {
+	case schoolmajor.EdgeSchool:
+		m.ClearSchool()
+		return nil
+	case schoolmajor.EdgeMajor:
+		m.ClearMajor()
+		return nil
+	}
+	return fmt.Errorf("unknown SchoolMajor unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SchoolMajorMutation) ResetEdge(name string) error {
+	switch name {
+	case schoolmajor.EdgeSchool:
+		m.ResetSchool()
+		return nil
+	case schoolmajor.EdgeMajor:
+		m.ResetMajor()
+		return nil
+	}
+	return fmt.Errorf("unknown SchoolMajor edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int64
+	nickname           *string
+	phone              *string
+	password           *string
+	admission_grade    *int32
+	addadmission_grade *int32
+	avatar_url         *string
+	experience         *int32
+	addexperience      *int32
+	status             *int8
+	addstatus          *int8
+	logoff_time        *uint32
+	addlogoff_time     *int32
+	banned_time        *uint32
+	addbanned_time     *int32
+	unbanned_time      *uint32
+	addunbanned_time   *int32
+	created_at         *uint32
+	addcreated_at      *int32
+	updated_at         *uint32
+	addupdated_at      *int32
+	clearedFields      map[string]struct{}
+	school             *uint32
+	clearedschool      bool
+	major              *uint32
+	clearedmajor       bool
+	done               bool
+	oldValue           func(context.Context) (*User, error)
+	predicates         []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int64) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNickname sets the "nickname" field.
+func (m *UserMutation) SetNickname(s string) {
+	m.nickname = &s
+}
+
+// Nickname returns the value of the "nickname" field in the mutation.
+func (m *UserMutation) Nickname() (r string, exists bool) {
+	v := m.nickname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNickname returns the old "nickname" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldNickname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+	}
+	return oldValue.Nickname, nil
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (m *UserMutation) ClearNickname() {
+	m.nickname = nil
+	m.clearedFields[user.FieldNickname] = struct{}{}
+}
+
+// NicknameCleared returns if the "nickname" field was cleared in this mutation.
+func (m *UserMutation) NicknameCleared() bool {
+	_, ok := m.clearedFields[user.FieldNickname]
+	return ok
+}
+
+// ResetNickname resets all changes to the "nickname" field.
+func (m *UserMutation) ResetNickname() {
+	m.nickname = nil
+	delete(m.clearedFields, user.FieldNickname)
+}
+
+// SetPhone sets the "phone" field.
+func (m *UserMutation) SetPhone(s string) {
+	m.phone = &s
+}
+
+// Phone returns the value of the "phone" field in the mutation.
+func (m *UserMutation) Phone() (r string, exists bool) {
+	v := m.phone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhone returns the old "phone" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPhone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+	}
+	return oldValue.Phone, nil
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (m *UserMutation) ClearPhone() {
+	m.phone = nil
+	m.clearedFields[user.FieldPhone] = struct{}{}
+}
+
+// PhoneCleared returns if the "phone" field was cleared in this mutation.
+func (m *UserMutation) PhoneCleared() bool {
+	_, ok := m.clearedFields[user.FieldPhone]
+	return ok
+}
+
+// ResetPhone resets all changes to the "phone" field.
+func (m *UserMutation) ResetPhone() {
+	m.phone = nil
+	delete(m.clearedFields, user.FieldPhone)
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ClearPassword clears the value of the "password" field.
+func (m *UserMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[user.FieldPassword] = struct{}{}
+}
+
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *UserMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[user.FieldPassword]
+	return ok
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, user.FieldPassword)
+}
+
+// SetSchoolID sets the "school_id" field.
+func (m *UserMutation) SetSchoolID(u uint32) {
+	m.school = &u
+}
+
+// SchoolID returns the value of the "school_id" field in the mutation.
+func (m *UserMutation) SchoolID() (r uint32, exists bool) {
+	v := m.school
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchoolID returns the old "school_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSchoolID(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchoolID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchoolID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchoolID: %w", err)
+	}
+	return oldValue.SchoolID, nil
+}
+
+// ClearSchoolID clears the value of the "school_id" field.
+func (m *UserMutation) ClearSchoolID() {
+	m.school = nil
+	m.clearedFields[user.FieldSchoolID] = struct{}{}
+}
+
+// SchoolIDCleared returns if the "school_id" field was cleared in this mutation.
+func (m *UserMutation) SchoolIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldSchoolID]
+	return ok
+}
+
+// ResetSchoolID resets all changes to the "school_id" field.
+func (m *UserMutation) ResetSchoolID() {
+	m.school = nil
+	delete(m.clearedFields, user.FieldSchoolID)
+}
+
+// SetMajorID sets the "major_id" field.
+func (m *UserMutation) SetMajorID(u uint32) {
+	m.major = &u
+}
+
+// MajorID returns the value of the "major_id" field in the mutation.
+func (m *UserMutation) MajorID() (r uint32, exists bool) {
+	v := m.major
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMajorID returns the old "major_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMajorID(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMajorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMajorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMajorID: %w", err)
+	}
+	return oldValue.MajorID, nil
+}
+
+// ClearMajorID clears the value of the "major_id" field.
+func (m *UserMutation) ClearMajorID() {
+	m.major = nil
+	m.clearedFields[user.FieldMajorID] = struct{}{}
+}
+
+// MajorIDCleared returns if the "major_id" field was cleared in this mutation.
+func (m *UserMutation) MajorIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldMajorID]
+	return ok
+}
+
+// ResetMajorID resets all changes to the "major_id" field.
+func (m *UserMutation) ResetMajorID() {
+	m.major = nil
+	delete(m.clearedFields, user.FieldMajorID)
+}
+
+// SetAdmissionGrade sets the "admission_grade" field.
+func (m *UserMutation) SetAdmissionGrade(i int32) {
+	m.admission_grade = &i
+	m.addadmission_grade = nil
+}
+
+// AdmissionGrade returns the value of the "admission_grade" field in the mutation.
+func (m *UserMutation) AdmissionGrade() (r int32, exists bool) {
+	v := m.admission_grade
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdmissionGrade returns the old "admission_grade" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAdmissionGrade(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdmissionGrade is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdmissionGrade requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdmissionGrade: %w", err)
+	}
+	return oldValue.AdmissionGrade, nil
+}
+
+// AddAdmissionGrade adds i to the "admission_grade" field.
+func (m *UserMutation) AddAdmissionGrade(i int32) {
+	if m.addadmission_grade != nil {
+		*m.addadmission_grade += i
+	} else {
+		m.addadmission_grade = &i
+	}
+}
+
+// AddedAdmissionGrade returns the value that was added to the "admission_grade" field in this mutation.
+func (m *UserMutation) AddedAdmissionGrade() (r int32, exists bool) {
+	v := m.addadmission_grade
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAdmissionGrade clears the value of the "admission_grade" field.
+func (m *UserMutation) ClearAdmissionGrade() {
+	m.admission_grade = nil
+	m.addadmission_grade = nil
+	m.clearedFields[user.FieldAdmissionGrade] = struct{}{}
+}
+
+// AdmissionGradeCleared returns if the "admission_grade" field was cleared in this mutation.
+func (m *UserMutation) AdmissionGradeCleared() bool {
+	_, ok := m.clearedFields[user.FieldAdmissionGrade]
+	return ok
+}
+
+// ResetAdmissionGrade resets all changes to the "admission_grade" field.
+func (m *UserMutation) ResetAdmissionGrade() {
+	m.admission_grade = nil
+	m.addadmission_grade = nil
+	delete(m.clearedFields, user.FieldAdmissionGrade)
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (m *UserMutation) SetAvatarURL(s string) {
+	m.avatar_url = &s
+}
+
+// AvatarURL returns the value of the "avatar_url" field in the mutation.
+func (m *UserMutation) AvatarURL() (r string, exists bool) {
+	v := m.avatar_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatarURL returns the old "avatar_url" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatarURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatarURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatarURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatarURL: %w", err)
+	}
+	return oldValue.AvatarURL, nil
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (m *UserMutation) ClearAvatarURL() {
+	m.avatar_url = nil
+	m.clearedFields[user.FieldAvatarURL] = struct{}{}
+}
+
+// AvatarURLCleared returns if the "avatar_url" field was cleared in this mutation.
+func (m *UserMutation) AvatarURLCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatarURL]
+	return ok
+}
+
+// ResetAvatarURL resets all changes to the "avatar_url" field.
+func (m *UserMutation) ResetAvatarURL() {
+	m.avatar_url = nil
+	delete(m.clearedFields, user.FieldAvatarURL)
+}
+
+// SetExperience sets the "experience" field.
+func (m *UserMutation) SetExperience(i int32) {
+	m.experience = &i
+	m.addexperience = nil
+}
+
+// Experience returns the value of the "experience" field in the mutation.
+func (m *UserMutation) Experience() (r int32, exists bool) {
+	v := m.experience
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExperience returns the old "experience" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldExperience(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExperience is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExperience requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExperience: %w", err)
+	}
+	return oldValue.Experience, nil
+}
+
+// AddExperience adds i to the "experience" field.
+func (m *UserMutation) AddExperience(i int32) {
+	if m.addexperience != nil {
+		*m.addexperience += i
+	} else {
+		m.addexperience = &i
+	}
+}
+
+// AddedExperience returns the value that was added to the "experience" field in this mutation.
+func (m *UserMutation) AddedExperience() (r int32, exists bool) {
+	v := m.addexperience
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExperience resets all changes to the "experience" field.
+func (m *UserMutation) ResetExperience() {
+	m.experience = nil
+	m.addexperience = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *UserMutation) SetStatus(i int8) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *UserMutation) Status() (r int8, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatus(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *UserMutation) AddStatus(i int8) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *UserMutation) AddedStatus() (r int8, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *UserMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetLogoffTime sets the "logoff_time" field.
+func (m *UserMutation) SetLogoffTime(u uint32) {
+	m.logoff_time = &u
+	m.addlogoff_time = nil
+}
+
+// LogoffTime returns the value of the "logoff_time" field in the mutation.
+func (m *UserMutation) LogoffTime() (r uint32, exists bool) {
+	v := m.logoff_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogoffTime returns the old "logoff_time" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLogoffTime(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogoffTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogoffTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogoffTime: %w", err)
+	}
+	return oldValue.LogoffTime, nil
+}
+
+// AddLogoffTime adds u to the "logoff_time" field.
+func (m *UserMutation) AddLogoffTime(u int32) {
+	if m.addlogoff_time != nil {
+		*m.addlogoff_time += u
+	} else {
+		m.addlogoff_time = &u
+	}
+}
+
+// AddedLogoffTime returns the value that was added to the "logoff_time" field in this mutation.
+func (m *UserMutation) AddedLogoffTime() (r int32, exists bool) {
+	v := m.addlogoff_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLogoffTime clears the value of the "logoff_time" field.
+func (m *UserMutation) ClearLogoffTime() {
+	m.logoff_time = nil
+	m.addlogoff_time = nil
+	m.clearedFields[user.FieldLogoffTime] = struct{}{}
+}
+
+// LogoffTimeCleared returns if the "logoff_time" field was cleared in this mutation.
+func (m *UserMutation) LogoffTimeCleared() bool {
+	_, ok := m.clearedFields[user.FieldLogoffTime]
+	return ok
+}
+
+// ResetLogoffTime resets all changes to the "logoff_time" field.
+func (m *UserMutation) ResetLogoffTime() {
+	m.logoff_time = nil
+	m.addlogoff_time = nil
+	delete(m.clearedFields, user.FieldLogoffTime)
+}
+
+// SetBannedTime sets the "banned_time" field.
+func (m *UserMutation) SetBannedTime(u uint32) {
+	m.banned_time = &u
+	m.addbanned_time = nil
+}
+
+// BannedTime returns the value of the "banned_time" field in the mutation.
+func (m *UserMutation) BannedTime() (r uint32, exists bool) {
+	v := m.banned_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBannedTime returns the old "banned_time" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBannedTime(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBannedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBannedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBannedTime: %w", err)
+	}
+	return oldValue.BannedTime, nil
+}
+
+// AddBannedTime adds u to the "banned_time" field.
+func (m *UserMutation) AddBannedTime(u int32) {
+	if m.addbanned_time != nil {
+		*m.addbanned_time += u
+	} else {
+		m.addbanned_time = &u
+	}
+}
+
+// AddedBannedTime returns the value that was added to the "banned_time" field in this mutation.
+func (m *UserMutation) AddedBannedTime() (r int32, exists bool) {
+	v := m.addbanned_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBannedTime clears the value of the "banned_time" field.
+func (m *UserMutation) ClearBannedTime() {
+	m.banned_time = nil
+	m.addbanned_time = nil
+	m.clearedFields[user.FieldBannedTime] = struct{}{}
+}
+
+// BannedTimeCleared returns if the "banned_time" field was cleared in this mutation.
+func (m *UserMutation) BannedTimeCleared() bool {
+	_, ok := m.clearedFields[user.FieldBannedTime]
+	return ok
+}
+
+// ResetBannedTime resets all changes to the "banned_time" field.
+func (m *UserMutation) ResetBannedTime() {
+	m.banned_time = nil
+	m.addbanned_time = nil
+	delete(m.clearedFields, user.FieldBannedTime)
+}
+
+// SetUnbannedTime sets the "unbanned_time" field.
+func (m *UserMutation) SetUnbannedTime(u uint32) {
+	m.unbanned_time = &u
+	m.addunbanned_time = nil
+}
+
+// UnbannedTime returns the value of the "unbanned_time" field in the mutation.
+func (m *UserMutation) UnbannedTime() (r uint32, exists bool) {
+	v := m.unbanned_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnbannedTime returns the old "unbanned_time" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUnbannedTime(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnbannedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnbannedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnbannedTime: %w", err)
+	}
+	return oldValue.UnbannedTime, nil
+}
+
+// AddUnbannedTime adds u to the "unbanned_time" field.
+func (m *UserMutation) AddUnbannedTime(u int32) {
+	if m.addunbanned_time != nil {
+		*m.addunbanned_time += u
+	} else {
+		m.addunbanned_time = &u
+	}
+}
+
+// AddedUnbannedTime returns the value that was added to the "unbanned_time" field in this mutation.
+func (m *UserMutation) AddedUnbannedTime() (r int32, exists bool) {
+	v := m.addunbanned_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUnbannedTime clears the value of the "unbanned_time" field.
+func (m *UserMutation) ClearUnbannedTime() {
+	m.unbanned_time = nil
+	m.addunbanned_time = nil
+	m.clearedFields[user.FieldUnbannedTime] = struct{}{}
+}
+
+// UnbannedTimeCleared returns if the "unbanned_time" field was cleared in this mutation.
+func (m *UserMutation) UnbannedTimeCleared() bool {
+	_, ok := m.clearedFields[user.FieldUnbannedTime]
+	return ok
+}
+
+// ResetUnbannedTime resets all changes to the "unbanned_time" field.
+func (m *UserMutation) ResetUnbannedTime() {
+	m.unbanned_time = nil
+	m.addunbanned_time = nil
+	delete(m.clearedFields, user.FieldUnbannedTime)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(u uint32) {
+	m.created_at = &u
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r uint32, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds u to the "created_at" field.
+func (m *UserMutation) AddCreatedAt(u int32) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += u
+	} else {
+		m.addcreated_at = &u
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *UserMutation) AddedCreatedAt() (r int32, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *UserMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+	m.clearedFields[user.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *UserMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+	delete(m.clearedFields, user.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(u uint32) {
+	m.updated_at = &u
+	m.addupdated_at = nil
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r uint32, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// AddUpdatedAt adds u to the "updated_at" field.
+func (m *UserMutation) AddUpdatedAt(u int32) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += u
+	} else {
+		m.addupdated_at = &u
+	}
+}
+
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *UserMutation) AddedUpdatedAt() (r int32, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *UserMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+	m.clearedFields[user.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *UserMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+	delete(m.clearedFields, user.FieldUpdatedAt)
+}
+
+// ClearSchool clears the "school" edge to the School entity.
+func (m *UserMutation) ClearSchool() {
+	m.clearedschool = true
+	m.clearedFields[user.FieldSchoolID] = struct{}{}
+}
+
+// SchoolCleared reports if the "school" edge to the School entity was cleared.
+func (m *UserMutation) SchoolCleared() bool {
+	return m.SchoolIDCleared() || m.clearedschool
+}
+
+// SchoolIDs returns the "school" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SchoolID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) SchoolIDs() (ids []uint32) {
+	if id := m.school; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSchool resets all changes to the "school" edge.
+func (m *UserMutation) ResetSchool() {
+	m.school = nil
+	m.clearedschool = false
+}
+
+// ClearMajor clears the "major" edge to the Major entity.
+func (m *UserMutation) ClearMajor() {
+	m.clearedmajor = true
+	m.clearedFields[user.FieldMajorID] = struct{}{}
+}
+
+// MajorCleared reports if the "major" edge to the Major entity was cleared.
+func (m *UserMutation) MajorCleared() bool {
+	return m.MajorIDCleared() || m.clearedmajor
+}
+
+// MajorIDs returns the "major" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MajorID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) MajorIDs() (ids []uint32) {
+	if id := m.major; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMajor resets all changes to the "major" edge.
+func (m *UserMutation) ResetMajor() {
+	m.major = nil
+	m.clearedmajor = false
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.nickname != nil {
+		fields = append(fields, user.FieldNickname)
+	}
+	if m.phone != nil {
+		fields = append(fields, user.FieldPhone)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.school != nil {
+		fields = append(fields, user.FieldSchoolID)
+	}
+	if m.major != nil {
+		fields = append(fields, user.FieldMajorID)
+	}
+	if m.admission_grade != nil {
+		fields = append(fields, user.FieldAdmissionGrade)
+	}
+	if m.avatar_url != nil {
+		fields = append(fields, user.FieldAvatarURL)
+	}
+	if m.experience != nil {
+		fields = append(fields, user.FieldExperience)
+	}
+	if m.status != nil {
+		fields = append(fields, user.FieldStatus)
+	}
+	if m.logoff_time != nil {
+		fields = append(fields, user.FieldLogoffTime)
+	}
+	if m.banned_time != nil {
+		fields = append(fields, user.FieldBannedTime)
+	}
+	if m.unbanned_time != nil {
+		fields = append(fields, user.FieldUnbannedTime)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldNickname:
+		return m.Nickname()
+	case user.FieldPhone:
+		return m.Phone()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldSchoolID:
+		return m.SchoolID()
+	case user.FieldMajorID:
+		return m.MajorID()
+	case user.FieldAdmissionGrade:
+		return m.AdmissionGrade()
+	case user.FieldAvatarURL:
+		return m.AvatarURL()
+	case user.FieldExperience:
+		return m.Experience()
+	case user.FieldStatus:
+		return m.Status()
+	case user.FieldLogoffTime:
+		return m.LogoffTime()
+	case user.FieldBannedTime:
+		return m.BannedTime()
+	case user.FieldUnbannedTime:
+		return m.UnbannedTime()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldNickname:
+		return m.OldNickname(ctx)
+	case user.FieldPhone:
+		return m.OldPhone(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldSchoolID:
+		return m.OldSchoolID(ctx)
+	case user.FieldMajorID:
+		return m.OldMajorID(ctx)
+	case user.FieldAdmissionGrade:
+		return m.OldAdmissionGrade(ctx)
+	case user.FieldAvatarURL:
+		return m.OldAvatarURL(ctx)
+	case user.FieldExperience:
+		return m.OldExperience(ctx)
+	case user.FieldStatus:
+		return m.OldStatus(ctx)
+	case user.FieldLogoffTime:
+		return m.OldLogoffTime(ctx)
+	case user.FieldBannedTime:
+		return m.OldBannedTime(ctx)
+	case user.FieldUnbannedTime:
+		return m.OldUnbannedTime(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldNickname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNickname(v)
+		return nil
+	case user.FieldPhone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhone(v)
+		return nil
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldSchoolID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchoolID(v)
+		return nil
+	case user.FieldMajorID:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMajorID(v)
+		return nil
+	case user.FieldAdmissionGrade:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdmissionGrade(v)
+		return nil
+	case user.FieldAvatarURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatarURL(v)
+		return nil
+	case user.FieldExperience:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExperience(v)
+		return nil
+	case user.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case user.FieldLogoffTime:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogoffTime(v)
+		return nil
+	case user.FieldBannedTime:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBannedTime(v)
+		return nil
+	case user.FieldUnbannedTime:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnbannedTime(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addadmission_grade != nil {
+		fields = append(fields, user.FieldAdmissionGrade)
+	}
+	if m.addexperience != nil {
+		fields = append(fields, user.FieldExperience)
+	}
+	if m.addstatus != nil {
+		fields = append(fields, user.FieldStatus)
+	}
+	if m.addlogoff_time != nil {
+		fields = append(fields, user.FieldLogoffTime)
+	}
+	if m.addbanned_time != nil {
+		fields = append(fields, user.FieldBannedTime)
+	}
+	if m.addunbanned_time != nil {
+		fields = append(fields, user.FieldUnbannedTime)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldAdmissionGrade:
+		return m.AddedAdmissionGrade()
+	case user.FieldExperience:
+		return m.AddedExperience()
+	case user.FieldStatus:
+		return m.AddedStatus()
+	case user.FieldLogoffTime:
+		return m.AddedLogoffTime()
+	case user.FieldBannedTime:
+		return m.AddedBannedTime()
+	case user.FieldUnbannedTime:
+		return m.AddedUnbannedTime()
+	case user.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case user.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldAdmissionGrade:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAdmissionGrade(v)
+		return nil
+	case user.FieldExperience:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExperience(v)
+		return nil
+	case user.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case user.FieldLogoffTime:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLogoffTime(v)
+		return nil
+	case user.FieldBannedTime:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBannedTime(v)
+		return nil
+	case user.FieldUnbannedTime:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUnbannedTime(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldNickname) {
+		fields = append(fields, user.FieldNickname)
+	}
+	if m.FieldCleared(user.FieldPhone) {
+		fields = append(fields, user.FieldPhone)
+	}
+	if m.FieldCleared(user.FieldPassword) {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.FieldCleared(user.FieldSchoolID) {
+		fields = append(fields, user.FieldSchoolID)
+	}
+	if m.FieldCleared(user.FieldMajorID) {
+		fields = append(fields, user.FieldMajorID)
+	}
+	if m.FieldCleared(user.FieldAdmissionGrade) {
+		fields = append(fields, user.FieldAdmissionGrade)
+	}
+	if m.FieldCleared(user.FieldAvatarURL) {
+		fields = append(fields, user.FieldAvatarURL)
+	}
+	if m.FieldCleared(user.FieldLogoffTime) {
+		fields = append(fields, user.FieldLogoffTime)
+	}
+	if m.FieldCleared(user.FieldBannedTime) {
+		fields = append(fields, user.FieldBannedTime)
+	}
+	if m.FieldCleared(user.FieldUnbannedTime) {
+		fields = append(fields, user.FieldUnbannedTime)
+	}
+	if m.FieldCleared(user.FieldCreatedAt) {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.FieldCleared(user.FieldUpdatedAt) {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldNickname:
+		m.ClearNickname()
+		return nil
+	case user.FieldPhone:
+		m.ClearPhone()
+		return nil
+	case user.FieldPassword:
+		m.ClearPassword()
+		return nil
+	case user.FieldSchoolID:
+		m.ClearSchoolID()
+		return nil
+	case user.FieldMajorID:
+		m.ClearMajorID()
+		return nil
+	case user.FieldAdmissionGrade:
+		m.ClearAdmissionGrade()
+		return nil
+	case user.FieldAvatarURL:
+		m.ClearAvatarURL()
+		return nil
+	case user.FieldLogoffTime:
+		m.ClearLogoffTime()
+		return nil
+	case user.FieldBannedTime:
+		m.ClearBannedTime()
+		return nil
+	case user.FieldUnbannedTime:
+		m.ClearUnbannedTime()
+		return nil
+	case user.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldNickname:
+		m.ResetNickname()
+		return nil
+	case user.FieldPhone:
+		m.ResetPhone()
+		return nil
+	case user.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case user.FieldSchoolID:
+		m.ResetSchoolID()
+		return nil
+	case user.FieldMajorID:
+		m.ResetMajorID()
+		return nil
+	case user.FieldAdmissionGrade:
+		m.ResetAdmissionGrade()
+		return nil
+	case user.FieldAvatarURL:
+		m.ResetAvatarURL()
+		return nil
+	case user.FieldExperience:
+		m.ResetExperience()
+		return nil
+	case user.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case user.FieldLogoffTime:
+		m.ResetLogoffTime()
+		return nil
+	case user.FieldBannedTime:
+		m.ResetBannedTime()
+		return nil
+	case user.FieldUnbannedTime:
+		m.ResetUnbannedTime()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.school != nil {
+		edges = append(edges, user.EdgeSchool)
+	}
+	if m.major != nil {
+		edges = append(edges, user.EdgeMajor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeSchool:
+		if id := m.school; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeMajor:
+		if id := m.major; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 2)
+	if m.clearedschool {
+		edges = append(edges, user.EdgeSchool)
+	}
+	if m.clearedmajor {
+		edges = append(edges, user.EdgeMajor)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
 func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeSchool:
+		return m.clearedschool
+	case user.EdgeMajor:
+		return m.clearedmajor
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
 func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	case user.EdgeSchool:
+		m.ClearSchool()
+		return nil
+	case user.EdgeMajor:
+		m.ClearMajor()
+		return nil
+	}
 	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
 func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeSchool:
+		m.ResetSchool()
+		return nil
+	case user.EdgeMajor:
+		m.ResetMajor()
+		return nil
+	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
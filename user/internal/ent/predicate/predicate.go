@@ -6,5 +6,14 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Major is the predicate function for major builders.
+type Major func(*sql.Selector)
+
+// School is the predicate function for school builders.
+type School func(*sql.Selector)
+
+// SchoolMajor is the predicate function for schoolmajor builders.
+type SchoolMajor func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -110,6 +110,78 @@ func DenyMutationOperationRule(op ent.Op) MutationRule {
 	return OnMutationOperation(rule, op)
 }
 
+// The MajorQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type MajorQueryRuleFunc func(context.Context, *ent.MajorQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f MajorQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MajorQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.MajorQuery", q)
+}
+
+// The MajorMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type MajorMutationRuleFunc func(context.Context, *ent.MajorMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f MajorMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.MajorMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MajorMutation", m)
+}
+
+// The SchoolQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SchoolQueryRuleFunc func(context.Context, *ent.SchoolQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SchoolQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SchoolQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SchoolQuery", q)
+}
+
+// The SchoolMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SchoolMutationRuleFunc func(context.Context, *ent.SchoolMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SchoolMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SchoolMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SchoolMutation", m)
+}
+
+// The SchoolMajorQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SchoolMajorQueryRuleFunc func(context.Context, *ent.SchoolMajorQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SchoolMajorQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SchoolMajorQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SchoolMajorQuery", q)
+}
+
+// The SchoolMajorMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SchoolMajorMutationRuleFunc func(context.Context, *ent.SchoolMajorMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SchoolMajorMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SchoolMajorMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SchoolMajorMutation", m)
+}
+
 // The UserQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type UserQueryRuleFunc func(context.Context, *ent.UserQuery) error
@@ -2,8 +2,109 @@
 
 package ent
 
+import (
+	"user/internal/ent/major"
+	"user/internal/ent/schema"
+	"user/internal/ent/school"
+	"user/internal/ent/user"
+)
+
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	majorFields := schema.Major{}.Fields()
+	_ = majorFields
+	// majorDescName is the schema descriptor for name field.
+	majorDescName := majorFields[1].Descriptor()
+	// major.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	major.NameValidator = func() func(string) error {
+		validators := majorDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// majorDescDescription is the schema descriptor for description field.
+	majorDescDescription := majorFields[2].Descriptor()
+	// major.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	major.DescriptionValidator = majorDescDescription.Validators[0].(func(string) error)
+	schoolFields := schema.School{}.Fields()
+	_ = schoolFields
+	// schoolDescName is the schema descriptor for name field.
+	schoolDescName := schoolFields[1].Descriptor()
+	// school.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	school.NameValidator = func() func(string) error {
+		validators := schoolDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// schoolDescLogoURL is the schema descriptor for logo_url field.
+	schoolDescLogoURL := schoolFields[2].Descriptor()
+	// school.DefaultLogoURL holds the default value on creation for the logo_url field.
+	school.DefaultLogoURL = schoolDescLogoURL.Default.(string)
+	// school.LogoURLValidator is a validator for the "logo_url" field. It is called by the builders before save.
+	school.LogoURLValidator = schoolDescLogoURL.Validators[0].(func(string) error)
+	// schoolDescStatus is the schema descriptor for status field.
+	schoolDescStatus := schoolFields[3].Descriptor()
+	// school.DefaultStatus holds the default value on creation for the status field.
+	school.DefaultStatus = schoolDescStatus.Default.(int8)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescNickname is the schema descriptor for nickname field.
+	userDescNickname := userFields[1].Descriptor()
+	// user.NicknameValidator is a validator for the "nickname" field. It is called by the builders before save.
+	user.NicknameValidator = userDescNickname.Validators[0].(func(string) error)
+	// userDescPhone is the schema descriptor for phone field.
+	userDescPhone := userFields[2].Descriptor()
+	// user.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	user.PhoneValidator = userDescPhone.Validators[0].(func(string) error)
+	// userDescPassword is the schema descriptor for password field.
+	userDescPassword := userFields[3].Descriptor()
+	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
+	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+	// userDescAvatarURL is the schema descriptor for avatar_url field.
+	userDescAvatarURL := userFields[7].Descriptor()
+	// user.DefaultAvatarURL holds the default value on creation for the avatar_url field.
+	user.DefaultAvatarURL = userDescAvatarURL.Default.(string)
+	// user.AvatarURLValidator is a validator for the "avatar_url" field. It is called by the builders before save.
+	user.AvatarURLValidator = userDescAvatarURL.Validators[0].(func(string) error)
+	// userDescExperience is the schema descriptor for experience field.
+	userDescExperience := userFields[8].Descriptor()
+	// user.DefaultExperience holds the default value on creation for the experience field.
+	user.DefaultExperience = userDescExperience.Default.(int32)
+	// userDescStatus is the schema descriptor for status field.
+	userDescStatus := userFields[9].Descriptor()
+	// user.DefaultStatus holds the default value on creation for the status field.
+	user.DefaultStatus = userDescStatus.Default.(int8)
+	// userDescLogoffTime is the schema descriptor for logoff_time field.
+	userDescLogoffTime := userFields[10].Descriptor()
+	// user.DefaultLogoffTime holds the default value on creation for the logoff_time field.
+	user.DefaultLogoffTime = userDescLogoffTime.Default.(uint32)
+	// userDescBannedTime is the schema descriptor for banned_time field.
+	userDescBannedTime := userFields[11].Descriptor()
+	// user.DefaultBannedTime holds the default value on creation for the banned_time field.
+	user.DefaultBannedTime = userDescBannedTime.Default.(uint32)
+	// userDescUnbannedTime is the schema descriptor for unbanned_time field.
+	userDescUnbannedTime := userFields[12].Descriptor()
+	// user.DefaultUnbannedTime holds the default value on creation for the unbanned_time field.
+	user.DefaultUnbannedTime = userDescUnbannedTime.Default.(uint32)
 }
@@ -1,32 +0,0 @@
-package schema
-
-import (
-	"entgo.io/ent"
-	"entgo.io/ent/dialect/entsql"
-	"entgo.io/ent/schema"
-	"entgo.io/ent/schema/field"
-)
-
-// User holds the schema definition for the User entity.
-type User struct {
-	ent.Schema
-}
-
-// Fields of the User.
-func (User) Fields() []ent.Field {
-	return []ent.Field{
-		field.Int64("id").Immutable().Unique().Comment("ID").StructTag(`json:"id"`),
-		field.Int64("nick_name").Comment("用户名称").StructTag(`json:"nick_name"`),
-	}
-}
-
-// Edges of the User.
-func (User) Edges() []ent.Edge {
-	return []ent.Edge{}
-}
-
-func (User) Annotations() []schema.Annotation {
-	return []schema.Annotation{
-		entsql.Annotation{Table: "User"},
-	}
-}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Major holds the schema definition for the Major entity.
+type Major struct {
+	ent.Schema
+}
+
+// Fields of the Major.
+func (Major) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("id").Immutable().Unique().Comment("专业ID").StructTag(`json:"id"`),
+		field.String("name").MaxLen(100).NotEmpty().Comment("专业名称").StructTag(`json:"name"`),
+		field.String("description").MaxLen(255).Optional().Comment("专业描述").StructTag(`json:"description"`),
+		field.Uint32("created_at").Optional().Immutable().Comment("创建时间（UNIX时间戳）").StructTag(`json:"created_at"`),
+		field.Uint32("updated_at").Optional().Comment("更新时间（UNIX时间戳）").StructTag(`json:"updated_at"`),
+	}
+}
+
+// Edges of the Major.
+func (Major) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("users", User.Type),
+		edge.From("schools", School.Type).Ref("majors").Through("school_majors", SchoolMajor.Type),
+	}
+}
+
+// Indexes of the Major.
+func (Major) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Unique().StorageKey("uk_name"),
+	}
+}
+
+func (Major) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "major"},
+		schema.Comment("专业表"),
+		entsql.WithComments(true),
+	}
+}
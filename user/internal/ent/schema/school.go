@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// 学校状态
+const (
+	SchoolStatusDisabled int8 = 0 // 未启用
+	SchoolStatusEnabled  int8 = 1 // 启用
+)
+
+// School holds the schema definition for the School entity.
+type School struct {
+	ent.Schema
+}
+
+// Fields of the School.
+func (School) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("id").Immutable().Unique().Comment("学校ID").StructTag(`json:"id"`),
+		field.String("name").MaxLen(100).NotEmpty().Comment("学校全称").StructTag(`json:"name"`),
+		field.String("logo_url").MaxLen(255).Default("").Comment("学校Logo图片URL").StructTag(`json:"logo_url"`),
+		field.Int8("status").Default(SchoolStatusEnabled).Comment("状态（1：启用，0：未启用）").StructTag(`json:"status"`),
+		field.Uint32("created_at").Optional().Immutable().Comment("创建时间（UNIX时间戳）").StructTag(`json:"created_at"`),
+		field.Uint32("updated_at").Optional().Comment("更新时间（UNIX时间戳）").StructTag(`json:"updated_at"`),
+	}
+}
+
+// Edges of the School.
+func (School) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("users", User.Type),
+		edge.To("majors", Major.Type).Through("school_majors", SchoolMajor.Type),
+	}
+}
+
+// Indexes of the School.
+func (School) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Unique().StorageKey("uk_name"),
+	}
+}
+
+func (School) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "school"},
+		schema.Comment("学校表"),
+		entsql.WithComments(true),
+	}
+}
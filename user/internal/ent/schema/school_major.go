@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SchoolMajor holds the schema definition for the SchoolMajor entity.
+type SchoolMajor struct {
+	ent.Schema
+}
+
+// Fields of the SchoolMajor.
+func (SchoolMajor) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("id").Immutable().Unique().Comment("关联ID").StructTag(`json:"id"`),
+		field.Uint32("school_id").Comment("学校ID").StructTag(`json:"school_id"`),
+		field.Uint32("major_id").Comment("专业ID").StructTag(`json:"major_id"`),
+		field.Uint32("created_at").Optional().Immutable().Comment("创建时间（UNIX时间戳）").StructTag(`json:"created_at"`),
+	}
+}
+
+// Edges of the SchoolMajor.
+func (SchoolMajor) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("school", School.Type).Field("school_id").Unique().Required(),
+		edge.To("major", Major.Type).Field("major_id").Unique().Required(),
+	}
+}
+
+// Indexes of the SchoolMajor.
+func (SchoolMajor) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("school_id", "major_id").Unique().StorageKey("uk_school_major"),
+		index.Fields("school_id").StorageKey("idx_school_id"),
+		index.Fields("major_id").StorageKey("idx_major_id"),
+	}
+}
+
+func (SchoolMajor) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "school_major"},
+		schema.Comment("学校-专业关联表"),
+		entsql.WithComments(true),
+	}
+}
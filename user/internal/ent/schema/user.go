@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// 账号状态
+const (
+	UserStatusBanned int8 = 0 // 封禁
+	UserStatusNormal int8 = 1 // 正常
+	UserStatusLogoff int8 = 2 // 注销
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		// 雪花算法生成，不使用自增
+		field.Int64("id").Immutable().Unique().Comment("用户唯一ID（雪花算法生成）").StructTag(`json:"id"`).
+			Annotations(entsql.Annotation{Incremental: new(bool)}),
+		field.String("nickname").MaxLen(50).Optional().Comment("昵称").StructTag(`json:"nickname"`),
+		field.String("phone").MaxLen(20).Optional().Comment("手机号").StructTag(`json:"phone"`),
+		field.String("password").MaxLen(100).Optional().Sensitive().Comment("加密存储的密码（bcrypt算法）"),
+		field.Uint32("school_id").Optional().Comment("所属学校").StructTag(`json:"school_id"`),
+		field.Uint32("major_id").Optional().Comment("所属专业ID").StructTag(`json:"major_id"`),
+		field.Int32("admission_grade").Optional().Comment("入学年级（如2021、2022）").StructTag(`json:"admission_grade"`),
+		field.String("avatar_url").MaxLen(255).Optional().Default("default_avatar.png").Comment("头像URL").StructTag(`json:"avatar_url"`),
+		field.Int32("experience").Default(0).Comment("经验值").StructTag(`json:"experience"`),
+		field.Int8("status").Default(UserStatusNormal).Comment("账号状态（1-正常，0-封禁，2-注销）").StructTag(`json:"status"`),
+		field.Uint32("logoff_time").Optional().Default(0).Comment("注销时间").StructTag(`json:"logoff_time"`),
+		field.Uint32("banned_time").Optional().Default(0).Comment("封禁时间").StructTag(`json:"banned_time"`),
+		field.Uint32("unbanned_time").Optional().Default(0).Comment("解封时间").StructTag(`json:"unbanned_time"`),
+		field.Uint32("created_at").Optional().Immutable().Comment("注册时间（UNIX时间戳）").StructTag(`json:"created_at"`),
+		field.Uint32("updated_at").Optional().Comment("更新时间（UNIX时间戳）").StructTag(`json:"updated_at"`),
+	}
+}
+
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("school", School.Type).Ref("users").Field("school_id").Unique(),
+		edge.From("major", Major.Type).Ref("users").Field("major_id").Unique(),
+	}
+}
+
+// Indexes of the User.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		// 注销后 status、logoff_time 变化，同一手机号可以重新注册
+		index.Fields("phone", "status", "logoff_time").Unique().StorageKey("uk_phone_status_logoff_time"),
+		index.Fields("school_id").StorageKey("idx_school_id"),
+	}
+}
+
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "user"},
+		schema.Comment("用户表"),
+		entsql.WithComments(true),
+	}
+}
@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"user/internal/ent/school"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 学校表
+type School struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 学校ID
+	ID uint32 `json:"id"`
+	// 学校全称
+	Name string `json:"name"`
+	// 学校Logo图片URL
+	LogoURL string `json:"logo_url"`
+	// 状态（1：启用，0：未启用）
+	Status int8 `json:"status"`
+	// 创建时间（UNIX时间戳）
+	CreatedAt uint32 `json:"created_at"`
+	// 更新时间（UNIX时间戳）
+	UpdatedAt uint32 `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SchoolQuery when eager-loading is set.
+	Edges        SchoolEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SchoolEdges holds the relations/edges for other nodes in the graph.
+type SchoolEdges struct {
+	// Users holds the value of the users edge.
+	Users []*User `json:"users,omitempty"`
+	// Majors holds the value of the majors edge.
+	Majors []*Major `json:"majors,omitempty"`
+	// SchoolMajors holds the value of the school_majors edge.
+	SchoolMajors []*SchoolMajor `json:"school_majors,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UsersOrErr returns the Users value or an error if the edge
+// was not loaded in eager-loading.
+func (e SchoolEdges) UsersOrErr() ([]*User, error) {
+	if e.loadedTypes[0] {
+		return e.Users, nil
+	}
+	return nil, &NotLoadedError{edge: "users"}
+}
+
+// MajorsOrErr returns the Majors value or an error if the edge
+// was not loaded in eager-loading.
+func (e SchoolEdges) MajorsOrErr() ([]*Major, error) {
+	if e.loadedTypes[1] {
+		return e.Majors, nil
+	}
+	return nil, &NotLoadedError{edge: "majors"}
+}
+
+// SchoolMajorsOrErr returns the SchoolMajors value or an error if the edge
+// was not loaded in eager-loading.
+func (e SchoolEdges) SchoolMajorsOrErr() ([]*SchoolMajor, error) {
+	if e.loadedTypes[2] {
+		return e.SchoolMajors, nil
+	}
+	return nil, &NotLoadedError{edge: "school_majors"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*School) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case school.FieldID, school.FieldStatus, school.FieldCreatedAt, school.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case school.FieldName, school.FieldLogoURL:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the School fields.
+func (_m *School) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case school.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case school.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case school.FieldLogoURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field logo_url", values[i])
+			} else if value.Valid {
+				_m.LogoURL = value.String
+			}
+		case school.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = int8(value.Int64)
+			}
+		case school.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = uint32(value.Int64)
+			}
+		case school.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = uint32(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the School.
+// This includes values selected through modifiers, order, etc.
+func (_m *School) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUsers queries the "users" edge of the School entity.
+func (_m *School) QueryUsers() *UserQuery {
+	return NewSchoolClient(_m.config).QueryUsers(_m)
+}
+
+// QueryMajors queries the "majors" edge of the School entity.
+func (_m *School) QueryMajors() *MajorQuery {
+	return NewSchoolClient(_m.config).QueryMajors(_m)
+}
+
+// QuerySchoolMajors queries the "school_majors" edge of the School entity.
+func (_m *School) QuerySchoolMajors() *SchoolMajorQuery {
+	return NewSchoolClient(_m.config).QuerySchoolMajors(_m)
+}
+
+// Update returns a builder for updating this School.
+// Note that you need to call School.Unwrap() before calling this method if this School
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *School) Update() *SchoolUpdateOne {
+	return NewSchoolClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the School entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *School) Unwrap() *School {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: School is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *School) String() string {
+	var builder strings.Builder
+	builder.WriteString("School(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("logo_url=")
+	builder.WriteString(_m.LogoURL)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Schools is a parsable slice of School.
+type Schools []*School
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package school
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the school type in the database.
+	Label = "school"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldLogoURL holds the string denoting the logo_url field in the database.
+	FieldLogoURL = "logo_url"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUsers holds the string denoting the users edge name in mutations.
+	EdgeUsers = "users"
+	// EdgeMajors holds the string denoting the majors edge name in mutations.
+	EdgeMajors = "majors"
+	// EdgeSchoolMajors holds the string denoting the school_majors edge name in mutations.
+	EdgeSchoolMajors = "school_majors"
+	// Table holds the table name of the school in the database.
+	Table = "school"
+	// UsersTable is the table that holds the users relation/edge.
+	UsersTable = "user"
+	// UsersInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UsersInverseTable = "user"
+	// UsersColumn is the table column denoting the users relation/edge.
+	UsersColumn = "school_id"
+	// MajorsTable is the table that holds the majors relation/edge. The primary key declared below.
+	MajorsTable = "school_major"
+	// MajorsInverseTable is the table name for the Major entity.
+	// It exists in this package in order to avoid circular dependency with the "major" package.
+	MajorsInverseTable = "major"
+	// SchoolMajorsTable is the table that holds the school_majors relation/edge.
+	SchoolMajorsTable = "school_major"
+	// SchoolMajorsInverseTable is the table name for the SchoolMajor entity.
+	// It exists in this package in order to avoid circular dependency with the "schoolmajor" package.
+	SchoolMajorsInverseTable = "school_major"
+	// SchoolMajorsColumn is the table column denoting the school_majors relation/edge.
+	SchoolMajorsColumn = "school_id"
+)
+
+// Columns holds all SQL columns for school fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldLogoURL,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+var (
+	// MajorsPrimaryKey and MajorsColumn2 are the table columns denoting the
+	// primary key for the majors relation (M2M).
+	MajorsPrimaryKey = []string{"school_id", "major_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultLogoURL holds the default value on creation for the "logo_url" field.
+	DefaultLogoURL string
+	// LogoURLValidator is a validator for the "logo_url" field. It is called by the builders before save.
+	LogoURLValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus int8
+)
+
+// OrderOption defines the ordering options for the School queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByLogoURL orders the results by the logo_url field.
+func ByLogoURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogoURL, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUsersCount orders the results by users count.
+func ByUsersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUsersStep(), opts...)
+	}
+}
+
+// ByUsers orders the results by users terms.
+func ByUsers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUsersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMajorsCount orders the results by majors count.
+func ByMajorsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMajorsStep(), opts...)
+	}
+}
+
+// ByMajors orders the results by majors terms.
+func ByMajors(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMajorsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySchoolMajorsCount orders the results by school_majors count.
+func BySchoolMajorsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSchoolMajorsStep(), opts...)
+	}
+}
+
+// BySchoolMajors orders the results by school_majors terms.
+func BySchoolMajors(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSchoolMajorsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newUsersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UsersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UsersTable, UsersColumn),
+	)
+}
+func newMajorsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MajorsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, MajorsTable, MajorsPrimaryKey...),
+	)
+}
+func newSchoolMajorsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SchoolMajorsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SchoolMajorsTable, SchoolMajorsColumn),
+	)
+}
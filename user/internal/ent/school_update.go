@@ -0,0 +1,916 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"user/internal/ent/major"
+	"user/internal/ent/predicate"
+	"user/internal/ent/school"
+	"user/internal/ent/schoolmajor"
+	"user/internal/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SchoolUpdate is the builder for updating School entities.
+type SchoolUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SchoolMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SchoolUpdate builder.
+func (_u *SchoolUpdate) Where(ps ...predicate.School) *SchoolUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SchoolUpdate) SetName(v string) *SchoolUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SchoolUpdate) SetNillableName(v *string) *SchoolUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (_u *SchoolUpdate) SetLogoURL(v string) *SchoolUpdate {
+	_u.mutation.SetLogoURL(v)
+	return _u
+}
+
+// SetNillableLogoURL sets the "logo_url" field if the given value is not nil.
+func (_u *SchoolUpdate) SetNillableLogoURL(v *string) *SchoolUpdate {
+	if v != nil {
+		_u.SetLogoURL(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SchoolUpdate) SetStatus(v int8) *SchoolUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SchoolUpdate) SetNillableStatus(v *int8) *SchoolUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *SchoolUpdate) AddStatus(v int8) *SchoolUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SchoolUpdate) SetUpdatedAt(v uint32) *SchoolUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *SchoolUpdate) SetNillableUpdatedAt(v *uint32) *SchoolUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *SchoolUpdate) AddUpdatedAt(v int32) *SchoolUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *SchoolUpdate) ClearUpdatedAt() *SchoolUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (_u *SchoolUpdate) AddUserIDs(ids ...int64) *SchoolUpdate {
+	_u.mutation.AddUserIDs(ids...)
+	return _u
+}
+
+// AddUsers adds the "users" edges to the User entity.
+func (_u *SchoolUpdate) AddUsers(v ...*User) *SchoolUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUserIDs(ids...)
+}
+
+// AddMajorIDs adds the "majors" edge to the Major entity by IDs.
+func (_u *SchoolUpdate) AddMajorIDs(ids ...uint32) *SchoolUpdate {
+	_u.mutation.AddMajorIDs(ids...)
+	return _u
+}
+
+// AddMajors adds the "majors" edges to the Major entity.
+func (_u *SchoolUpdate) AddMajors(v ...*Major) *SchoolUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMajorIDs(ids...)
+}
+
+// AddSchoolMajorIDs adds the "school_majors" edge to the SchoolMajor entity by IDs.
+func (_u *SchoolUpdate) AddSchoolMajorIDs(ids ...uint32) *SchoolUpdate {
+	_u.mutation.AddSchoolMajorIDs(ids...)
+	return _u
+}
+
+// AddSchoolMajors adds the "school_majors" edges to the SchoolMajor entity.
+func (_u *SchoolUpdate) AddSchoolMajors(v ...*SchoolMajor) *SchoolUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSchoolMajorIDs(ids...)
+}
+
+// Mutation returns the SchoolMutation object of the builder.
+func (_u *SchoolUpdate) Mutation() *SchoolMutation {
+	return _u.mutation
+}
+
+// ClearUsers clears all "users" edges to the User entity.
+func (_u *SchoolUpdate) ClearUsers() *SchoolUpdate {
+	_u.mutation.ClearUsers()
+	return _u
+}
+
+// RemoveUserIDs removes the "users" edge to User entities by IDs.
+func (_u *SchoolUpdate) RemoveUserIDs(ids ...int64) *SchoolUpdate {
+	_u.mutation.RemoveUserIDs(ids...)
+	return _u
+}
+
+// RemoveUsers removes "users" edges to User entities.
+func (_u *SchoolUpdate) RemoveUsers(v ...*User) *SchoolUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserIDs(ids...)
+}
+
+// ClearMajors clears all "majors" edges to the Major entity.
+func (_u *SchoolUpdate) ClearMajors() *SchoolUpdate {
+	_u.mutation.ClearMajors()
+	return _u
+}
+
+// RemoveMajorIDs removes the "majors" edge to Major entities by IDs.
+func (_u *SchoolUpdate) RemoveMajorIDs(ids ...uint32) *SchoolUpdate {
+	_u.mutation.RemoveMajorIDs(ids...)
+	return _u
+}
+
+// RemoveMajors removes "majors" edges to Major entities.
+func (_u *SchoolUpdate) RemoveMajors(v ...*Major) *SchoolUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMajorIDs(ids...)
+}
+
+// ClearSchoolMajors clears all "school_majors" edges to the SchoolMajor entity.
+func (_u *SchoolUpdate) ClearSchoolMajors() *SchoolUpdate {
+	_u.mutation.ClearSchoolMajors()
+	return _u
+}
+
+// RemoveSchoolMajorIDs removes the "school_majors" edge to SchoolMajor entities by IDs.
+func (_u *SchoolUpdate) RemoveSchoolMajorIDs(ids ...uint32) *SchoolUpdate {
+	_u.mutation.RemoveSchoolMajorIDs(ids...)
+	return _u
+}
+
+// RemoveSchoolMajors removes "school_majors" edges to SchoolMajor entities.
+func (_u *SchoolUpdate) RemoveSchoolMajors(v ...*SchoolMajor) *SchoolUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSchoolMajorIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SchoolUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SchoolUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SchoolUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SchoolUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SchoolUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := school.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "School.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LogoURL(); ok {
+		if err := school.LogoURLValidator(v); err != nil {
+			return &ValidationError{Name: "logo_url", err: fmt.Errorf(`ent: validator failed for field "School.logo_url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SchoolUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SchoolUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SchoolUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(school.Table, school.Columns, sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(school.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LogoURL(); ok {
+		_spec.SetField(school.FieldLogoURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(school.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(school.FieldStatus, field.TypeInt8, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(school.FieldCreatedAt, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(school.FieldUpdatedAt, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(school.FieldUpdatedAt, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(school.FieldUpdatedAt, field.TypeUint32)
+	}
+	if _u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   school.UsersTable,
+			Columns: []string{school.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsersIDs(); len(nodes) > 0 && !_u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   school.UsersTable,
+			Columns: []string{school.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   school.UsersTable,
+			Columns: []string{school.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MajorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   school.MajorsTable,
+			Columns: school.MajorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMajorsIDs(); len(nodes) > 0 && !_u.mutation.MajorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   school.MajorsTable,
+			Columns: school.MajorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MajorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   school.MajorsTable,
+			Columns: school.MajorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SchoolMajorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   school.SchoolMajorsTable,
+			Columns: []string{school.SchoolMajorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSchoolMajorsIDs(); len(nodes) > 0 && !_u.mutation.SchoolMajorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   school.SchoolMajorsTable,
+			Columns: []string{school.SchoolMajorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SchoolMajorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   school.SchoolMajorsTable,
+			Columns: []string{school.SchoolMajorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{school.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SchoolUpdateOne is the builder for updating a single School entity.
+type SchoolUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SchoolMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *SchoolUpdateOne) SetName(v string) *SchoolUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SchoolUpdateOne) SetNillableName(v *string) *SchoolUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (_u *SchoolUpdateOne) SetLogoURL(v string) *SchoolUpdateOne {
+	_u.mutation.SetLogoURL(v)
+	return _u
+}
+
+// SetNillableLogoURL sets the "logo_url" field if the given value is not nil.
+func (_u *SchoolUpdateOne) SetNillableLogoURL(v *string) *SchoolUpdateOne {
+	if v != nil {
+		_u.SetLogoURL(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SchoolUpdateOne) SetStatus(v int8) *SchoolUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SchoolUpdateOne) SetNillableStatus(v *int8) *SchoolUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *SchoolUpdateOne) AddStatus(v int8) *SchoolUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SchoolUpdateOne) SetUpdatedAt(v uint32) *SchoolUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *SchoolUpdateOne) SetNillableUpdatedAt(v *uint32) *SchoolUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *SchoolUpdateOne) AddUpdatedAt(v int32) *SchoolUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *SchoolUpdateOne) ClearUpdatedAt() *SchoolUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (_u *SchoolUpdateOne) AddUserIDs(ids ...int64) *SchoolUpdateOne {
+	_u.mutation.AddUserIDs(ids...)
+	return _u
+}
+
+// AddUsers adds the "users" edges to the User entity.
+func (_u *SchoolUpdateOne) AddUsers(v ...*User) *SchoolUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUserIDs(ids...)
+}
+
+// AddMajorIDs adds the "majors" edge to the Major entity by IDs.
+func (_u *SchoolUpdateOne) AddMajorIDs(ids ...uint32) *SchoolUpdateOne {
+	_u.mutation.AddMajorIDs(ids...)
+	return _u
+}
+
+// AddMajors adds the "majors" edges to the Major entity.
+func (_u *SchoolUpdateOne) AddMajors(v ...*Major) *SchoolUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMajorIDs(ids...)
+}
+
+// AddSchoolMajorIDs adds the "school_majors" edge to the SchoolMajor entity by IDs.
+func (_u *SchoolUpdateOne) AddSchoolMajorIDs(ids ...uint32) *SchoolUpdateOne {
+	_u.mutation.AddSchoolMajorIDs(ids...)
+	return _u
+}
+
+// AddSchoolMajors adds the "school_majors" edges to the SchoolMajor entity.
+func (_u *SchoolUpdateOne) AddSchoolMajors(v ...*SchoolMajor) *SchoolUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSchoolMajorIDs(ids...)
+}
+
+// Mutation returns the SchoolMutation object of the builder.
+func (_u *SchoolUpdateOne) Mutation() *SchoolMutation {
+	return _u.mutation
+}
+
+// ClearUsers clears all "users" edges to the User entity.
+func (_u *SchoolUpdateOne) ClearUsers() *SchoolUpdateOne {
+	_u.mutation.ClearUsers()
+	return _u
+}
+
+// RemoveUserIDs removes the "users" edge to User entities by IDs.
+func (_u *SchoolUpdateOne) RemoveUserIDs(ids ...int64) *SchoolUpdateOne {
+	_u.mutation.RemoveUserIDs(ids...)
+	return _u
+}
+
+// RemoveUsers removes "users" edges to User entities.
+func (_u *SchoolUpdateOne) RemoveUsers(v ...*User) *SchoolUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserIDs(ids...)
+}
+
+// ClearMajors clears all "majors" edges to the Major entity.
+func (_u *SchoolUpdateOne) ClearMajors() *SchoolUpdateOne {
+	_u.mutation.ClearMajors()
+	return _u
+}
+
+// RemoveMajorIDs removes the "majors" edge to Major entities by IDs.
+func (_u *SchoolUpdateOne) RemoveMajorIDs(ids ...uint32) *SchoolUpdateOne {
+	_u.mutation.RemoveMajorIDs(ids...)
+	return _u
+}
+
+// RemoveMajors removes "majors" edges to Major entities.
+func (_u *SchoolUpdateOne) RemoveMajors(v ...*Major) *SchoolUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMajorIDs(ids...)
+}
+
+// ClearSchoolMajors clears all "school_majors" edges to the SchoolMajor entity.
+func (_u *SchoolUpdateOne) ClearSchoolMajors() *SchoolUpdateOne {
+	_u.mutation.ClearSchoolMajors()
+	return _u
+}
+
+// RemoveSchoolMajorIDs removes the "school_majors" edge to SchoolMajor entities by IDs.
+func (_u *SchoolUpdateOne) RemoveSchoolMajorIDs(ids ...uint32) *SchoolUpdateOne {
+	_u.mutation.RemoveSchoolMajorIDs(ids...)
+	return _u
+}
+
+// RemoveSchoolMajors removes "school_majors" edges to SchoolMajor entities.
+func (_u *SchoolUpdateOne) RemoveSchoolMajors(v ...*SchoolMajor) *SchoolUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSchoolMajorIDs(ids...)
+}
+
+// Where appends a list predicates to the SchoolUpdate builder.
+func (_u *SchoolUpdateOne) Where(ps ...predicate.School) *SchoolUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SchoolUpdateOne) Select(field string, fields ...string) *SchoolUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated School entity.
+func (_u *SchoolUpdateOne) Save(ctx context.Context) (*School, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SchoolUpdateOne) SaveX(ctx context.Context) *School {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SchoolUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SchoolUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SchoolUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := school.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "School.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LogoURL(); ok {
+		if err := school.LogoURLValidator(v); err != nil {
+			return &ValidationError{Name: "logo_url", err: fmt.Errorf(`ent: validator failed for field "School.logo_url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SchoolUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SchoolUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SchoolUpdateOne) sqlSave(ctx context.Context) (_node *School, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(school.Table, school.Columns, sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "School.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, school.FieldID)
+		for _, f := range fields {
+			if !school.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != school.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(school.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LogoURL(); ok {
+		_spec.SetField(school.FieldLogoURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(school.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(school.FieldStatus, field.TypeInt8, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(school.FieldCreatedAt, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(school.FieldUpdatedAt, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(school.FieldUpdatedAt, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(school.FieldUpdatedAt, field.TypeUint32)
+	}
+	if _u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   school.UsersTable,
+			Columns: []string{school.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsersIDs(); len(nodes) > 0 && !_u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   school.UsersTable,
+			Columns: []string{school.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   school.UsersTable,
+			Columns: []string{school.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MajorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   school.MajorsTable,
+			Columns: school.MajorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMajorsIDs(); len(nodes) > 0 && !_u.mutation.MajorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   school.MajorsTable,
+			Columns: school.MajorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MajorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   school.MajorsTable,
+			Columns: school.MajorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SchoolMajorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   school.SchoolMajorsTable,
+			Columns: []string{school.SchoolMajorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSchoolMajorsIDs(); len(nodes) > 0 && !_u.mutation.SchoolMajorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   school.SchoolMajorsTable,
+			Columns: []string{school.SchoolMajorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SchoolMajorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   school.SchoolMajorsTable,
+			Columns: []string{school.SchoolMajorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &School{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{school.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"user/internal/ent/major"
+	"user/internal/ent/school"
+	"user/internal/ent/schoolmajor"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 学校-专业关联表
+type SchoolMajor struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 关联ID
+	ID uint32 `json:"id"`
+	// 学校ID
+	SchoolID uint32 `json:"school_id"`
+	// 专业ID
+	MajorID uint32 `json:"major_id"`
+	// 创建时间（UNIX时间戳）
+	CreatedAt uint32 `json:"created_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SchoolMajorQuery when eager-loading is set.
+	Edges        SchoolMajorEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SchoolMajorEdges holds the relations/edges for other nodes in the graph.
+type SchoolMajorEdges struct {
+	// School holds the value of the school edge.
+	School *School `json:"school,omitempty"`
+	// Major holds the value of the major edge.
+	Major *Major `json:"major,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// SchoolOrErr returns the School value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SchoolMajorEdges) SchoolOrErr() (*School, error) {
+	if e.School != nil {
+		return e.School, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: school.Label}
+	}
+	return nil, &NotLoadedError{edge: "school"}
+}
+
+// MajorOrErr returns the Major value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SchoolMajorEdges) MajorOrErr() (*Major, error) {
+	if e.Major != nil {
+		return e.Major, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: major.Label}
+	}
+	return nil, &NotLoadedError{edge: "major"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SchoolMajor) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case schoolmajor.FieldID, schoolmajor.FieldSchoolID, schoolmajor.FieldMajorID, schoolmajor.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SchoolMajor fields.
+func (_m *SchoolMajor) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case schoolmajor.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case schoolmajor.FieldSchoolID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field school_id", values[i])
+			} else if value.Valid {
+				_m.SchoolID = uint32(value.Int64)
+			}
+		case schoolmajor.FieldMajorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field major_id", values[i])
+			} else if value.Valid {
+				_m.MajorID = uint32(value.Int64)
+			}
+		case schoolmajor.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = uint32(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SchoolMajor.
+// This includes values selected through modifiers, order, etc.
+func (_m *SchoolMajor) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySchool queries the "school" edge of the SchoolMajor entity.
+func (_m *SchoolMajor) QuerySchool() *SchoolQuery {
+	return NewSchoolMajorClient(_m.config).QuerySchool(_m)
+}
+
+// QueryMajor queries the "major" edge of the SchoolMajor entity.
+func (_m *SchoolMajor) QueryMajor() *MajorQuery {
+	return NewSchoolMajorClient(_m.config).QueryMajor(_m)
+}
+
+// Update returns a builder for updating this SchoolMajor.
+// Note that you need to call SchoolMajor.Unwrap() before calling this method if this SchoolMajor
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SchoolMajor) Update() *SchoolMajorUpdateOne {
+	return NewSchoolMajorClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SchoolMajor entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SchoolMajor) Unwrap() *SchoolMajor {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SchoolMajor is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SchoolMajor) String() string {
+	var builder strings.Builder
+	builder.WriteString("SchoolMajor(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("school_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SchoolID))
+	builder.WriteString(", ")
+	builder.WriteString("major_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MajorID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SchoolMajors is a parsable slice of SchoolMajor.
+type SchoolMajors []*SchoolMajor
@@ -0,0 +1,110 @@
+// Code generated by ent, DO NOT EDIT.
+
+package schoolmajor
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the schoolmajor type in the database.
+	Label = "school_major"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSchoolID holds the string denoting the school_id field in the database.
+	FieldSchoolID = "school_id"
+	// FieldMajorID holds the string denoting the major_id field in the database.
+	FieldMajorID = "major_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeSchool holds the string denoting the school edge name in mutations.
+	EdgeSchool = "school"
+	// EdgeMajor holds the string denoting the major edge name in mutations.
+	EdgeMajor = "major"
+	// Table holds the table name of the schoolmajor in the database.
+	Table = "school_major"
+	// SchoolTable is the table that holds the school relation/edge.
+	SchoolTable = "school_major"
+	// SchoolInverseTable is the table name for the School entity.
+	// It exists in this package in order to avoid circular dependency with the "school" package.
+	SchoolInverseTable = "school"
+	// SchoolColumn is the table column denoting the school relation/edge.
+	SchoolColumn = "school_id"
+	// MajorTable is the table that holds the major relation/edge.
+	MajorTable = "school_major"
+	// MajorInverseTable is the table name for the Major entity.
+	// It exists in this package in order to avoid circular dependency with the "major" package.
+	MajorInverseTable = "major"
+	// MajorColumn is the table column denoting the major relation/edge.
+	MajorColumn = "major_id"
+)
+
+// Columns holds all SQL columns for schoolmajor fields.
+var Columns = []string{
+	FieldID,
+	FieldSchoolID,
+	FieldMajorID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the SchoolMajor queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySchoolID orders the results by the school_id field.
+func BySchoolID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSchoolID, opts...).ToFunc()
+}
+
+// ByMajorID orders the results by the major_id field.
+func ByMajorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMajorID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// BySchoolField orders the results by school field.
+func BySchoolField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSchoolStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMajorField orders the results by major field.
+func ByMajorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMajorStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSchoolStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SchoolInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, SchoolTable, SchoolColumn),
+	)
+}
+func newMajorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MajorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, MajorTable, MajorColumn),
+	)
+}
@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package schoolmajor
+
+import (
+	"user/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldLTE(FieldID, id))
+}
+
+// SchoolID applies equality check predicate on the "school_id" field. It's identical to SchoolIDEQ.
+func SchoolID(v uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldEQ(FieldSchoolID, v))
+}
+
+// MajorID applies equality check predicate on the "major_id" field. It's identical to MajorIDEQ.
+func MajorID(v uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldEQ(FieldMajorID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SchoolIDEQ applies the EQ predicate on the "school_id" field.
+func SchoolIDEQ(v uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldEQ(FieldSchoolID, v))
+}
+
+// SchoolIDNEQ applies the NEQ predicate on the "school_id" field.
+func SchoolIDNEQ(v uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldNEQ(FieldSchoolID, v))
+}
+
+// SchoolIDIn applies the In predicate on the "school_id" field.
+func SchoolIDIn(vs ...uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldIn(FieldSchoolID, vs...))
+}
+
+// SchoolIDNotIn applies the NotIn predicate on the "school_id" field.
+func SchoolIDNotIn(vs ...uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldNotIn(FieldSchoolID, vs...))
+}
+
+// MajorIDEQ applies the EQ predicate on the "major_id" field.
+func MajorIDEQ(v uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldEQ(FieldMajorID, v))
+}
+
+// MajorIDNEQ applies the NEQ predicate on the "major_id" field.
+func MajorIDNEQ(v uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldNEQ(FieldMajorID, v))
+}
+
+// MajorIDIn applies the In predicate on the "major_id" field.
+func MajorIDIn(vs ...uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldIn(FieldMajorID, vs...))
+}
+
+// MajorIDNotIn applies the NotIn predicate on the "major_id" field.
+func MajorIDNotIn(vs ...uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldNotIn(FieldMajorID, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v uint32) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// HasSchool applies the HasEdge predicate on the "school" edge.
+func HasSchool() predicate.SchoolMajor {
+	return predicate.SchoolMajor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SchoolTable, SchoolColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSchoolWith applies the HasEdge predicate on the "school" edge with a given conditions (other predicates).
+func HasSchoolWith(preds ...predicate.School) predicate.SchoolMajor {
+	return predicate.SchoolMajor(func(s *sql.Selector) {
+		step := newSchoolStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMajor applies the HasEdge predicate on the "major" edge.
+func HasMajor() predicate.SchoolMajor {
+	return predicate.SchoolMajor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, MajorTable, MajorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMajorWith applies the HasEdge predicate on the "major" edge with a given conditions (other predicates).
+func HasMajorWith(preds ...predicate.Major) predicate.SchoolMajor {
+	return predicate.SchoolMajor(func(s *sql.Selector) {
+		step := newMajorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SchoolMajor) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SchoolMajor) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SchoolMajor) predicate.SchoolMajor {
+	return predicate.SchoolMajor(sql.NotPredicates(p))
+}
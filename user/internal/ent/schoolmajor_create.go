@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"user/internal/ent/major"
+	"user/internal/ent/school"
+	"user/internal/ent/schoolmajor"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SchoolMajorCreate is the builder for creating a SchoolMajor entity.
+type SchoolMajorCreate struct {
+	config
+	mutation *SchoolMajorMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSchoolID sets the "school_id" field.
+func (_c *SchoolMajorCreate) SetSchoolID(v uint32) *SchoolMajorCreate {
+	_c.mutation.SetSchoolID(v)
+	return _c
+}
+
+// SetMajorID sets the "major_id" field.
+func (_c *SchoolMajorCreate) SetMajorID(v uint32) *SchoolMajorCreate {
+	_c.mutation.SetMajorID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SchoolMajorCreate) SetCreatedAt(v uint32) *SchoolMajorCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SchoolMajorCreate) SetNillableCreatedAt(v *uint32) *SchoolMajorCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SchoolMajorCreate) SetID(v uint32) *SchoolMajorCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetSchool sets the "school" edge to the School entity.
+func (_c *SchoolMajorCreate) SetSchool(v *School) *SchoolMajorCreate {
+	return _c.SetSchoolID(v.ID)
+}
+
+// SetMajor sets the "major" edge to the Major entity.
+func (_c *SchoolMajorCreate) SetMajor(v *Major) *SchoolMajorCreate {
+	return _c.SetMajorID(v.ID)
+}
+
+// Mutation returns the SchoolMajorMutation object of the builder.
+func (_c *SchoolMajorCreate) Mutation() *SchoolMajorMutation {
+	return _c.mutation
+}
+
+// Save creates the SchoolMajor in the database.
+func (_c *SchoolMajorCreate) Save(ctx context.Context) (*SchoolMajor, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SchoolMajorCreate) SaveX(ctx context.Context) *SchoolMajor {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SchoolMajorCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SchoolMajorCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SchoolMajorCreate) check() error {
+	if _, ok := _c.mutation.SchoolID(); !ok {
+		return &ValidationError{Name: "school_id", err: errors.New(`ent: missing required field "SchoolMajor.school_id"`)}
+	}
+	if _, ok := _c.mutation.MajorID(); !ok {
+		return &ValidationError{Name: "major_id", err: errors.New(`ent: missing required field "SchoolMajor.major_id"`)}
+	}
+	if len(_c.mutation.SchoolIDs()) == 0 {
+		return &ValidationError{Name: "school", err: errors.New(`ent: missing required edge "SchoolMajor.school"`)}
+	}
+	if len(_c.mutation.MajorIDs()) == 0 {
+		return &ValidationError{Name: "major", err: errors.New(`ent: missing required edge "SchoolMajor.major"`)}
+	}
+	return nil
+}
+
+func (_c *SchoolMajorCreate) sqlSave(ctx context.Context) (*SchoolMajor, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SchoolMajorCreate) createSpec() (*SchoolMajor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SchoolMajor{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(schoolmajor.Table, sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(schoolmajor.FieldCreatedAt, field.TypeUint32, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.SchoolIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   schoolmajor.SchoolTable,
+			Columns: []string{schoolmajor.SchoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SchoolID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MajorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   schoolmajor.MajorTable,
+			Columns: []string{schoolmajor.MajorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MajorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SchoolMajor.Create().
+//		SetSchoolID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SchoolMajorUpsert) {
+//			SetSchoolID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SchoolMajorCreate) OnConflict(opts ...sql.ConflictOption) *SchoolMajorUpsertOne {
+	_c.conflict = opts
+	return &SchoolMajorUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SchoolMajor.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SchoolMajorCreate) OnConflictColumns(columns ...string) *SchoolMajorUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SchoolMajorUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SchoolMajorUpsertOne is the builder for "upsert"-ing
+	//  one SchoolMajor node.
+	SchoolMajorUpsertOne struct {
+		create *SchoolMajorCreate
+	}
+
+	// SchoolMajorUpsert is the "OnConflict" setter.
+	SchoolMajorUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSchoolID sets the "school_id" field.
+func (u *SchoolMajorUpsert) SetSchoolID(v uint32) *SchoolMajorUpsert {
+	u.Set(schoolmajor.FieldSchoolID, v)
+	return u
+}
+
+// UpdateSchoolID sets the "school_id" field to the value that was provided on create.
+func (u *SchoolMajorUpsert) UpdateSchoolID() *SchoolMajorUpsert {
+	u.SetExcluded(schoolmajor.FieldSchoolID)
+	return u
+}
+
+// SetMajorID sets the "major_id" field.
+func (u *SchoolMajorUpsert) SetMajorID(v uint32) *SchoolMajorUpsert {
+	u.Set(schoolmajor.FieldMajorID, v)
+	return u
+}
+
+// UpdateMajorID sets the "major_id" field to the value that was provided on create.
+func (u *SchoolMajorUpsert) UpdateMajorID() *SchoolMajorUpsert {
+	u.SetExcluded(schoolmajor.FieldMajorID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SchoolMajor.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(schoolmajor.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SchoolMajorUpsertOne) UpdateNewValues() *SchoolMajorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(schoolmajor.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(schoolmajor.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SchoolMajor.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SchoolMajorUpsertOne) Ignore() *SchoolMajorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SchoolMajorUpsertOne) DoNothing() *SchoolMajorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SchoolMajorCreate.OnConflict
+// documentation for more info.
+func (u *SchoolMajorUpsertOne) Update(set func(*SchoolMajorUpsert)) *SchoolMajorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SchoolMajorUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSchoolID sets the "school_id" field.
+func (u *SchoolMajorUpsertOne) SetSchoolID(v uint32) *SchoolMajorUpsertOne {
+	return u.Update(func(s *SchoolMajorUpsert) {
+		s.SetSchoolID(v)
+	})
+}
+
+// UpdateSchoolID sets the "school_id" field to the value that was provided on create.
+func (u *SchoolMajorUpsertOne) UpdateSchoolID() *SchoolMajorUpsertOne {
+	return u.Update(func(s *SchoolMajorUpsert) {
+		s.UpdateSchoolID()
+	})
+}
+
+// SetMajorID sets the "major_id" field.
+func (u *SchoolMajorUpsertOne) SetMajorID(v uint32) *SchoolMajorUpsertOne {
+	return u.Update(func(s *SchoolMajorUpsert) {
+		s.SetMajorID(v)
+	})
+}
+
+// UpdateMajorID sets the "major_id" field to the value that was provided on create.
+func (u *SchoolMajorUpsertOne) UpdateMajorID() *SchoolMajorUpsertOne {
+	return u.Update(func(s *SchoolMajorUpsert) {
+		s.UpdateMajorID()
+	})
+}
+
+// Exec executes the query.
+func (u *SchoolMajorUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SchoolMajorCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SchoolMajorUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SchoolMajorUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SchoolMajorUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SchoolMajorCreateBulk is the builder for creating many SchoolMajor entities in bulk.
+type SchoolMajorCreateBulk struct {
+	config
+	err      error
+	builders []*SchoolMajorCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SchoolMajor entities in the database.
+func (_c *SchoolMajorCreateBulk) Save(ctx context.Context) ([]*SchoolMajor, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SchoolMajor, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SchoolMajorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SchoolMajorCreateBulk) SaveX(ctx context.Context) []*SchoolMajor {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SchoolMajorCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SchoolMajorCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SchoolMajor.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SchoolMajorUpsert) {
+//			SetSchoolID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SchoolMajorCreateBulk) OnConflict(opts ...sql.ConflictOption) *SchoolMajorUpsertBulk {
+	_c.conflict = opts
+	return &SchoolMajorUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SchoolMajor.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SchoolMajorCreateBulk) OnConflictColumns(columns ...string) *SchoolMajorUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SchoolMajorUpsertBulk{
+		create: _c,
+	}
+}
+
+// SchoolMajorUpsertBulk is the builder for "upsert"-ing
+// a bulk of SchoolMajor nodes.
+type SchoolMajorUpsertBulk struct {
+	create *SchoolMajorCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SchoolMajor.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(schoolmajor.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SchoolMajorUpsertBulk) UpdateNewValues() *SchoolMajorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(schoolmajor.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(schoolmajor.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SchoolMajor.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SchoolMajorUpsertBulk) Ignore() *SchoolMajorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SchoolMajorUpsertBulk) DoNothing() *SchoolMajorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SchoolMajorCreateBulk.OnConflict
+// documentation for more info.
+func (u *SchoolMajorUpsertBulk) Update(set func(*SchoolMajorUpsert)) *SchoolMajorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SchoolMajorUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSchoolID sets the "school_id" field.
+func (u *SchoolMajorUpsertBulk) SetSchoolID(v uint32) *SchoolMajorUpsertBulk {
+	return u.Update(func(s *SchoolMajorUpsert) {
+		s.SetSchoolID(v)
+	})
+}
+
+// UpdateSchoolID sets the "school_id" field to the value that was provided on create.
+func (u *SchoolMajorUpsertBulk) UpdateSchoolID() *SchoolMajorUpsertBulk {
+	return u.Update(func(s *SchoolMajorUpsert) {
+		s.UpdateSchoolID()
+	})
+}
+
+// SetMajorID sets the "major_id" field.
+func (u *SchoolMajorUpsertBulk) SetMajorID(v uint32) *SchoolMajorUpsertBulk {
+	return u.Update(func(s *SchoolMajorUpsert) {
+		s.SetMajorID(v)
+	})
+}
+
+// UpdateMajorID sets the "major_id" field to the value that was provided on create.
+func (u *SchoolMajorUpsertBulk) UpdateMajorID() *SchoolMajorUpsertBulk {
+	return u.Update(func(s *SchoolMajorUpsert) {
+		s.UpdateMajorID()
+	})
+}
+
+// Exec executes the query.
+func (u *SchoolMajorUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SchoolMajorCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SchoolMajorCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SchoolMajorUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
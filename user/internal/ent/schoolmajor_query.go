@@ -0,0 +1,731 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"user/internal/ent/major"
+	"user/internal/ent/predicate"
+	"user/internal/ent/school"
+	"user/internal/ent/schoolmajor"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SchoolMajorQuery is the builder for querying SchoolMajor entities.
+type SchoolMajorQuery struct {
+	config
+	ctx        *QueryContext
+	order      []schoolmajor.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SchoolMajor
+	withSchool *SchoolQuery
+	withMajor  *MajorQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SchoolMajorQuery builder.
+func (_q *SchoolMajorQuery) Where(ps ...predicate.SchoolMajor) *SchoolMajorQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *SchoolMajorQuery) Limit(limit int) *SchoolMajorQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *SchoolMajorQuery) Offset(offset int) *SchoolMajorQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *SchoolMajorQuery) Unique(unique bool) *SchoolMajorQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *SchoolMajorQuery) Order(o ...schoolmajor.OrderOption) *SchoolMajorQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QuerySchool chains the current query on the "school" edge.
+func (_q *SchoolMajorQuery) QuerySchool() *SchoolQuery {
+	query := (&SchoolClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(schoolmajor.Table, schoolmajor.FieldID, selector),
+			sqlgraph.To(school.Table, school.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, schoolmajor.SchoolTable, schoolmajor.SchoolColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMajor chains the current query on the "major" edge.
+func (_q *SchoolMajorQuery) QueryMajor() *MajorQuery {
+	query := (&MajorClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(schoolmajor.Table, schoolmajor.FieldID, selector),
+			sqlgraph.To(major.Table, major.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, schoolmajor.MajorTable, schoolmajor.MajorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SchoolMajor entity from the query.
+// Returns a *NotFoundError when no SchoolMajor was found.
+func (_q *SchoolMajorQuery) First(ctx context.Context) (*SchoolMajor, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{schoolmajor.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *SchoolMajorQuery) FirstX(ctx context.Context) *SchoolMajor {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SchoolMajor ID from the query.
+// Returns a *NotFoundError when no SchoolMajor ID was found.
+func (_q *SchoolMajorQuery) FirstID(ctx context.Context) (id uint32, err error) {
+	var ids []uint32
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{schoolmajor.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *SchoolMajorQuery) FirstIDX(ctx context.Context) uint32 {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SchoolMajor entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SchoolMajor entity is found.
+// Returns a *NotFoundError when no SchoolMajor entities are found.
+func (_q *SchoolMajorQuery) Only(ctx context.Context) (*SchoolMajor, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{schoolmajor.Label}
+	default:
+		return nil, &NotSingularError{schoolmajor.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *SchoolMajorQuery) OnlyX(ctx context.Context) *SchoolMajor {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SchoolMajor ID in the query.
+// Returns a *NotSingularError when more than one SchoolMajor ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *SchoolMajorQuery) OnlyID(ctx context.Context) (id uint32, err error) {
+	var ids []uint32
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{schoolmajor.Label}
+	default:
+		err = &NotSingularError{schoolmajor.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *SchoolMajorQuery) OnlyIDX(ctx context.Context) uint32 {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SchoolMajors.
+func (_q *SchoolMajorQuery) All(ctx context.Context) ([]*SchoolMajor, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SchoolMajor, *SchoolMajorQuery]()
+	return withInterceptors[[]*SchoolMajor](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *SchoolMajorQuery) AllX(ctx context.Context) []*SchoolMajor {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SchoolMajor IDs.
+func (_q *SchoolMajorQuery) IDs(ctx context.Context) (ids []uint32, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(schoolmajor.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *SchoolMajorQuery) IDsX(ctx context.Context) []uint32 {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *SchoolMajorQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*SchoolMajorQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *SchoolMajorQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *SchoolMajorQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *SchoolMajorQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SchoolMajorQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *SchoolMajorQuery) Clone() *SchoolMajorQuery {
+	if _q == nil {
+		return nil
+	}
+	return &SchoolMajorQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]schoolmajor.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.SchoolMajor{}, _q.predicates...),
+		withSchool: _q.withSchool.Clone(),
+		withMajor:  _q.withMajor.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithSchool tells the query-builder to eager-load the nodes that are connected to
+// the "school" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SchoolMajorQuery) WithSchool(opts ...func(*SchoolQuery)) *SchoolMajorQuery {
+	query := (&SchoolClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSchool = query
+	return _q
+}
+
+// WithMajor tells the query-builder to eager-load the nodes that are connected to
+// the "major" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SchoolMajorQuery) WithMajor(opts ...func(*MajorQuery)) *SchoolMajorQuery {
+	query := (&MajorClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMajor = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SchoolID uint32 `json:"school_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SchoolMajor.Query().
+//		GroupBy(schoolmajor.FieldSchoolID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *SchoolMajorQuery) GroupBy(field string, fields ...string) *SchoolMajorGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SchoolMajorGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = schoolmajor.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SchoolID uint32 `json:"school_id"`
+//	}
+//
+//	client.SchoolMajor.Query().
+//		Select(schoolmajor.FieldSchoolID).
+//		Scan(ctx, &v)
+func (_q *SchoolMajorQuery) Select(fields ...string) *SchoolMajorSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &SchoolMajorSelect{SchoolMajorQuery: _q}
+	sbuild.label = schoolmajor.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SchoolMajorSelect configured with the given aggregations.
+func (_q *SchoolMajorQuery) Aggregate(fns ...AggregateFunc) *SchoolMajorSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *SchoolMajorQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !schoolmajor.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *SchoolMajorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SchoolMajor, error) {
+	var (
+		nodes       = []*SchoolMajor{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withSchool != nil,
+			_q.withMajor != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SchoolMajor).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SchoolMajor{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withSchool; query != nil {
+		if err := _q.loadSchool(ctx, query, nodes, nil,
+			func(n *SchoolMajor, e *School) { n.Edges.School = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMajor; query != nil {
+		if err := _q.loadMajor(ctx, query, nodes, nil,
+			func(n *SchoolMajor, e *Major) { n.Edges.Major = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *SchoolMajorQuery) loadSchool(ctx context.Context, query *SchoolQuery, nodes []*SchoolMajor, init func(*SchoolMajor), assign func(*SchoolMajor, *School)) error {
+	ids := make([]uint32, 0, len(nodes))
+	nodeids := make(map[uint32][]*SchoolMajor)
+	for i := range nodes {
+		fk := nodes[i].SchoolID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(school.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "school_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *SchoolMajorQuery) loadMajor(ctx context.Context, query *MajorQuery, nodes []*SchoolMajor, init func(*SchoolMajor), assign func(*SchoolMajor, *Major)) error {
+	ids := make([]uint32, 0, len(nodes))
+	nodeids := make(map[uint32][]*SchoolMajor)
+	for i := range nodes {
+		fk := nodes[i].MajorID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(major.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "major_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *SchoolMajorQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *SchoolMajorQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(schoolmajor.Table, schoolmajor.Columns, sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schoolmajor.FieldID)
+		for i := range fields {
+			if fields[i] != schoolmajor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withSchool != nil {
+			_spec.Node.AddColumnOnce(schoolmajor.FieldSchoolID)
+		}
+		if _q.withMajor != nil {
+			_spec.Node.AddColumnOnce(schoolmajor.FieldMajorID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *SchoolMajorQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(schoolmajor.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = schoolmajor.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *SchoolMajorQuery) ForUpdate(opts ...sql.LockOption) *SchoolMajorQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *SchoolMajorQuery) ForShare(opts ...sql.LockOption) *SchoolMajorQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *SchoolMajorQuery) Modify(modifiers ...func(s *sql.Selector)) *SchoolMajorSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// SchoolMajorGroupBy is the group-by builder for SchoolMajor entities.
+type SchoolMajorGroupBy struct {
+	selector
+	build *SchoolMajorQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *SchoolMajorGroupBy) Aggregate(fns ...AggregateFunc) *SchoolMajorGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *SchoolMajorGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SchoolMajorQuery, *SchoolMajorGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *SchoolMajorGroupBy) sqlScan(ctx context.Context, root *SchoolMajorQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SchoolMajorSelect is the builder for selecting fields of SchoolMajor entities.
+type SchoolMajorSelect struct {
+	*SchoolMajorQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *SchoolMajorSelect) Aggregate(fns ...AggregateFunc) *SchoolMajorSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *SchoolMajorSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SchoolMajorQuery, *SchoolMajorSelect](ctx, _s.SchoolMajorQuery, _s, _s.inters, v)
+}
+
+func (_s *SchoolMajorSelect) sqlScan(ctx context.Context, root *SchoolMajorQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *SchoolMajorSelect) Modify(modifiers ...func(s *sql.Selector)) *SchoolMajorSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
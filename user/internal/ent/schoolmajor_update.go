@@ -0,0 +1,443 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"user/internal/ent/major"
+	"user/internal/ent/predicate"
+	"user/internal/ent/school"
+	"user/internal/ent/schoolmajor"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SchoolMajorUpdate is the builder for updating SchoolMajor entities.
+type SchoolMajorUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SchoolMajorMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SchoolMajorUpdate builder.
+func (_u *SchoolMajorUpdate) Where(ps ...predicate.SchoolMajor) *SchoolMajorUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSchoolID sets the "school_id" field.
+func (_u *SchoolMajorUpdate) SetSchoolID(v uint32) *SchoolMajorUpdate {
+	_u.mutation.SetSchoolID(v)
+	return _u
+}
+
+// SetNillableSchoolID sets the "school_id" field if the given value is not nil.
+func (_u *SchoolMajorUpdate) SetNillableSchoolID(v *uint32) *SchoolMajorUpdate {
+	if v != nil {
+		_u.SetSchoolID(*v)
+	}
+	return _u
+}
+
+// SetMajorID sets the "major_id" field.
+func (_u *SchoolMajorUpdate) SetMajorID(v uint32) *SchoolMajorUpdate {
+	_u.mutation.SetMajorID(v)
+	return _u
+}
+
+// SetNillableMajorID sets the "major_id" field if the given value is not nil.
+func (_u *SchoolMajorUpdate) SetNillableMajorID(v *uint32) *SchoolMajorUpdate {
+	if v != nil {
+		_u.SetMajorID(*v)
+	}
+	return _u
+}
+
+// SetSchool sets the "school" edge to the School entity.
+func (_u *SchoolMajorUpdate) SetSchool(v *School) *SchoolMajorUpdate {
+	return _u.SetSchoolID(v.ID)
+}
+
+// SetMajor sets the "major" edge to the Major entity.
+func (_u *SchoolMajorUpdate) SetMajor(v *Major) *SchoolMajorUpdate {
+	return _u.SetMajorID(v.ID)
+}
+
+// Mutation returns the SchoolMajorMutation object of the builder.
+func (_u *SchoolMajorUpdate) Mutation() *SchoolMajorMutation {
+	return _u.mutation
+}
+
+// ClearSchool clears the "school" edge to the School entity.
+func (_u *SchoolMajorUpdate) ClearSchool() *SchoolMajorUpdate {
+	_u.mutation.ClearSchool()
+	return _u
+}
+
+// ClearMajor clears the "major" edge to the Major entity.
+func (_u *SchoolMajorUpdate) ClearMajor() *SchoolMajorUpdate {
+	_u.mutation.ClearMajor()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SchoolMajorUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SchoolMajorUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SchoolMajorUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SchoolMajorUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SchoolMajorUpdate) check() error {
+	if _u.mutation.SchoolCleared() && len(_u.mutation.SchoolIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SchoolMajor.school"`)
+	}
+	if _u.mutation.MajorCleared() && len(_u.mutation.MajorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SchoolMajor.major"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SchoolMajorUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SchoolMajorUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SchoolMajorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schoolmajor.Table, schoolmajor.Columns, sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(schoolmajor.FieldCreatedAt, field.TypeUint32)
+	}
+	if _u.mutation.SchoolCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   schoolmajor.SchoolTable,
+			Columns: []string{schoolmajor.SchoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SchoolIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   schoolmajor.SchoolTable,
+			Columns: []string{schoolmajor.SchoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MajorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   schoolmajor.MajorTable,
+			Columns: []string{schoolmajor.MajorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MajorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   schoolmajor.MajorTable,
+			Columns: []string{schoolmajor.MajorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schoolmajor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SchoolMajorUpdateOne is the builder for updating a single SchoolMajor entity.
+type SchoolMajorUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SchoolMajorMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetSchoolID sets the "school_id" field.
+func (_u *SchoolMajorUpdateOne) SetSchoolID(v uint32) *SchoolMajorUpdateOne {
+	_u.mutation.SetSchoolID(v)
+	return _u
+}
+
+// SetNillableSchoolID sets the "school_id" field if the given value is not nil.
+func (_u *SchoolMajorUpdateOne) SetNillableSchoolID(v *uint32) *SchoolMajorUpdateOne {
+	if v != nil {
+		_u.SetSchoolID(*v)
+	}
+	return _u
+}
+
+// SetMajorID sets the "major_id" field.
+func (_u *SchoolMajorUpdateOne) SetMajorID(v uint32) *SchoolMajorUpdateOne {
+	_u.mutation.SetMajorID(v)
+	return _u
+}
+
+// SetNillableMajorID sets the "major_id" field if the given value is not nil.
+func (_u *SchoolMajorUpdateOne) SetNillableMajorID(v *uint32) *SchoolMajorUpdateOne {
+	if v != nil {
+		_u.SetMajorID(*v)
+	}
+	return _u
+}
+
+// SetSchool sets the "school" edge to the School entity.
+func (_u *SchoolMajorUpdateOne) SetSchool(v *School) *SchoolMajorUpdateOne {
+	return _u.SetSchoolID(v.ID)
+}
+
+// SetMajor sets the "major" edge to the Major entity.
+func (_u *SchoolMajorUpdateOne) SetMajor(v *Major) *SchoolMajorUpdateOne {
+	return _u.SetMajorID(v.ID)
+}
+
+// Mutation returns the SchoolMajorMutation object of the builder.
+func (_u *SchoolMajorUpdateOne) Mutation() *SchoolMajorMutation {
+	return _u.mutation
+}
+
+// ClearSchool clears the "school" edge to the School entity.
+func (_u *SchoolMajorUpdateOne) ClearSchool() *SchoolMajorUpdateOne {
+	_u.mutation.ClearSchool()
+	return _u
+}
+
+// ClearMajor clears the "major" edge to the Major entity.
+func (_u *SchoolMajorUpdateOne) ClearMajor() *SchoolMajorUpdateOne {
+	_u.mutation.ClearMajor()
+	return _u
+}
+
+// Where appends a list predicates to the SchoolMajorUpdate builder.
+func (_u *SchoolMajorUpdateOne) Where(ps ...predicate.SchoolMajor) *SchoolMajorUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SchoolMajorUpdateOne) Select(field string, fields ...string) *SchoolMajorUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SchoolMajor entity.
+func (_u *SchoolMajorUpdateOne) Save(ctx context.Context) (*SchoolMajor, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SchoolMajorUpdateOne) SaveX(ctx context.Context) *SchoolMajor {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SchoolMajorUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SchoolMajorUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SchoolMajorUpdateOne) check() error {
+	if _u.mutation.SchoolCleared() && len(_u.mutation.SchoolIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SchoolMajor.school"`)
+	}
+	if _u.mutation.MajorCleared() && len(_u.mutation.MajorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SchoolMajor.major"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SchoolMajorUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SchoolMajorUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SchoolMajorUpdateOne) sqlSave(ctx context.Context) (_node *SchoolMajor, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schoolmajor.Table, schoolmajor.Columns, sqlgraph.NewFieldSpec(schoolmajor.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SchoolMajor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schoolmajor.FieldID)
+		for _, f := range fields {
+			if !schoolmajor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != schoolmajor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(schoolmajor.FieldCreatedAt, field.TypeUint32)
+	}
+	if _u.mutation.SchoolCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   schoolmajor.SchoolTable,
+			Columns: []string{schoolmajor.SchoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SchoolIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   schoolmajor.SchoolTable,
+			Columns: []string{schoolmajor.SchoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MajorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   schoolmajor.MajorTable,
+			Columns: []string{schoolmajor.MajorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MajorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   schoolmajor.MajorTable,
+			Columns: []string{schoolmajor.MajorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SchoolMajor{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schoolmajor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
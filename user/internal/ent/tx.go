@@ -14,6 +14,12 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Major is the client for interacting with the Major builders.
+	Major *MajorClient
+	// School is the client for interacting with the School builders.
+	School *SchoolClient
+	// SchoolMajor is the client for interacting with the SchoolMajor builders.
+	SchoolMajor *SchoolMajorClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -147,6 +153,9 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Major = NewMajorClient(tx.config)
+	tx.School = NewSchoolClient(tx.config)
+	tx.SchoolMajor = NewSchoolMajorClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -157,7 +166,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: User.QueryXXX(), the query will be executed
+// applies a query, for example: Major.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
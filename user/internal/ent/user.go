@@ -5,30 +5,96 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"user/internal/ent/major"
+	"user/internal/ent/school"
 	"user/internal/ent/user"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 )
 
-// User is the model entity for the User schema.
+// 用户表
 type User struct {
 	config `json:"-"`
 	// ID of the ent.
-	// ID
+	// 用户唯一ID（雪花算法生成）
 	ID int64 `json:"id"`
-	// 用户名称
-	NickName     int64 `json:"nick_name"`
+	// 昵称
+	Nickname string `json:"nickname"`
+	// 手机号
+	Phone string `json:"phone"`
+	// 加密存储的密码（bcrypt算法）
+	Password string `json:"-"`
+	// 所属学校
+	SchoolID uint32 `json:"school_id"`
+	// 所属专业ID
+	MajorID uint32 `json:"major_id"`
+	// 入学年级（如2021、2022）
+	AdmissionGrade int32 `json:"admission_grade"`
+	// 头像URL
+	AvatarURL string `json:"avatar_url"`
+	// 经验值
+	Experience int32 `json:"experience"`
+	// 账号状态（1-正常，0-封禁，2-注销）
+	Status int8 `json:"status"`
+	// 注销时间
+	LogoffTime uint32 `json:"logoff_time"`
+	// 封禁时间
+	BannedTime uint32 `json:"banned_time"`
+	// 解封时间
+	UnbannedTime uint32 `json:"unbanned_time"`
+	// 注册时间（UNIX时间戳）
+	CreatedAt uint32 `json:"created_at"`
+	// 更新时间（UNIX时间戳）
+	UpdatedAt uint32 `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges        UserEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// School holds the value of the school edge.
+	School *School `json:"school,omitempty"`
+	// Major holds the value of the major edge.
+	Major *Major `json:"major,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// SchoolOrErr returns the School value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) SchoolOrErr() (*School, error) {
+	if e.School != nil {
+		return e.School, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: school.Label}
+	}
+	return nil, &NotLoadedError{edge: "school"}
+}
+
+// MajorOrErr returns the Major value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) MajorOrErr() (*Major, error) {
+	if e.Major != nil {
+		return e.Major, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: major.Label}
+	}
+	return nil, &NotLoadedError{edge: "major"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID, user.FieldNickName:
+		case user.FieldID, user.FieldSchoolID, user.FieldMajorID, user.FieldAdmissionGrade, user.FieldExperience, user.FieldStatus, user.FieldLogoffTime, user.FieldBannedTime, user.FieldUnbannedTime, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullInt64)
+		case user.FieldNickname, user.FieldPhone, user.FieldPassword, user.FieldAvatarURL:
+			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -50,11 +116,89 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int64(value.Int64)
-		case user.FieldNickName:
+		case user.FieldNickname:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nickname", values[i])
+			} else if value.Valid {
+				_m.Nickname = value.String
+			}
+		case user.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				_m.Phone = value.String
+			}
+		case user.FieldPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password", values[i])
+			} else if value.Valid {
+				_m.Password = value.String
+			}
+		case user.FieldSchoolID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field school_id", values[i])
+			} else if value.Valid {
+				_m.SchoolID = uint32(value.Int64)
+			}
+		case user.FieldMajorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field major_id", values[i])
+			} else if value.Valid {
+				_m.MajorID = uint32(value.Int64)
+			}
+		case user.FieldAdmissionGrade:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for field nick_name", values[i])
+				return fmt.Errorf("unexpected type %T for field admission_grade", values[i])
+			} else if value.Valid {
+				_m.AdmissionGrade = int32(value.Int64)
+			}
+		case user.FieldAvatarURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar_url", values[i])
 			} else if value.Valid {
-				_m.NickName = value.Int64
+				_m.AvatarURL = value.String
+			}
+		case user.FieldExperience:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field experience", values[i])
+			} else if value.Valid {
+				_m.Experience = int32(value.Int64)
+			}
+		case user.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = int8(value.Int64)
+			}
+		case user.FieldLogoffTime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field logoff_time", values[i])
+			} else if value.Valid {
+				_m.LogoffTime = uint32(value.Int64)
+			}
+		case user.FieldBannedTime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field banned_time", values[i])
+			} else if value.Valid {
+				_m.BannedTime = uint32(value.Int64)
+			}
+		case user.FieldUnbannedTime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unbanned_time", values[i])
+			} else if value.Valid {
+				_m.UnbannedTime = uint32(value.Int64)
+			}
+		case user.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = uint32(value.Int64)
+			}
+		case user.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = uint32(value.Int64)
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -69,6 +213,16 @@ func (_m *User) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QuerySchool queries the "school" edge of the User entity.
+func (_m *User) QuerySchool() *SchoolQuery {
+	return NewUserClient(_m.config).QuerySchool(_m)
+}
+
+// QueryMajor queries the "major" edge of the User entity.
+func (_m *User) QueryMajor() *MajorQuery {
+	return NewUserClient(_m.config).QueryMajor(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -92,8 +246,46 @@ func (_m *User) String() string {
 	var builder strings.Builder
 	builder.WriteString("User(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
-	builder.WriteString("nick_name=")
-	builder.WriteString(fmt.Sprintf("%v", _m.NickName))
+	builder.WriteString("nickname=")
+	builder.WriteString(_m.Nickname)
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(_m.Phone)
+	builder.WriteString(", ")
+	builder.WriteString("password=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("school_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SchoolID))
+	builder.WriteString(", ")
+	builder.WriteString("major_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MajorID))
+	builder.WriteString(", ")
+	builder.WriteString("admission_grade=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AdmissionGrade))
+	builder.WriteString(", ")
+	builder.WriteString("avatar_url=")
+	builder.WriteString(_m.AvatarURL)
+	builder.WriteString(", ")
+	builder.WriteString("experience=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Experience))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("logoff_time=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LogoffTime))
+	builder.WriteString(", ")
+	builder.WriteString("banned_time=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BannedTime))
+	builder.WriteString(", ")
+	builder.WriteString("unbanned_time=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnbannedTime))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedAt))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -4,6 +4,7 @@ package user
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -11,16 +12,73 @@ const (
 	Label = "user"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
-	// FieldNickName holds the string denoting the nick_name field in the database.
-	FieldNickName = "nick_name"
+	// FieldNickname holds the string denoting the nickname field in the database.
+	FieldNickname = "nickname"
+	// FieldPhone holds the string denoting the phone field in the database.
+	FieldPhone = "phone"
+	// FieldPassword holds the string denoting the password field in the database.
+	FieldPassword = "password"
+	// FieldSchoolID holds the string denoting the school_id field in the database.
+	FieldSchoolID = "school_id"
+	// FieldMajorID holds the string denoting the major_id field in the database.
+	FieldMajorID = "major_id"
+	// FieldAdmissionGrade holds the string denoting the admission_grade field in the database.
+	FieldAdmissionGrade = "admission_grade"
+	// FieldAvatarURL holds the string denoting the avatar_url field in the database.
+	FieldAvatarURL = "avatar_url"
+	// FieldExperience holds the string denoting the experience field in the database.
+	FieldExperience = "experience"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldLogoffTime holds the string denoting the logoff_time field in the database.
+	FieldLogoffTime = "logoff_time"
+	// FieldBannedTime holds the string denoting the banned_time field in the database.
+	FieldBannedTime = "banned_time"
+	// FieldUnbannedTime holds the string denoting the unbanned_time field in the database.
+	FieldUnbannedTime = "unbanned_time"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeSchool holds the string denoting the school edge name in mutations.
+	EdgeSchool = "school"
+	// EdgeMajor holds the string denoting the major edge name in mutations.
+	EdgeMajor = "major"
 	// Table holds the table name of the user in the database.
-	Table = "User"
+	Table = "user"
+	// SchoolTable is the table that holds the school relation/edge.
+	SchoolTable = "user"
+	// SchoolInverseTable is the table name for the School entity.
+	// It exists in this package in order to avoid circular dependency with the "school" package.
+	SchoolInverseTable = "school"
+	// SchoolColumn is the table column denoting the school relation/edge.
+	SchoolColumn = "school_id"
+	// MajorTable is the table that holds the major relation/edge.
+	MajorTable = "user"
+	// MajorInverseTable is the table name for the Major entity.
+	// It exists in this package in order to avoid circular dependency with the "major" package.
+	MajorInverseTable = "major"
+	// MajorColumn is the table column denoting the major relation/edge.
+	MajorColumn = "major_id"
 )
 
 // Columns holds all SQL columns for user fields.
 var Columns = []string{
 	FieldID,
-	FieldNickName,
+	FieldNickname,
+	FieldPhone,
+	FieldPassword,
+	FieldSchoolID,
+	FieldMajorID,
+	FieldAdmissionGrade,
+	FieldAvatarURL,
+	FieldExperience,
+	FieldStatus,
+	FieldLogoffTime,
+	FieldBannedTime,
+	FieldUnbannedTime,
+	FieldCreatedAt,
+	FieldUpdatedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -33,6 +91,29 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// NicknameValidator is a validator for the "nickname" field. It is called by the builders before save.
+	NicknameValidator func(string) error
+	// PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	PhoneValidator func(string) error
+	// PasswordValidator is a validator for the "password" field. It is called by the builders before save.
+	PasswordValidator func(string) error
+	// DefaultAvatarURL holds the default value on creation for the "avatar_url" field.
+	DefaultAvatarURL string
+	// AvatarURLValidator is a validator for the "avatar_url" field. It is called by the builders before save.
+	AvatarURLValidator func(string) error
+	// DefaultExperience holds the default value on creation for the "experience" field.
+	DefaultExperience int32
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus int8
+	// DefaultLogoffTime holds the default value on creation for the "logoff_time" field.
+	DefaultLogoffTime uint32
+	// DefaultBannedTime holds the default value on creation for the "banned_time" field.
+	DefaultBannedTime uint32
+	// DefaultUnbannedTime holds the default value on creation for the "unbanned_time" field.
+	DefaultUnbannedTime uint32
+)
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -41,7 +122,100 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
-// ByNickName orders the results by the nick_name field.
-func ByNickName(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldNickName, opts...).ToFunc()
+// ByNickname orders the results by the nickname field.
+func ByNickname(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNickname, opts...).ToFunc()
+}
+
+// ByPhone orders the results by the phone field.
+func ByPhone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhone, opts...).ToFunc()
+}
+
+// ByPassword orders the results by the password field.
+func ByPassword(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPassword, opts...).ToFunc()
+}
+
+// BySchoolID orders the results by the school_id field.
+func BySchoolID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSchoolID, opts...).ToFunc()
+}
+
+// ByMajorID orders the results by the major_id field.
+func ByMajorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMajorID, opts...).ToFunc()
+}
+
+// ByAdmissionGrade orders the results by the admission_grade field.
+func ByAdmissionGrade(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdmissionGrade, opts...).ToFunc()
+}
+
+// ByAvatarURL orders the results by the avatar_url field.
+func ByAvatarURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvatarURL, opts...).ToFunc()
+}
+
+// ByExperience orders the results by the experience field.
+func ByExperience(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExperience, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByLogoffTime orders the results by the logoff_time field.
+func ByLogoffTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogoffTime, opts...).ToFunc()
+}
+
+// ByBannedTime orders the results by the banned_time field.
+func ByBannedTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBannedTime, opts...).ToFunc()
+}
+
+// ByUnbannedTime orders the results by the unbanned_time field.
+func ByUnbannedTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnbannedTime, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySchoolField orders the results by school field.
+func BySchoolField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSchoolStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMajorField orders the results by major field.
+func ByMajorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMajorStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSchoolStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SchoolInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SchoolTable, SchoolColumn),
+	)
+}
+func newMajorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MajorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MajorTable, MajorColumn),
+	)
 }
@@ -6,6 +6,7 @@ import (
 	"user/internal/ent/predicate"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
@@ -53,49 +54,860 @@ func IDLTE(id int64) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldID, id))
 }
 
-// NickName applies equality check predicate on the "nick_name" field. It's identical to NickNameEQ.
-func NickName(v int64) predicate.User {
-	return predicate.User(sql.FieldEQ(FieldNickName, v))
+// Nickname applies equality check predicate on the "nickname" field. It's identical to NicknameEQ.
+func Nickname(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldNickname, v))
 }
 
-// NickNameEQ applies the EQ predicate on the "nick_name" field.
-func NickNameEQ(v int64) predicate.User {
-	return predicate.User(sql.FieldEQ(FieldNickName, v))
+// Phone applies equality check predicate on the "phone" field. It's identical to PhoneEQ.
+func Phone(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPhone, v))
 }
 
-// NickNameNEQ applies the NEQ predicate on the "nick_name" field.
-func NickNameNEQ(v int64) predicate.User {
-	return predicate.User(sql.FieldNEQ(FieldNickName, v))
+// Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
+func Password(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPassword, v))
 }
 
-// NickNameIn applies the In predicate on the "nick_name" field.
-func NickNameIn(vs ...int64) predicate.User {
-	return predicate.User(sql.FieldIn(FieldNickName, vs...))
+// SchoolID applies equality check predicate on the "school_id" field. It's identical to SchoolIDEQ.
+func SchoolID(v uint32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSchoolID, v))
 }
 
-// NickNameNotIn applies the NotIn predicate on the "nick_name" field.
-func NickNameNotIn(vs ...int64) predicate.User {
-	return predicate.User(sql.FieldNotIn(FieldNickName, vs...))
+// MajorID applies equality check predicate on the "major_id" field. It's identical to MajorIDEQ.
+func MajorID(v uint32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMajorID, v))
 }
 
-// NickNameGT applies the GT predicate on the "nick_name" field.
-func NickNameGT(v int64) predicate.User {
-	return predicate.User(sql.FieldGT(FieldNickName, v))
+// AdmissionGrade applies equality check predicate on the "admission_grade" field. It's identical to AdmissionGradeEQ.
+func AdmissionGrade(v int32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAdmissionGrade, v))
 }
 
-// NickNameGTE applies the GTE predicate on the "nick_name" field.
-func NickNameGTE(v int64) predicate.User {
-	return predicate.User(sql.FieldGTE(FieldNickName, v))
+// AvatarURL applies equality check predicate on the "avatar_url" field. It's identical to AvatarURLEQ.
+func AvatarURL(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAvatarURL, v))
 }
 
-// NickNameLT applies the LT predicate on the "nick_name" field.
-func NickNameLT(v int64) predicate.User {
-	return predicate.User(sql.FieldLT(FieldNickName, v))
+// Experience applies equality check predicate on the "experience" field. It's identical to ExperienceEQ.
+func Experience(v int32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldExperience, v))
 }
 
-// NickNameLTE applies the LTE predicate on the "nick_name" field.
-func NickNameLTE(v int64) predicate.User {
-	return predicate.User(sql.FieldLTE(FieldNickName, v))
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v int8) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStatus, v))
+}
+
+// LogoffTime applies equality check predicate on the "logoff_time" field. It's identical to LogoffTimeEQ.
+func LogoffTime(v uint32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLogoffTime, v))
+}
+
+// BannedTime applies equality check predicate on the "banned_time" field. It's identical to BannedTimeEQ.
+func BannedTime(v uint32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBannedTime, v))
+}
+
+// UnbannedTime applies equality check predicate on the "unbanned_time" field. It's identical to UnbannedTimeEQ.
+func UnbannedTime(v uint32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUnbannedTime, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v uint32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v uint32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NicknameEQ applies the EQ predicate on the "nickname" field.
+func NicknameEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldNickname, v))
+}
+
+// NicknameNEQ applies the NEQ predicate on the "nickname" field.
+func NicknameNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldNickname, v))
+}
+
+// NicknameIn applies the In predicate on the "nickname" field.
+func NicknameIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldNickname, vs...))
+}
+
+// NicknameNotIn applies the NotIn predicate on the "nickname" field.
+func NicknameNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldNickname, vs...))
+}
+
+// NicknameGT applies the GT predicate on the "nickname" field.
+func NicknameGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldNickname, v))
+}
+
+// NicknameGTE applies the GTE predicate on the "nickname" field.
+func NicknameGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldNickname, v))
+}
+
+// NicknameLT applies the LT predicate on the "nickname" field.
+func NicknameLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldNickname, v))
+}
+
+// NicknameLTE applies the LTE predicate on the "nickname" field.
+func NicknameLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldNickname, v))
+}
+
+// NicknameContains applies the Contains predicate on the "nickname" field.
+func NicknameContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldNickname, v))
+}
+
+// NicknameHasPrefix applies the HasPrefix predicate on the "nickname" field.
+func NicknameHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldNickname, v))
+}
+
+// NicknameHasSuffix applies the HasSuffix predicate on the "nickname" field.
+func NicknameHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldNickname, v))
+}
+
+// NicknameIsNil applies the IsNil predicate on the "nickname" field.
+func NicknameIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldNickname))
+}
+
+// NicknameNotNil applies the NotNil predicate on the "nickname" field.
+func NicknameNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldNickname))
+}
+
+// NicknameEqualFold applies the EqualFold predicate on the "nickname" field.
+func NicknameEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldNickname, v))
+}
+
+// NicknameContainsFold applies the ContainsFold predicate on the "nickname" field.
+func NicknameContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldNickname, v))
+}
+
+// PhoneEQ applies the EQ predicate on the "phone" field.
+func PhoneEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPhone, v))
+}
+
+// PhoneNEQ applies the NEQ predicate on the "phone" field.
+func PhoneNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPhone, v))
+}
+
+// PhoneIn applies the In predicate on the "phone" field.
+func PhoneIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPhone, vs...))
+}
+
+// PhoneNotIn applies the NotIn predicate on the "phone" field.
+func PhoneNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPhone, vs...))
+}
+
+// PhoneGT applies the GT predicate on the "phone" field.
+func PhoneGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPhone, v))
+}
+
+// PhoneGTE applies the GTE predicate on the "phone" field.
+func PhoneGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPhone, v))
+}
+
+// PhoneLT applies the LT predicate on the "phone" field.
+func PhoneLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPhone, v))
+}
+
+// PhoneLTE applies the LTE predicate on the "phone" field.
+func PhoneLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPhone, v))
+}
+
+// PhoneContains applies the Contains predicate on the "phone" field.
+func PhoneContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldPhone, v))
+}
+
+// PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
+func PhoneHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldPhone, v))
+}
+
+// PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
+func PhoneHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldPhone, v))
+}
+
+// PhoneIsNil applies the IsNil predicate on the "phone" field.
+func PhoneIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPhone))
+}
+
+// PhoneNotNil applies the NotNil predicate on the "phone" field.
+func PhoneNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPhone))
+}
+
+// PhoneEqualFold applies the EqualFold predicate on the "phone" field.
+func PhoneEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldPhone, v))
+}
+
+// PhoneContainsFold applies the ContainsFold predicate on the "phone" field.
+func PhoneContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldPhone, v))
+}
+
+// PasswordEQ applies the EQ predicate on the "password" field.
+func PasswordEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPassword, v))
+}
+
+// PasswordNEQ applies the NEQ predicate on the "password" field.
+func PasswordNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPassword, v))
+}
+
+// PasswordIn applies the In predicate on the "password" field.
+func PasswordIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPassword, vs...))
+}
+
+// PasswordNotIn applies the NotIn predicate on the "password" field.
+func PasswordNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPassword, vs...))
+}
+
+// PasswordGT applies the GT predicate on the "password" field.
+func PasswordGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPassword, v))
+}
+
+// PasswordGTE applies the GTE predicate on the "password" field.
+func PasswordGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPassword, v))
+}
+
+// PasswordLT applies the LT predicate on the "password" field.
+func PasswordLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPassword, v))
+}
+
+// PasswordLTE applies the LTE predicate on the "password" field.
+func PasswordLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPassword, v))
+}
+
+// PasswordContains applies the Contains predicate on the "password" field.
+func PasswordContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldPassword, v))
+}
+
+// PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
+func PasswordHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldPassword, v))
+}
+
+// PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
+func PasswordHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldPassword, v))
+}
+
+// PasswordIsNil applies the IsNil predicate on the "password" field.
+func PasswordIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPassword))
+}
+
+// PasswordNotNil applies the NotNil predicate on the "password" field.
+func PasswordNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPassword))
+}
+
+// PasswordEqualFold applies the EqualFold predicate on the "password" field.
+func PasswordEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldPassword, v))
+}
+
+// PasswordContainsFold applies the ContainsFold predicate on the "password" field.
+func PasswordContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldPassword, v))
+}
+
+// SchoolIDEQ applies the EQ predicate on the "school_id" field.
+func SchoolIDEQ(v uint32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSchoolID, v))
+}
+
+// SchoolIDNEQ applies the NEQ predicate on the "school_id" field.
+func SchoolIDNEQ(v uint32) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldSchoolID, v))
+}
+
+// SchoolIDIn applies the In predicate on the "school_id" field.
+func SchoolIDIn(vs ...uint32) predicate.User {
+	return predicate.User(sql.FieldIn(FieldSchoolID, vs...))
+}
+
+// SchoolIDNotIn applies the NotIn predicate on the "school_id" field.
+func SchoolIDNotIn(vs ...uint32) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldSchoolID, vs...))
+}
+
+// SchoolIDIsNil applies the IsNil predicate on the "school_id" field.
+func SchoolIDIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldSchoolID))
+}
+
+// SchoolIDNotNil applies the NotNil predicate on the "school_id" field.
+func SchoolIDNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldSchoolID))
+}
+
+// MajorIDEQ applies the EQ predicate on the "major_id" field.
+func MajorIDEQ(v uint32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMajorID, v))
+}
+
+// MajorIDNEQ applies the NEQ predicate on the "major_id" field.
+func MajorIDNEQ(v uint32) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMajorID, v))
+}
+
+// MajorIDIn applies the In predicate on the "major_id" field.
+func MajorIDIn(vs ...uint32) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMajorID, vs...))
+}
+
+// MajorIDNotIn applies the NotIn predicate on the "major_id" field.
+func MajorIDNotIn(vs ...uint32) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMajorID, vs...))
+}
+
+// MajorIDIsNil applies the IsNil predicate on the "major_id" field.
+func MajorIDIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldMajorID))
+}
+
+// MajorIDNotNil applies the NotNil predicate on the "major_id" field.
+func MajorIDNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldMajorID))
+}
+
+// AdmissionGradeEQ applies the EQ predicate on the "admission_grade" field.
+func AdmissionGradeEQ(v int32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAdmissionGrade, v))
+}
+
+// AdmissionGradeNEQ applies the NEQ predicate on the "admission_grade" field.
+func AdmissionGradeNEQ(v int32) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldAdmissionGrade, v))
+}
+
+// AdmissionGradeIn applies the In predicate on the "admission_grade" field.
+func AdmissionGradeIn(vs ...int32) predicate.User {
+	return predicate.User(sql.FieldIn(FieldAdmissionGrade, vs...))
+}
+
+// AdmissionGradeNotIn applies the NotIn predicate on the "admission_grade" field.
+func AdmissionGradeNotIn(vs ...int32) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldAdmissionGrade, vs...))
+}
+
+// AdmissionGradeGT applies the GT predicate on the "admission_grade" field.
+func AdmissionGradeGT(v int32) predicate.User {
+	return predicate.User(sql.FieldGT(FieldAdmissionGrade, v))
+}
+
+// AdmissionGradeGTE applies the GTE predicate on the "admission_grade" field.
+func AdmissionGradeGTE(v int32) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldAdmissionGrade, v))
+}
+
+// AdmissionGradeLT applies the LT predicate on the "admission_grade" field.
+func AdmissionGradeLT(v int32) predicate.User {
+	return predicate.User(sql.FieldLT(FieldAdmissionGrade, v))
+}
+
+// AdmissionGradeLTE applies the LTE predicate on the "admission_grade" field.
+func AdmissionGradeLTE(v int32) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldAdmissionGrade, v))
+}
+
+// AdmissionGradeIsNil applies the IsNil predicate on the "admission_grade" field.
+func AdmissionGradeIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldAdmissionGrade))
+}
+
+// AdmissionGradeNotNil applies the NotNil predicate on the "admission_grade" field.
+func AdmissionGradeNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldAdmissionGrade))
+}
+
+// AvatarURLEQ applies the EQ predicate on the "avatar_url" field.
+func AvatarURLEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAvatarURL, v))
+}
+
+// AvatarURLNEQ applies the NEQ predicate on the "avatar_url" field.
+func AvatarURLNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldAvatarURL, v))
+}
+
+// AvatarURLIn applies the In predicate on the "avatar_url" field.
+func AvatarURLIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldAvatarURL, vs...))
+}
+
+// AvatarURLNotIn applies the NotIn predicate on the "avatar_url" field.
+func AvatarURLNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldAvatarURL, vs...))
+}
+
+// AvatarURLGT applies the GT predicate on the "avatar_url" field.
+func AvatarURLGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldAvatarURL, v))
+}
+
+// AvatarURLGTE applies the GTE predicate on the "avatar_url" field.
+func AvatarURLGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldAvatarURL, v))
+}
+
+// AvatarURLLT applies the LT predicate on the "avatar_url" field.
+func AvatarURLLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldAvatarURL, v))
+}
+
+// AvatarURLLTE applies the LTE predicate on the "avatar_url" field.
+func AvatarURLLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldAvatarURL, v))
+}
+
+// AvatarURLContains applies the Contains predicate on the "avatar_url" field.
+func AvatarURLContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldAvatarURL, v))
+}
+
+// AvatarURLHasPrefix applies the HasPrefix predicate on the "avatar_url" field.
+func AvatarURLHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldAvatarURL, v))
+}
+
+// AvatarURLHasSuffix applies the HasSuffix predicate on the "avatar_url" field.
+func AvatarURLHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldAvatarURL, v))
+}
+
+// AvatarURLIsNil applies the IsNil predicate on the "avatar_url" field.
+func AvatarURLIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldAvatarURL))
+}
+
+// AvatarURLNotNil applies the NotNil predicate on the "avatar_url" field.
+func AvatarURLNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldAvatarURL))
+}
+
+// AvatarURLEqualFold applies the EqualFold predicate on the "avatar_url" field.
+func AvatarURLEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldAvatarURL, v))
+}
+
+// AvatarURLContainsFold applies the ContainsFold predicate on the "avatar_url" field.
+func AvatarURLContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldAvatarURL, v))
+}
+
+// ExperienceEQ applies the EQ predicate on the "experience" field.
+func ExperienceEQ(v int32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldExperience, v))
+}
+
+// ExperienceNEQ applies the NEQ predicate on the "experience" field.
+func ExperienceNEQ(v int32) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldExperience, v))
+}
+
+// ExperienceIn applies the In predicate on the "experience" field.
+func ExperienceIn(vs ...int32) predicate.User {
+	return predicate.User(sql.FieldIn(FieldExperience, vs...))
+}
+
+// ExperienceNotIn applies the NotIn predicate on the "experience" field.
+func ExperienceNotIn(vs ...int32) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldExperience, vs...))
+}
+
+// ExperienceGT applies the GT predicate on the "experience" field.
+func ExperienceGT(v int32) predicate.User {
+	return predicate.User(sql.FieldGT(FieldExperience, v))
+}
+
+// ExperienceGTE applies the GTE predicate on the "experience" field.
+func ExperienceGTE(v int32) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldExperience, v))
+}
+
+// ExperienceLT applies the LT predicate on the "experience" field.
+func ExperienceLT(v int32) predicate.User {
+	return predicate.User(sql.FieldLT(FieldExperience, v))
+}
+
+// ExperienceLTE applies the LTE predicate on the "experience" field.
+func ExperienceLTE(v int32) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldExperience, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v int8) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v int8) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...int8) predicate.User {
+	return predicate.User(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...int8) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v int8) predicate.User {
+	return predicate.User(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v int8) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v int8) predicate.User {
+	return predicate.User(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v int8) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldStatus, v))
+}
+
+// LogoffTimeEQ applies the EQ predicate on the "logoff_time" field.
+func LogoffTimeEQ(v uint32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLogoffTime, v))
+}
+
+// LogoffTimeNEQ applies the NEQ predicate on the "logoff_time" field.
+func LogoffTimeNEQ(v uint32) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldLogoffTime, v))
+}
+
+// LogoffTimeIn applies the In predicate on the "logoff_time" field.
+func LogoffTimeIn(vs ...uint32) predicate.User {
+	return predicate.User(sql.FieldIn(FieldLogoffTime, vs...))
+}
+
+// LogoffTimeNotIn applies the NotIn predicate on the "logoff_time" field.
+func LogoffTimeNotIn(vs ...uint32) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldLogoffTime, vs...))
+}
+
+// LogoffTimeGT applies the GT predicate on the "logoff_time" field.
+func LogoffTimeGT(v uint32) predicate.User {
+	return predicate.User(sql.FieldGT(FieldLogoffTime, v))
+}
+
+// LogoffTimeGTE applies the GTE predicate on the "logoff_time" field.
+func LogoffTimeGTE(v uint32) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldLogoffTime, v))
+}
+
+// LogoffTimeLT applies the LT predicate on the "logoff_time" field.
+func LogoffTimeLT(v uint32) predicate.User {
+	return predicate.User(sql.FieldLT(FieldLogoffTime, v))
+}
+
+// LogoffTimeLTE applies the LTE predicate on the "logoff_time" field.
+func LogoffTimeLTE(v uint32) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldLogoffTime, v))
+}
+
+// LogoffTimeIsNil applies the IsNil predicate on the "logoff_time" field.
+func LogoffTimeIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldLogoffTime))
+}
+
+// LogoffTimeNotNil applies the NotNil predicate on the "logoff_time" field.
+func LogoffTimeNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldLogoffTime))
+}
+
+// BannedTimeEQ applies the EQ predicate on the "banned_time" field.
+func BannedTimeEQ(v uint32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBannedTime, v))
+}
+
+// BannedTimeNEQ applies the NEQ predicate on the "banned_time" field.
+func BannedTimeNEQ(v uint32) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldBannedTime, v))
+}
+
+// BannedTimeIn applies the In predicate on the "banned_time" field.
+func BannedTimeIn(vs ...uint32) predicate.User {
+	return predicate.User(sql.FieldIn(FieldBannedTime, vs...))
+}
+
+// BannedTimeNotIn applies the NotIn predicate on the "banned_time" field.
+func BannedTimeNotIn(vs ...uint32) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldBannedTime, vs...))
+}
+
+// BannedTimeGT applies the GT predicate on the "banned_time" field.
+func BannedTimeGT(v uint32) predicate.User {
+	return predicate.User(sql.FieldGT(FieldBannedTime, v))
+}
+
+// BannedTimeGTE applies the GTE predicate on the "banned_time" field.
+func BannedTimeGTE(v uint32) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldBannedTime, v))
+}
+
+// BannedTimeLT applies the LT predicate on the "banned_time" field.
+func BannedTimeLT(v uint32) predicate.User {
+	return predicate.User(sql.FieldLT(FieldBannedTime, v))
+}
+
+// BannedTimeLTE applies the LTE predicate on the "banned_time" field.
+func BannedTimeLTE(v uint32) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldBannedTime, v))
+}
+
+// BannedTimeIsNil applies the IsNil predicate on the "banned_time" field.
+func BannedTimeIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldBannedTime))
+}
+
+// BannedTimeNotNil applies the NotNil predicate on the "banned_time" field.
+func BannedTimeNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldBannedTime))
+}
+
+// UnbannedTimeEQ applies the EQ predicate on the "unbanned_time" field.
+func UnbannedTimeEQ(v uint32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUnbannedTime, v))
+}
+
+// UnbannedTimeNEQ applies the NEQ predicate on the "unbanned_time" field.
+func UnbannedTimeNEQ(v uint32) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldUnbannedTime, v))
+}
+
+// UnbannedTimeIn applies the In predicate on the "unbanned_time" field.
+func UnbannedTimeIn(vs ...uint32) predicate.User {
+	return predicate.User(sql.FieldIn(FieldUnbannedTime, vs...))
+}
+
+// UnbannedTimeNotIn applies the NotIn predicate on the "unbanned_time" field.
+func UnbannedTimeNotIn(vs ...uint32) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldUnbannedTime, vs...))
+}
+
+// UnbannedTimeGT applies the GT predicate on the "unbanned_time" field.
+func UnbannedTimeGT(v uint32) predicate.User {
+	return predicate.User(sql.FieldGT(FieldUnbannedTime, v))
+}
+
+// UnbannedTimeGTE applies the GTE predicate on the "unbanned_time" field.
+func UnbannedTimeGTE(v uint32) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldUnbannedTime, v))
+}
+
+// UnbannedTimeLT applies the LT predicate on the "unbanned_time" field.
+func UnbannedTimeLT(v uint32) predicate.User {
+	return predicate.User(sql.FieldLT(FieldUnbannedTime, v))
+}
+
+// UnbannedTimeLTE applies the LTE predicate on the "unbanned_time" field.
+func UnbannedTimeLTE(v uint32) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldUnbannedTime, v))
+}
+
+// UnbannedTimeIsNil applies the IsNil predicate on the "unbanned_time" field.
+func UnbannedTimeIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldUnbannedTime))
+}
+
+// UnbannedTimeNotNil applies the NotNil predicate on the "unbanned_time" field.
+func UnbannedTimeNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldUnbannedTime))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v uint32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v uint32) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...uint32) predicate.User {
+	return predicate.User(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...uint32) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v uint32) predicate.User {
+	return predicate.User(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v uint32) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v uint32) predicate.User {
+	return predicate.User(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v uint32) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v uint32) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v uint32) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...uint32) predicate.User {
+	return predicate.User(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...uint32) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v uint32) predicate.User {
+	return predicate.User(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v uint32) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v uint32) predicate.User {
+	return predicate.User(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v uint32) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// HasSchool applies the HasEdge predicate on the "school" edge.
+func HasSchool() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SchoolTable, SchoolColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSchoolWith applies the HasEdge predicate on the "school" edge with a given conditions (other predicates).
+func HasSchoolWith(preds ...predicate.School) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSchoolStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMajor applies the HasEdge predicate on the "major" edge.
+func HasMajor() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MajorTable, MajorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMajorWith applies the HasEdge predicate on the "major" edge with a given conditions (other predicates).
+func HasMajorWith(preds ...predicate.Major) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMajorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
 }
 
 // And groups predicates with the AND operator between them.
@@ -6,6 +6,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"user/internal/ent/major"
+	"user/internal/ent/school"
 	"user/internal/ent/user"
 
 	"entgo.io/ent/dialect/sql"
@@ -21,9 +23,199 @@ type UserCreate struct {
 	conflict []sql.ConflictOption
 }
 
-// SetNickName sets the "nick_name" field.
-func (_c *UserCreate) SetNickName(v int64) *UserCreate {
-	_c.mutation.SetNickName(v)
+// SetNickname sets the "nickname" field.
+func (_c *UserCreate) SetNickname(v string) *UserCreate {
+	_c.mutation.SetNickname(v)
+	return _c
+}
+
+// SetNillableNickname sets the "nickname" field if the given value is not nil.
+func (_c *UserCreate) SetNillableNickname(v *string) *UserCreate {
+	if v != nil {
+		_c.SetNickname(*v)
+	}
+	return _c
+}
+
+// SetPhone sets the "phone" field.
+func (_c *UserCreate) SetPhone(v string) *UserCreate {
+	_c.mutation.SetPhone(v)
+	return _c
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_c *UserCreate) SetNillablePhone(v *string) *UserCreate {
+	if v != nil {
+		_c.SetPhone(*v)
+	}
+	return _c
+}
+
+// SetPassword sets the "password" field.
+func (_c *UserCreate) SetPassword(v string) *UserCreate {
+	_c.mutation.SetPassword(v)
+	return _c
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (_c *UserCreate) SetNillablePassword(v *string) *UserCreate {
+	if v != nil {
+		_c.SetPassword(*v)
+	}
+	return _c
+}
+
+// SetSchoolID sets the "school_id" field.
+func (_c *UserCreate) SetSchoolID(v uint32) *UserCreate {
+	_c.mutation.SetSchoolID(v)
+	return _c
+}
+
+// SetNillableSchoolID sets the "school_id" field if the given value is not nil.
+func (_c *UserCreate) SetNillableSchoolID(v *uint32) *UserCreate {
+	if v != nil {
+		_c.SetSchoolID(*v)
+	}
+	return _c
+}
+
+// SetMajorID sets the "major_id" field.
+func (_c *UserCreate) SetMajorID(v uint32) *UserCreate {
+	_c.mutation.SetMajorID(v)
+	return _c
+}
+
+// SetNillableMajorID sets the "major_id" field if the given value is not nil.
+func (_c *UserCreate) SetNillableMajorID(v *uint32) *UserCreate {
+	if v != nil {
+		_c.SetMajorID(*v)
+	}
+	return _c
+}
+
+// SetAdmissionGrade sets the "admission_grade" field.
+func (_c *UserCreate) SetAdmissionGrade(v int32) *UserCreate {
+	_c.mutation.SetAdmissionGrade(v)
+	return _c
+}
+
+// SetNillableAdmissionGrade sets the "admission_grade" field if the given value is not nil.
+func (_c *UserCreate) SetNillableAdmissionGrade(v *int32) *UserCreate {
+	if v != nil {
+		_c.SetAdmissionGrade(*v)
+	}
+	return _c
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (_c *UserCreate) SetAvatarURL(v string) *UserCreate {
+	_c.mutation.SetAvatarURL(v)
+	return _c
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (_c *UserCreate) SetNillableAvatarURL(v *string) *UserCreate {
+	if v != nil {
+		_c.SetAvatarURL(*v)
+	}
+	return _c
+}
+
+// SetExperience sets the "experience" field.
+func (_c *UserCreate) SetExperience(v int32) *UserCreate {
+	_c.mutation.SetExperience(v)
+	return _c
+}
+
+// SetNillableExperience sets the "experience" field if the given value is not nil.
+func (_c *UserCreate) SetNillableExperience(v *int32) *UserCreate {
+	if v != nil {
+		_c.SetExperience(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *UserCreate) SetStatus(v int8) *UserCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *UserCreate) SetNillableStatus(v *int8) *UserCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetLogoffTime sets the "logoff_time" field.
+func (_c *UserCreate) SetLogoffTime(v uint32) *UserCreate {
+	_c.mutation.SetLogoffTime(v)
+	return _c
+}
+
+// SetNillableLogoffTime sets the "logoff_time" field if the given value is not nil.
+func (_c *UserCreate) SetNillableLogoffTime(v *uint32) *UserCreate {
+	if v != nil {
+		_c.SetLogoffTime(*v)
+	}
+	return _c
+}
+
+// SetBannedTime sets the "banned_time" field.
+func (_c *UserCreate) SetBannedTime(v uint32) *UserCreate {
+	_c.mutation.SetBannedTime(v)
+	return _c
+}
+
+// SetNillableBannedTime sets the "banned_time" field if the given value is not nil.
+func (_c *UserCreate) SetNillableBannedTime(v *uint32) *UserCreate {
+	if v != nil {
+		_c.SetBannedTime(*v)
+	}
+	return _c
+}
+
+// SetUnbannedTime sets the "unbanned_time" field.
+func (_c *UserCreate) SetUnbannedTime(v uint32) *UserCreate {
+	_c.mutation.SetUnbannedTime(v)
+	return _c
+}
+
+// SetNillableUnbannedTime sets the "unbanned_time" field if the given value is not nil.
+func (_c *UserCreate) SetNillableUnbannedTime(v *uint32) *UserCreate {
+	if v != nil {
+		_c.SetUnbannedTime(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserCreate) SetCreatedAt(v uint32) *UserCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableCreatedAt(v *uint32) *UserCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UserCreate) SetUpdatedAt(v uint32) *UserCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableUpdatedAt(v *uint32) *UserCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
 	return _c
 }
 
@@ -33,6 +225,16 @@ func (_c *UserCreate) SetID(v int64) *UserCreate {
 	return _c
 }
 
+// SetSchool sets the "school" edge to the School entity.
+func (_c *UserCreate) SetSchool(v *School) *UserCreate {
+	return _c.SetSchoolID(v.ID)
+}
+
+// SetMajor sets the "major" edge to the Major entity.
+func (_c *UserCreate) SetMajor(v *Major) *UserCreate {
+	return _c.SetMajorID(v.ID)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -40,6 +242,7 @@ func (_c *UserCreate) Mutation() *UserMutation {
 
 // Save creates the User in the database.
 func (_c *UserCreate) Save(ctx context.Context) (*User, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -65,10 +268,61 @@ func (_c *UserCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *UserCreate) defaults() {
+	if _, ok := _c.mutation.AvatarURL(); !ok {
+		v := user.DefaultAvatarURL
+		_c.mutation.SetAvatarURL(v)
+	}
+	if _, ok := _c.mutation.Experience(); !ok {
+		v := user.DefaultExperience
+		_c.mutation.SetExperience(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := user.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.LogoffTime(); !ok {
+		v := user.DefaultLogoffTime
+		_c.mutation.SetLogoffTime(v)
+	}
+	if _, ok := _c.mutation.BannedTime(); !ok {
+		v := user.DefaultBannedTime
+		_c.mutation.SetBannedTime(v)
+	}
+	if _, ok := _c.mutation.UnbannedTime(); !ok {
+		v := user.DefaultUnbannedTime
+		_c.mutation.SetUnbannedTime(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *UserCreate) check() error {
-	if _, ok := _c.mutation.NickName(); !ok {
-		return &ValidationError{Name: "nick_name", err: errors.New(`ent: missing required field "User.nick_name"`)}
+	if v, ok := _c.mutation.Nickname(); ok {
+		if err := user.NicknameValidator(v); err != nil {
+			return &ValidationError{Name: "nickname", err: fmt.Errorf(`ent: validator failed for field "User.nickname": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Phone(); ok {
+		if err := user.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "User.phone": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Password(); ok {
+		if err := user.PasswordValidator(v); err != nil {
+			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AvatarURL(); ok {
+		if err := user.AvatarURLValidator(v); err != nil {
+			return &ValidationError{Name: "avatar_url", err: fmt.Errorf(`ent: validator failed for field "User.avatar_url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Experience(); !ok {
+		return &ValidationError{Name: "experience", err: errors.New(`ent: missing required field "User.experience"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "User.status"`)}
 	}
 	return nil
 }
@@ -103,9 +357,87 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
-	if value, ok := _c.mutation.NickName(); ok {
-		_spec.SetField(user.FieldNickName, field.TypeInt64, value)
-		_node.NickName = value
+	if value, ok := _c.mutation.Nickname(); ok {
+		_spec.SetField(user.FieldNickname, field.TypeString, value)
+		_node.Nickname = value
+	}
+	if value, ok := _c.mutation.Phone(); ok {
+		_spec.SetField(user.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := _c.mutation.Password(); ok {
+		_spec.SetField(user.FieldPassword, field.TypeString, value)
+		_node.Password = value
+	}
+	if value, ok := _c.mutation.AdmissionGrade(); ok {
+		_spec.SetField(user.FieldAdmissionGrade, field.TypeInt32, value)
+		_node.AdmissionGrade = value
+	}
+	if value, ok := _c.mutation.AvatarURL(); ok {
+		_spec.SetField(user.FieldAvatarURL, field.TypeString, value)
+		_node.AvatarURL = value
+	}
+	if value, ok := _c.mutation.Experience(); ok {
+		_spec.SetField(user.FieldExperience, field.TypeInt32, value)
+		_node.Experience = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(user.FieldStatus, field.TypeInt8, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.LogoffTime(); ok {
+		_spec.SetField(user.FieldLogoffTime, field.TypeUint32, value)
+		_node.LogoffTime = value
+	}
+	if value, ok := _c.mutation.BannedTime(); ok {
+		_spec.SetField(user.FieldBannedTime, field.TypeUint32, value)
+		_node.BannedTime = value
+	}
+	if value, ok := _c.mutation.UnbannedTime(); ok {
+		_spec.SetField(user.FieldUnbannedTime, field.TypeUint32, value)
+		_node.UnbannedTime = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(user.FieldCreatedAt, field.TypeUint32, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeUint32, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.SchoolIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.SchoolTable,
+			Columns: []string{user.SchoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SchoolID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MajorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.MajorTable,
+			Columns: []string{user.MajorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MajorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
@@ -114,7 +446,7 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.User.Create().
-//		SetNickName(v).
+//		SetNickname(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -123,7 +455,7 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.UserUpsert) {
-//			SetNickName(v+v).
+//			SetNickname(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *UserCreate) OnConflict(opts ...sql.ConflictOption) *UserUpsertOne {
@@ -133,116 +465,652 @@ func (_c *UserCreate) OnConflict(opts ...sql.ConflictOption) *UserUpsertOne {
 	}
 }
 
-// OnConflictColumns calls `OnConflict` and configures the columns
-// as conflict target. Using this option is equivalent to using:
-//
-//	client.User.Create().
-//		OnConflict(sql.ConflictColumns(columns...)).
-//		Exec(ctx)
-func (_c *UserCreate) OnConflictColumns(columns ...string) *UserUpsertOne {
-	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
-	return &UserUpsertOne{
-		create: _c,
-	}
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserCreate) OnConflictColumns(columns ...string) *UserUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UserUpsertOne is the builder for "upsert"-ing
+	//  one User node.
+	UserUpsertOne struct {
+		create *UserCreate
+	}
+
+	// UserUpsert is the "OnConflict" setter.
+	UserUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetNickname sets the "nickname" field.
+func (u *UserUpsert) SetNickname(v string) *UserUpsert {
+	u.Set(user.FieldNickname, v)
+	return u
+}
+
+// UpdateNickname sets the "nickname" field to the value that was provided on create.
+func (u *UserUpsert) UpdateNickname() *UserUpsert {
+	u.SetExcluded(user.FieldNickname)
+	return u
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (u *UserUpsert) ClearNickname() *UserUpsert {
+	u.SetNull(user.FieldNickname)
+	return u
+}
+
+// SetPhone sets the "phone" field.
+func (u *UserUpsert) SetPhone(v string) *UserUpsert {
+	u.Set(user.FieldPhone, v)
+	return u
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePhone() *UserUpsert {
+	u.SetExcluded(user.FieldPhone)
+	return u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *UserUpsert) ClearPhone() *UserUpsert {
+	u.SetNull(user.FieldPhone)
+	return u
+}
+
+// SetPassword sets the "password" field.
+func (u *UserUpsert) SetPassword(v string) *UserUpsert {
+	u.Set(user.FieldPassword, v)
+	return u
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePassword() *UserUpsert {
+	u.SetExcluded(user.FieldPassword)
+	return u
+}
+
+// ClearPassword clears the value of the "password" field.
+func (u *UserUpsert) ClearPassword() *UserUpsert {
+	u.SetNull(user.FieldPassword)
+	return u
+}
+
+// SetSchoolID sets the "school_id" field.
+func (u *UserUpsert) SetSchoolID(v uint32) *UserUpsert {
+	u.Set(user.FieldSchoolID, v)
+	return u
+}
+
+// UpdateSchoolID sets the "school_id" field to the value that was provided on create.
+func (u *UserUpsert) UpdateSchoolID() *UserUpsert {
+	u.SetExcluded(user.FieldSchoolID)
+	return u
+}
+
+// ClearSchoolID clears the value of the "school_id" field.
+func (u *UserUpsert) ClearSchoolID() *UserUpsert {
+	u.SetNull(user.FieldSchoolID)
+	return u
+}
+
+// SetMajorID sets the "major_id" field.
+func (u *UserUpsert) SetMajorID(v uint32) *UserUpsert {
+	u.Set(user.FieldMajorID, v)
+	return u
+}
+
+// UpdateMajorID sets the "major_id" field to the value that was provided on create.
+func (u *UserUpsert) UpdateMajorID() *UserUpsert {
+	u.SetExcluded(user.FieldMajorID)
+	return u
+}
+
+// ClearMajorID clears the value of the "major_id" field.
+func (u *UserUpsert) ClearMajorID() *UserUpsert {
+	u.SetNull(user.FieldMajorID)
+	return u
+}
+
+// SetAdmissionGrade sets the "admission_grade" field.
+func (u *UserUpsert) SetAdmissionGrade(v int32) *UserUpsert {
+	u.Set(user.FieldAdmissionGrade, v)
+	return u
+}
+
+// UpdateAdmissionGrade sets the "admission_grade" field to the value that was provided on create.
+func (u *UserUpsert) UpdateAdmissionGrade() *UserUpsert {
+	u.SetExcluded(user.FieldAdmissionGrade)
+	return u
+}
+
+// AddAdmissionGrade adds v to the "admission_grade" field.
+func (u *UserUpsert) AddAdmissionGrade(v int32) *UserUpsert {
+	u.Add(user.FieldAdmissionGrade, v)
+	return u
+}
+
+// ClearAdmissionGrade clears the value of the "admission_grade" field.
+func (u *UserUpsert) ClearAdmissionGrade() *UserUpsert {
+	u.SetNull(user.FieldAdmissionGrade)
+	return u
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (u *UserUpsert) SetAvatarURL(v string) *UserUpsert {
+	u.Set(user.FieldAvatarURL, v)
+	return u
+}
+
+// UpdateAvatarURL sets the "avatar_url" field to the value that was provided on create.
+func (u *UserUpsert) UpdateAvatarURL() *UserUpsert {
+	u.SetExcluded(user.FieldAvatarURL)
+	return u
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (u *UserUpsert) ClearAvatarURL() *UserUpsert {
+	u.SetNull(user.FieldAvatarURL)
+	return u
+}
+
+// SetExperience sets the "experience" field.
+func (u *UserUpsert) SetExperience(v int32) *UserUpsert {
+	u.Set(user.FieldExperience, v)
+	return u
+}
+
+// UpdateExperience sets the "experience" field to the value that was provided on create.
+func (u *UserUpsert) UpdateExperience() *UserUpsert {
+	u.SetExcluded(user.FieldExperience)
+	return u
+}
+
+// AddExperience adds v to the "experience" field.
+func (u *UserUpsert) AddExperience(v int32) *UserUpsert {
+	u.Add(user.FieldExperience, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *UserUpsert) SetStatus(v int8) *UserUpsert {
+	u.Set(user.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *UserUpsert) UpdateStatus() *UserUpsert {
+	u.SetExcluded(user.FieldStatus)
+	return u
+}
+
+// AddStatus adds v to the "status" field.
+func (u *UserUpsert) AddStatus(v int8) *UserUpsert {
+	u.Add(user.FieldStatus, v)
+	return u
+}
+
+// SetLogoffTime sets the "logoff_time" field.
+func (u *UserUpsert) SetLogoffTime(v uint32) *UserUpsert {
+	u.Set(user.FieldLogoffTime, v)
+	return u
+}
+
+// UpdateLogoffTime sets the "logoff_time" field to the value that was provided on create.
+func (u *UserUpsert) UpdateLogoffTime() *UserUpsert {
+	u.SetExcluded(user.FieldLogoffTime)
+	return u
+}
+
+// AddLogoffTime adds v to the "logoff_time" field.
+func (u *UserUpsert) AddLogoffTime(v uint32) *UserUpsert {
+	u.Add(user.FieldLogoffTime, v)
+	return u
+}
+
+// ClearLogoffTime clears the value of the "logoff_time" field.
+func (u *UserUpsert) ClearLogoffTime() *UserUpsert {
+	u.SetNull(user.FieldLogoffTime)
+	return u
+}
+
+// SetBannedTime sets the "banned_time" field.
+func (u *UserUpsert) SetBannedTime(v uint32) *UserUpsert {
+	u.Set(user.FieldBannedTime, v)
+	return u
+}
+
+// UpdateBannedTime sets the "banned_time" field to the value that was provided on create.
+func (u *UserUpsert) UpdateBannedTime() *UserUpsert {
+	u.SetExcluded(user.FieldBannedTime)
+	return u
+}
+
+// AddBannedTime adds v to the "banned_time" field.
+func (u *UserUpsert) AddBannedTime(v uint32) *UserUpsert {
+	u.Add(user.FieldBannedTime, v)
+	return u
+}
+
+// ClearBannedTime clears the value of the "banned_time" field.
+func (u *UserUpsert) ClearBannedTime() *UserUpsert {
+	u.SetNull(user.FieldBannedTime)
+	return u
+}
+
+// SetUnbannedTime sets the "unbanned_time" field.
+func (u *UserUpsert) SetUnbannedTime(v uint32) *UserUpsert {
+	u.Set(user.FieldUnbannedTime, v)
+	return u
+}
+
+// UpdateUnbannedTime sets the "unbanned_time" field to the value that was provided on create.
+func (u *UserUpsert) UpdateUnbannedTime() *UserUpsert {
+	u.SetExcluded(user.FieldUnbannedTime)
+	return u
+}
+
+// AddUnbannedTime adds v to the "unbanned_time" field.
+func (u *UserUpsert) AddUnbannedTime(v uint32) *UserUpsert {
+	u.Add(user.FieldUnbannedTime, v)
+	return u
+}
+
+// ClearUnbannedTime clears the value of the "unbanned_time" field.
+func (u *UserUpsert) ClearUnbannedTime() *UserUpsert {
+	u.SetNull(user.FieldUnbannedTime)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserUpsert) SetUpdatedAt(v uint32) *UserUpsert {
+	u.Set(user.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdateUpdatedAt() *UserUpsert {
+	u.SetExcluded(user.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *UserUpsert) AddUpdatedAt(v uint32) *UserUpsert {
+	u.Add(user.FieldUpdatedAt, v)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *UserUpsert) ClearUpdatedAt() *UserUpsert {
+	u.SetNull(user.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(user.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserUpsertOne) UpdateNewValues() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(user.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(user.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserUpsertOne) Ignore() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserUpsertOne) DoNothing() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserCreate.OnConflict
+// documentation for more info.
+func (u *UserUpsertOne) Update(set func(*UserUpsert)) *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNickname sets the "nickname" field.
+func (u *UserUpsertOne) SetNickname(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetNickname(v)
+	})
+}
+
+// UpdateNickname sets the "nickname" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateNickname() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateNickname()
+	})
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (u *UserUpsertOne) ClearNickname() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearNickname()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *UserUpsertOne) SetPhone(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePhone() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *UserUpsertOne) ClearPhone() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPhone()
+	})
+}
+
+// SetPassword sets the "password" field.
+func (u *UserUpsertOne) SetPassword(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPassword(v)
+	})
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePassword() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePassword()
+	})
+}
+
+// ClearPassword clears the value of the "password" field.
+func (u *UserUpsertOne) ClearPassword() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPassword()
+	})
+}
+
+// SetSchoolID sets the "school_id" field.
+func (u *UserUpsertOne) SetSchoolID(v uint32) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetSchoolID(v)
+	})
+}
+
+// UpdateSchoolID sets the "school_id" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateSchoolID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateSchoolID()
+	})
+}
+
+// ClearSchoolID clears the value of the "school_id" field.
+func (u *UserUpsertOne) ClearSchoolID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearSchoolID()
+	})
+}
+
+// SetMajorID sets the "major_id" field.
+func (u *UserUpsertOne) SetMajorID(v uint32) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMajorID(v)
+	})
+}
+
+// UpdateMajorID sets the "major_id" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateMajorID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMajorID()
+	})
+}
+
+// ClearMajorID clears the value of the "major_id" field.
+func (u *UserUpsertOne) ClearMajorID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearMajorID()
+	})
+}
+
+// SetAdmissionGrade sets the "admission_grade" field.
+func (u *UserUpsertOne) SetAdmissionGrade(v int32) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAdmissionGrade(v)
+	})
+}
+
+// AddAdmissionGrade adds v to the "admission_grade" field.
+func (u *UserUpsertOne) AddAdmissionGrade(v int32) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddAdmissionGrade(v)
+	})
+}
+
+// UpdateAdmissionGrade sets the "admission_grade" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateAdmissionGrade() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAdmissionGrade()
+	})
+}
+
+// ClearAdmissionGrade clears the value of the "admission_grade" field.
+func (u *UserUpsertOne) ClearAdmissionGrade() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearAdmissionGrade()
+	})
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (u *UserUpsertOne) SetAvatarURL(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAvatarURL(v)
+	})
+}
+
+// UpdateAvatarURL sets the "avatar_url" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateAvatarURL() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAvatarURL()
+	})
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (u *UserUpsertOne) ClearAvatarURL() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearAvatarURL()
+	})
+}
+
+// SetExperience sets the "experience" field.
+func (u *UserUpsertOne) SetExperience(v int32) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetExperience(v)
+	})
+}
+
+// AddExperience adds v to the "experience" field.
+func (u *UserUpsertOne) AddExperience(v int32) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddExperience(v)
+	})
+}
+
+// UpdateExperience sets the "experience" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateExperience() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateExperience()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *UserUpsertOne) SetStatus(v int8) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *UserUpsertOne) AddStatus(v int8) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateStatus() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetLogoffTime sets the "logoff_time" field.
+func (u *UserUpsertOne) SetLogoffTime(v uint32) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLogoffTime(v)
+	})
+}
+
+// AddLogoffTime adds v to the "logoff_time" field.
+func (u *UserUpsertOne) AddLogoffTime(v uint32) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddLogoffTime(v)
+	})
+}
+
+// UpdateLogoffTime sets the "logoff_time" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateLogoffTime() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLogoffTime()
+	})
 }
 
-type (
-	// UserUpsertOne is the builder for "upsert"-ing
-	//  one User node.
-	UserUpsertOne struct {
-		create *UserCreate
-	}
+// ClearLogoffTime clears the value of the "logoff_time" field.
+func (u *UserUpsertOne) ClearLogoffTime() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLogoffTime()
+	})
+}
 
-	// UserUpsert is the "OnConflict" setter.
-	UserUpsert struct {
-		*sql.UpdateSet
-	}
-)
+// SetBannedTime sets the "banned_time" field.
+func (u *UserUpsertOne) SetBannedTime(v uint32) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBannedTime(v)
+	})
+}
 
-// SetNickName sets the "nick_name" field.
-func (u *UserUpsert) SetNickName(v int64) *UserUpsert {
-	u.Set(user.FieldNickName, v)
-	return u
+// AddBannedTime adds v to the "banned_time" field.
+func (u *UserUpsertOne) AddBannedTime(v uint32) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddBannedTime(v)
+	})
 }
 
-// UpdateNickName sets the "nick_name" field to the value that was provided on create.
-func (u *UserUpsert) UpdateNickName() *UserUpsert {
-	u.SetExcluded(user.FieldNickName)
-	return u
+// UpdateBannedTime sets the "banned_time" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateBannedTime() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBannedTime()
+	})
 }
 
-// AddNickName adds v to the "nick_name" field.
-func (u *UserUpsert) AddNickName(v int64) *UserUpsert {
-	u.Add(user.FieldNickName, v)
-	return u
+// ClearBannedTime clears the value of the "banned_time" field.
+func (u *UserUpsertOne) ClearBannedTime() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBannedTime()
+	})
 }
 
-// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
-// Using this option is equivalent to using:
-//
-//	client.User.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//			sql.ResolveWith(func(u *sql.UpdateSet) {
-//				u.SetIgnore(user.FieldID)
-//			}),
-//		).
-//		Exec(ctx)
-func (u *UserUpsertOne) UpdateNewValues() *UserUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
-		if _, exists := u.create.mutation.ID(); exists {
-			s.SetIgnore(user.FieldID)
-		}
-	}))
-	return u
+// SetUnbannedTime sets the "unbanned_time" field.
+func (u *UserUpsertOne) SetUnbannedTime(v uint32) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUnbannedTime(v)
+	})
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.User.Create().
-//	    OnConflict(sql.ResolveWithIgnore()).
-//	    Exec(ctx)
-func (u *UserUpsertOne) Ignore() *UserUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
-	return u
+// AddUnbannedTime adds v to the "unbanned_time" field.
+func (u *UserUpsertOne) AddUnbannedTime(v uint32) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddUnbannedTime(v)
+	})
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
-// Supported only by SQLite and PostgreSQL.
-func (u *UserUpsertOne) DoNothing() *UserUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.DoNothing())
-	return u
+// UpdateUnbannedTime sets the "unbanned_time" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateUnbannedTime() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUnbannedTime()
+	})
 }
 
-// Update allows overriding fields `UPDATE` values. See the UserCreate.OnConflict
-// documentation for more info.
-func (u *UserUpsertOne) Update(set func(*UserUpsert)) *UserUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
-		set(&UserUpsert{UpdateSet: update})
-	}))
-	return u
+// ClearUnbannedTime clears the value of the "unbanned_time" field.
+func (u *UserUpsertOne) ClearUnbannedTime() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearUnbannedTime()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserUpsertOne) SetUpdatedAt(v uint32) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUpdatedAt(v)
+	})
 }
 
-// SetNickName sets the "nick_name" field.
-func (u *UserUpsertOne) SetNickName(v int64) *UserUpsertOne {
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *UserUpsertOne) AddUpdatedAt(v uint32) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
-		s.SetNickName(v)
+		s.AddUpdatedAt(v)
 	})
 }
 
-// AddNickName adds v to the "nick_name" field.
-func (u *UserUpsertOne) AddNickName(v int64) *UserUpsertOne {
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateUpdatedAt() *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
-		s.AddNickName(v)
+		s.UpdateUpdatedAt()
 	})
 }
 
-// UpdateNickName sets the "nick_name" field to the value that was provided on create.
-func (u *UserUpsertOne) UpdateNickName() *UserUpsertOne {
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *UserUpsertOne) ClearUpdatedAt() *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
-		s.UpdateNickName()
+		s.ClearUpdatedAt()
 	})
 }
 
@@ -298,6 +1166,7 @@ func (_c *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*UserMutation)
 				if !ok {
@@ -380,7 +1249,7 @@ func (_c *UserCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.UserUpsert) {
-//			SetNickName(v+v).
+//			SetNickname(v+v).
 //		}).
 //		Exec(ctx)
 func (_c *UserCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserUpsertBulk {
@@ -427,6 +1296,9 @@ func (u *UserUpsertBulk) UpdateNewValues() *UserUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(user.FieldID)
 			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(user.FieldCreatedAt)
+			}
 		}
 	}))
 	return u
@@ -459,24 +1331,311 @@ func (u *UserUpsertBulk) Update(set func(*UserUpsert)) *UserUpsertBulk {
 	return u
 }
 
-// SetNickName sets the "nick_name" field.
-func (u *UserUpsertBulk) SetNickName(v int64) *UserUpsertBulk {
+// SetNickname sets the "nickname" field.
+func (u *UserUpsertBulk) SetNickname(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetNickname(v)
+	})
+}
+
+// UpdateNickname sets the "nickname" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateNickname() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateNickname()
+	})
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (u *UserUpsertBulk) ClearNickname() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearNickname()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *UserUpsertBulk) SetPhone(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePhone() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *UserUpsertBulk) ClearPhone() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPhone()
+	})
+}
+
+// SetPassword sets the "password" field.
+func (u *UserUpsertBulk) SetPassword(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPassword(v)
+	})
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePassword() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePassword()
+	})
+}
+
+// ClearPassword clears the value of the "password" field.
+func (u *UserUpsertBulk) ClearPassword() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPassword()
+	})
+}
+
+// SetSchoolID sets the "school_id" field.
+func (u *UserUpsertBulk) SetSchoolID(v uint32) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetSchoolID(v)
+	})
+}
+
+// UpdateSchoolID sets the "school_id" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateSchoolID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateSchoolID()
+	})
+}
+
+// ClearSchoolID clears the value of the "school_id" field.
+func (u *UserUpsertBulk) ClearSchoolID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearSchoolID()
+	})
+}
+
+// SetMajorID sets the "major_id" field.
+func (u *UserUpsertBulk) SetMajorID(v uint32) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMajorID(v)
+	})
+}
+
+// UpdateMajorID sets the "major_id" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateMajorID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMajorID()
+	})
+}
+
+// ClearMajorID clears the value of the "major_id" field.
+func (u *UserUpsertBulk) ClearMajorID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearMajorID()
+	})
+}
+
+// SetAdmissionGrade sets the "admission_grade" field.
+func (u *UserUpsertBulk) SetAdmissionGrade(v int32) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAdmissionGrade(v)
+	})
+}
+
+// AddAdmissionGrade adds v to the "admission_grade" field.
+func (u *UserUpsertBulk) AddAdmissionGrade(v int32) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddAdmissionGrade(v)
+	})
+}
+
+// UpdateAdmissionGrade sets the "admission_grade" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateAdmissionGrade() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAdmissionGrade()
+	})
+}
+
+// ClearAdmissionGrade clears the value of the "admission_grade" field.
+func (u *UserUpsertBulk) ClearAdmissionGrade() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearAdmissionGrade()
+	})
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (u *UserUpsertBulk) SetAvatarURL(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAvatarURL(v)
+	})
+}
+
+// UpdateAvatarURL sets the "avatar_url" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateAvatarURL() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAvatarURL()
+	})
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (u *UserUpsertBulk) ClearAvatarURL() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearAvatarURL()
+	})
+}
+
+// SetExperience sets the "experience" field.
+func (u *UserUpsertBulk) SetExperience(v int32) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetExperience(v)
+	})
+}
+
+// AddExperience adds v to the "experience" field.
+func (u *UserUpsertBulk) AddExperience(v int32) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddExperience(v)
+	})
+}
+
+// UpdateExperience sets the "experience" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateExperience() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateExperience()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *UserUpsertBulk) SetStatus(v int8) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *UserUpsertBulk) AddStatus(v int8) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateStatus() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetLogoffTime sets the "logoff_time" field.
+func (u *UserUpsertBulk) SetLogoffTime(v uint32) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLogoffTime(v)
+	})
+}
+
+// AddLogoffTime adds v to the "logoff_time" field.
+func (u *UserUpsertBulk) AddLogoffTime(v uint32) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddLogoffTime(v)
+	})
+}
+
+// UpdateLogoffTime sets the "logoff_time" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateLogoffTime() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLogoffTime()
+	})
+}
+
+// ClearLogoffTime clears the value of the "logoff_time" field.
+func (u *UserUpsertBulk) ClearLogoffTime() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLogoffTime()
+	})
+}
+
+// SetBannedTime sets the "banned_time" field.
+func (u *UserUpsertBulk) SetBannedTime(v uint32) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBannedTime(v)
+	})
+}
+
+// AddBannedTime adds v to the "banned_time" field.
+func (u *UserUpsertBulk) AddBannedTime(v uint32) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddBannedTime(v)
+	})
+}
+
+// UpdateBannedTime sets the "banned_time" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateBannedTime() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBannedTime()
+	})
+}
+
+// ClearBannedTime clears the value of the "banned_time" field.
+func (u *UserUpsertBulk) ClearBannedTime() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBannedTime()
+	})
+}
+
+// SetUnbannedTime sets the "unbanned_time" field.
+func (u *UserUpsertBulk) SetUnbannedTime(v uint32) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUnbannedTime(v)
+	})
+}
+
+// AddUnbannedTime adds v to the "unbanned_time" field.
+func (u *UserUpsertBulk) AddUnbannedTime(v uint32) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddUnbannedTime(v)
+	})
+}
+
+// UpdateUnbannedTime sets the "unbanned_time" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateUnbannedTime() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUnbannedTime()
+	})
+}
+
+// ClearUnbannedTime clears the value of the "unbanned_time" field.
+func (u *UserUpsertBulk) ClearUnbannedTime() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearUnbannedTime()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserUpsertBulk) SetUpdatedAt(v uint32) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *UserUpsertBulk) AddUpdatedAt(v uint32) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
-		s.SetNickName(v)
+		s.AddUpdatedAt(v)
 	})
 }
 
-// AddNickName adds v to the "nick_name" field.
-func (u *UserUpsertBulk) AddNickName(v int64) *UserUpsertBulk {
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateUpdatedAt() *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
-		s.AddNickName(v)
+		s.UpdateUpdatedAt()
 	})
 }
 
-// UpdateNickName sets the "nick_name" field to the value that was provided on create.
-func (u *UserUpsertBulk) UpdateNickName() *UserUpsertBulk {
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *UserUpsertBulk) ClearUpdatedAt() *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
-		s.UpdateNickName()
+		s.ClearUpdatedAt()
 	})
 }
 
@@ -6,7 +6,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"user/internal/ent/major"
 	"user/internal/ent/predicate"
+	"user/internal/ent/school"
 	"user/internal/ent/user"
 
 	"entgo.io/ent/dialect/sql"
@@ -28,32 +30,330 @@ func (_u *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return _u
 }
 
-// SetNickName sets the "nick_name" field.
-func (_u *UserUpdate) SetNickName(v int64) *UserUpdate {
-	_u.mutation.ResetNickName()
-	_u.mutation.SetNickName(v)
+// SetNickname sets the "nickname" field.
+func (_u *UserUpdate) SetNickname(v string) *UserUpdate {
+	_u.mutation.SetNickname(v)
 	return _u
 }
 
-// SetNillableNickName sets the "nick_name" field if the given value is not nil.
-func (_u *UserUpdate) SetNillableNickName(v *int64) *UserUpdate {
+// SetNillableNickname sets the "nickname" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableNickname(v *string) *UserUpdate {
 	if v != nil {
-		_u.SetNickName(*v)
+		_u.SetNickname(*v)
 	}
 	return _u
 }
 
-// AddNickName adds value to the "nick_name" field.
-func (_u *UserUpdate) AddNickName(v int64) *UserUpdate {
-	_u.mutation.AddNickName(v)
+// ClearNickname clears the value of the "nickname" field.
+func (_u *UserUpdate) ClearNickname() *UserUpdate {
+	_u.mutation.ClearNickname()
 	return _u
 }
 
+// SetPhone sets the "phone" field.
+func (_u *UserUpdate) SetPhone(v string) *UserUpdate {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePhone(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *UserUpdate) ClearPhone() *UserUpdate {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetPassword sets the "password" field.
+func (_u *UserUpdate) SetPassword(v string) *UserUpdate {
+	_u.mutation.SetPassword(v)
+	return _u
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePassword(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetPassword(*v)
+	}
+	return _u
+}
+
+// ClearPassword clears the value of the "password" field.
+func (_u *UserUpdate) ClearPassword() *UserUpdate {
+	_u.mutation.ClearPassword()
+	return _u
+}
+
+// SetSchoolID sets the "school_id" field.
+func (_u *UserUpdate) SetSchoolID(v uint32) *UserUpdate {
+	_u.mutation.SetSchoolID(v)
+	return _u
+}
+
+// SetNillableSchoolID sets the "school_id" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableSchoolID(v *uint32) *UserUpdate {
+	if v != nil {
+		_u.SetSchoolID(*v)
+	}
+	return _u
+}
+
+// ClearSchoolID clears the value of the "school_id" field.
+func (_u *UserUpdate) ClearSchoolID() *UserUpdate {
+	_u.mutation.ClearSchoolID()
+	return _u
+}
+
+// SetMajorID sets the "major_id" field.
+func (_u *UserUpdate) SetMajorID(v uint32) *UserUpdate {
+	_u.mutation.SetMajorID(v)
+	return _u
+}
+
+// SetNillableMajorID sets the "major_id" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableMajorID(v *uint32) *UserUpdate {
+	if v != nil {
+		_u.SetMajorID(*v)
+	}
+	return _u
+}
+
+// ClearMajorID clears the value of the "major_id" field.
+func (_u *UserUpdate) ClearMajorID() *UserUpdate {
+	_u.mutation.ClearMajorID()
+	return _u
+}
+
+// SetAdmissionGrade sets the "admission_grade" field.
+func (_u *UserUpdate) SetAdmissionGrade(v int32) *UserUpdate {
+	_u.mutation.ResetAdmissionGrade()
+	_u.mutation.SetAdmissionGrade(v)
+	return _u
+}
+
+// SetNillableAdmissionGrade sets the "admission_grade" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableAdmissionGrade(v *int32) *UserUpdate {
+	if v != nil {
+		_u.SetAdmissionGrade(*v)
+	}
+	return _u
+}
+
+// AddAdmissionGrade adds value to the "admission_grade" field.
+func (_u *UserUpdate) AddAdmissionGrade(v int32) *UserUpdate {
+	_u.mutation.AddAdmissionGrade(v)
+	return _u
+}
+
+// ClearAdmissionGrade clears the value of the "admission_grade" field.
+func (_u *UserUpdate) ClearAdmissionGrade() *UserUpdate {
+	_u.mutation.ClearAdmissionGrade()
+	return _u
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (_u *UserUpdate) SetAvatarURL(v string) *UserUpdate {
+	_u.mutation.SetAvatarURL(v)
+	return _u
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableAvatarURL(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetAvatarURL(*v)
+	}
+	return _u
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (_u *UserUpdate) ClearAvatarURL() *UserUpdate {
+	_u.mutation.ClearAvatarURL()
+	return _u
+}
+
+// SetExperience sets the "experience" field.
+func (_u *UserUpdate) SetExperience(v int32) *UserUpdate {
+	_u.mutation.ResetExperience()
+	_u.mutation.SetExperience(v)
+	return _u
+}
+
+// SetNillableExperience sets the "experience" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableExperience(v *int32) *UserUpdate {
+	if v != nil {
+		_u.SetExperience(*v)
+	}
+	return _u
+}
+
+// AddExperience adds value to the "experience" field.
+func (_u *UserUpdate) AddExperience(v int32) *UserUpdate {
+	_u.mutation.AddExperience(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *UserUpdate) SetStatus(v int8) *UserUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableStatus(v *int8) *UserUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *UserUpdate) AddStatus(v int8) *UserUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetLogoffTime sets the "logoff_time" field.
+func (_u *UserUpdate) SetLogoffTime(v uint32) *UserUpdate {
+	_u.mutation.ResetLogoffTime()
+	_u.mutation.SetLogoffTime(v)
+	return _u
+}
+
+// SetNillableLogoffTime sets the "logoff_time" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableLogoffTime(v *uint32) *UserUpdate {
+	if v != nil {
+		_u.SetLogoffTime(*v)
+	}
+	return _u
+}
+
+// AddLogoffTime adds value to the "logoff_time" field.
+func (_u *UserUpdate) AddLogoffTime(v int32) *UserUpdate {
+	_u.mutation.AddLogoffTime(v)
+	return _u
+}
+
+// ClearLogoffTime clears the value of the "logoff_time" field.
+func (_u *UserUpdate) ClearLogoffTime() *UserUpdate {
+	_u.mutation.ClearLogoffTime()
+	return _u
+}
+
+// SetBannedTime sets the "banned_time" field.
+func (_u *UserUpdate) SetBannedTime(v uint32) *UserUpdate {
+	_u.mutation.ResetBannedTime()
+	_u.mutation.SetBannedTime(v)
+	return _u
+}
+
+// SetNillableBannedTime sets the "banned_time" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableBannedTime(v *uint32) *UserUpdate {
+	if v != nil {
+		_u.SetBannedTime(*v)
+	}
+	return _u
+}
+
+// AddBannedTime adds value to the "banned_time" field.
+func (_u *UserUpdate) AddBannedTime(v int32) *UserUpdate {
+	_u.mutation.AddBannedTime(v)
+	return _u
+}
+
+// ClearBannedTime clears the value of the "banned_time" field.
+func (_u *UserUpdate) ClearBannedTime() *UserUpdate {
+	_u.mutation.ClearBannedTime()
+	return _u
+}
+
+// SetUnbannedTime sets the "unbanned_time" field.
+func (_u *UserUpdate) SetUnbannedTime(v uint32) *UserUpdate {
+	_u.mutation.ResetUnbannedTime()
+	_u.mutation.SetUnbannedTime(v)
+	return _u
+}
+
+// SetNillableUnbannedTime sets the "unbanned_time" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableUnbannedTime(v *uint32) *UserUpdate {
+	if v != nil {
+		_u.SetUnbannedTime(*v)
+	}
+	return _u
+}
+
+// AddUnbannedTime adds value to the "unbanned_time" field.
+func (_u *UserUpdate) AddUnbannedTime(v int32) *UserUpdate {
+	_u.mutation.AddUnbannedTime(v)
+	return _u
+}
+
+// ClearUnbannedTime clears the value of the "unbanned_time" field.
+func (_u *UserUpdate) ClearUnbannedTime() *UserUpdate {
+	_u.mutation.ClearUnbannedTime()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserUpdate) SetUpdatedAt(v uint32) *UserUpdate {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableUpdatedAt(v *uint32) *UserUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *UserUpdate) AddUpdatedAt(v int32) *UserUpdate {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *UserUpdate) ClearUpdatedAt() *UserUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetSchool sets the "school" edge to the School entity.
+func (_u *UserUpdate) SetSchool(v *School) *UserUpdate {
+	return _u.SetSchoolID(v.ID)
+}
+
+// SetMajor sets the "major" edge to the Major entity.
+func (_u *UserUpdate) SetMajor(v *Major) *UserUpdate {
+	return _u.SetMajorID(v.ID)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
 }
 
+// ClearSchool clears the "school" edge to the School entity.
+func (_u *UserUpdate) ClearSchool() *UserUpdate {
+	_u.mutation.ClearSchool()
+	return _u
+}
+
+// ClearMajor clears the "major" edge to the Major entity.
+func (_u *UserUpdate) ClearMajor() *UserUpdate {
+	_u.mutation.ClearMajor()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -81,6 +381,31 @@ func (_u *UserUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserUpdate) check() error {
+	if v, ok := _u.mutation.Nickname(); ok {
+		if err := user.NicknameValidator(v); err != nil {
+			return &ValidationError{Name: "nickname", err: fmt.Errorf(`ent: validator failed for field "User.nickname": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Phone(); ok {
+		if err := user.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "User.phone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Password(); ok {
+		if err := user.PasswordValidator(v); err != nil {
+			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AvatarURL(); ok {
+		if err := user.AvatarURLValidator(v); err != nil {
+			return &ValidationError{Name: "avatar_url", err: fmt.Errorf(`ent: validator failed for field "User.avatar_url": %w`, err)}
+		}
+	}
+	return nil
+}
+
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (_u *UserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdate {
 	_u.modifiers = append(_u.modifiers, modifiers...)
@@ -88,6 +413,9 @@ func (_u *UserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdat
 }
 
 func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -96,11 +424,147 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
-	if value, ok := _u.mutation.NickName(); ok {
-		_spec.SetField(user.FieldNickName, field.TypeInt64, value)
+	if value, ok := _u.mutation.Nickname(); ok {
+		_spec.SetField(user.FieldNickname, field.TypeString, value)
+	}
+	if _u.mutation.NicknameCleared() {
+		_spec.ClearField(user.FieldNickname, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(user.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(user.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Password(); ok {
+		_spec.SetField(user.FieldPassword, field.TypeString, value)
+	}
+	if _u.mutation.PasswordCleared() {
+		_spec.ClearField(user.FieldPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdmissionGrade(); ok {
+		_spec.SetField(user.FieldAdmissionGrade, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedAdmissionGrade(); ok {
+		_spec.AddField(user.FieldAdmissionGrade, field.TypeInt32, value)
+	}
+	if _u.mutation.AdmissionGradeCleared() {
+		_spec.ClearField(user.FieldAdmissionGrade, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.AvatarURL(); ok {
+		_spec.SetField(user.FieldAvatarURL, field.TypeString, value)
+	}
+	if _u.mutation.AvatarURLCleared() {
+		_spec.ClearField(user.FieldAvatarURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Experience(); ok {
+		_spec.SetField(user.FieldExperience, field.TypeInt32, value)
 	}
-	if value, ok := _u.mutation.AddedNickName(); ok {
-		_spec.AddField(user.FieldNickName, field.TypeInt64, value)
+	if value, ok := _u.mutation.AddedExperience(); ok {
+		_spec.AddField(user.FieldExperience, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(user.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(user.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.LogoffTime(); ok {
+		_spec.SetField(user.FieldLogoffTime, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedLogoffTime(); ok {
+		_spec.AddField(user.FieldLogoffTime, field.TypeUint32, value)
+	}
+	if _u.mutation.LogoffTimeCleared() {
+		_spec.ClearField(user.FieldLogoffTime, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.BannedTime(); ok {
+		_spec.SetField(user.FieldBannedTime, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedBannedTime(); ok {
+		_spec.AddField(user.FieldBannedTime, field.TypeUint32, value)
+	}
+	if _u.mutation.BannedTimeCleared() {
+		_spec.ClearField(user.FieldBannedTime, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UnbannedTime(); ok {
+		_spec.SetField(user.FieldUnbannedTime, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUnbannedTime(); ok {
+		_spec.AddField(user.FieldUnbannedTime, field.TypeUint32, value)
+	}
+	if _u.mutation.UnbannedTimeCleared() {
+		_spec.ClearField(user.FieldUnbannedTime, field.TypeUint32)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(user.FieldCreatedAt, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(user.FieldUpdatedAt, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(user.FieldUpdatedAt, field.TypeUint32)
+	}
+	if _u.mutation.SchoolCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.SchoolTable,
+			Columns: []string{user.SchoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SchoolIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.SchoolTable,
+			Columns: []string{user.SchoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MajorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.MajorTable,
+			Columns: []string{user.MajorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MajorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.MajorTable,
+			Columns: []string{user.MajorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
@@ -124,32 +588,330 @@ type UserUpdateOne struct {
 	modifiers []func(*sql.UpdateBuilder)
 }
 
-// SetNickName sets the "nick_name" field.
-func (_u *UserUpdateOne) SetNickName(v int64) *UserUpdateOne {
-	_u.mutation.ResetNickName()
-	_u.mutation.SetNickName(v)
+// SetNickname sets the "nickname" field.
+func (_u *UserUpdateOne) SetNickname(v string) *UserUpdateOne {
+	_u.mutation.SetNickname(v)
 	return _u
 }
 
-// SetNillableNickName sets the "nick_name" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableNickName(v *int64) *UserUpdateOne {
+// SetNillableNickname sets the "nickname" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableNickname(v *string) *UserUpdateOne {
 	if v != nil {
-		_u.SetNickName(*v)
+		_u.SetNickname(*v)
 	}
 	return _u
 }
 
-// AddNickName adds value to the "nick_name" field.
-func (_u *UserUpdateOne) AddNickName(v int64) *UserUpdateOne {
-	_u.mutation.AddNickName(v)
+// ClearNickname clears the value of the "nickname" field.
+func (_u *UserUpdateOne) ClearNickname() *UserUpdateOne {
+	_u.mutation.ClearNickname()
 	return _u
 }
 
+// SetPhone sets the "phone" field.
+func (_u *UserUpdateOne) SetPhone(v string) *UserUpdateOne {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePhone(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *UserUpdateOne) ClearPhone() *UserUpdateOne {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetPassword sets the "password" field.
+func (_u *UserUpdateOne) SetPassword(v string) *UserUpdateOne {
+	_u.mutation.SetPassword(v)
+	return _u
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePassword(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPassword(*v)
+	}
+	return _u
+}
+
+// ClearPassword clears the value of the "password" field.
+func (_u *UserUpdateOne) ClearPassword() *UserUpdateOne {
+	_u.mutation.ClearPassword()
+	return _u
+}
+
+// SetSchoolID sets the "school_id" field.
+func (_u *UserUpdateOne) SetSchoolID(v uint32) *UserUpdateOne {
+	_u.mutation.SetSchoolID(v)
+	return _u
+}
+
+// SetNillableSchoolID sets the "school_id" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableSchoolID(v *uint32) *UserUpdateOne {
+	if v != nil {
+		_u.SetSchoolID(*v)
+	}
+	return _u
+}
+
+// ClearSchoolID clears the value of the "school_id" field.
+func (_u *UserUpdateOne) ClearSchoolID() *UserUpdateOne {
+	_u.mutation.ClearSchoolID()
+	return _u
+}
+
+// SetMajorID sets the "major_id" field.
+func (_u *UserUpdateOne) SetMajorID(v uint32) *UserUpdateOne {
+	_u.mutation.SetMajorID(v)
+	return _u
+}
+
+// SetNillableMajorID sets the "major_id" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableMajorID(v *uint32) *UserUpdateOne {
+	if v != nil {
+		_u.SetMajorID(*v)
+	}
+	return _u
+}
+
+// ClearMajorID clears the value of the "major_id" field.
+func (_u *UserUpdateOne) ClearMajorID() *UserUpdateOne {
+	_u.mutation.ClearMajorID()
+	return _u
+}
+
+// SetAdmissionGrade sets the "admission_grade" field.
+func (_u *UserUpdateOne) SetAdmissionGrade(v int32) *UserUpdateOne {
+	_u.mutation.ResetAdmissionGrade()
+	_u.mutation.SetAdmissionGrade(v)
+	return _u
+}
+
+// SetNillableAdmissionGrade sets the "admission_grade" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableAdmissionGrade(v *int32) *UserUpdateOne {
+	if v != nil {
+		_u.SetAdmissionGrade(*v)
+	}
+	return _u
+}
+
+// AddAdmissionGrade adds value to the "admission_grade" field.
+func (_u *UserUpdateOne) AddAdmissionGrade(v int32) *UserUpdateOne {
+	_u.mutation.AddAdmissionGrade(v)
+	return _u
+}
+
+// ClearAdmissionGrade clears the value of the "admission_grade" field.
+func (_u *UserUpdateOne) ClearAdmissionGrade() *UserUpdateOne {
+	_u.mutation.ClearAdmissionGrade()
+	return _u
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (_u *UserUpdateOne) SetAvatarURL(v string) *UserUpdateOne {
+	_u.mutation.SetAvatarURL(v)
+	return _u
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableAvatarURL(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetAvatarURL(*v)
+	}
+	return _u
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (_u *UserUpdateOne) ClearAvatarURL() *UserUpdateOne {
+	_u.mutation.ClearAvatarURL()
+	return _u
+}
+
+// SetExperience sets the "experience" field.
+func (_u *UserUpdateOne) SetExperience(v int32) *UserUpdateOne {
+	_u.mutation.ResetExperience()
+	_u.mutation.SetExperience(v)
+	return _u
+}
+
+// SetNillableExperience sets the "experience" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableExperience(v *int32) *UserUpdateOne {
+	if v != nil {
+		_u.SetExperience(*v)
+	}
+	return _u
+}
+
+// AddExperience adds value to the "experience" field.
+func (_u *UserUpdateOne) AddExperience(v int32) *UserUpdateOne {
+	_u.mutation.AddExperience(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *UserUpdateOne) SetStatus(v int8) *UserUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStatus(v *int8) *UserUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *UserUpdateOne) AddStatus(v int8) *UserUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetLogoffTime sets the "logoff_time" field.
+func (_u *UserUpdateOne) SetLogoffTime(v uint32) *UserUpdateOne {
+	_u.mutation.ResetLogoffTime()
+	_u.mutation.SetLogoffTime(v)
+	return _u
+}
+
+// SetNillableLogoffTime sets the "logoff_time" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLogoffTime(v *uint32) *UserUpdateOne {
+	if v != nil {
+		_u.SetLogoffTime(*v)
+	}
+	return _u
+}
+
+// AddLogoffTime adds value to the "logoff_time" field.
+func (_u *UserUpdateOne) AddLogoffTime(v int32) *UserUpdateOne {
+	_u.mutation.AddLogoffTime(v)
+	return _u
+}
+
+// ClearLogoffTime clears the value of the "logoff_time" field.
+func (_u *UserUpdateOne) ClearLogoffTime() *UserUpdateOne {
+	_u.mutation.ClearLogoffTime()
+	return _u
+}
+
+// SetBannedTime sets the "banned_time" field.
+func (_u *UserUpdateOne) SetBannedTime(v uint32) *UserUpdateOne {
+	_u.mutation.ResetBannedTime()
+	_u.mutation.SetBannedTime(v)
+	return _u
+}
+
+// SetNillableBannedTime sets the "banned_time" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableBannedTime(v *uint32) *UserUpdateOne {
+	if v != nil {
+		_u.SetBannedTime(*v)
+	}
+	return _u
+}
+
+// AddBannedTime adds value to the "banned_time" field.
+func (_u *UserUpdateOne) AddBannedTime(v int32) *UserUpdateOne {
+	_u.mutation.AddBannedTime(v)
+	return _u
+}
+
+// ClearBannedTime clears the value of the "banned_time" field.
+func (_u *UserUpdateOne) ClearBannedTime() *UserUpdateOne {
+	_u.mutation.ClearBannedTime()
+	return _u
+}
+
+// SetUnbannedTime sets the "unbanned_time" field.
+func (_u *UserUpdateOne) SetUnbannedTime(v uint32) *UserUpdateOne {
+	_u.mutation.ResetUnbannedTime()
+	_u.mutation.SetUnbannedTime(v)
+	return _u
+}
+
+// SetNillableUnbannedTime sets the "unbanned_time" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableUnbannedTime(v *uint32) *UserUpdateOne {
+	if v != nil {
+		_u.SetUnbannedTime(*v)
+	}
+	return _u
+}
+
+// AddUnbannedTime adds value to the "unbanned_time" field.
+func (_u *UserUpdateOne) AddUnbannedTime(v int32) *UserUpdateOne {
+	_u.mutation.AddUnbannedTime(v)
+	return _u
+}
+
+// ClearUnbannedTime clears the value of the "unbanned_time" field.
+func (_u *UserUpdateOne) ClearUnbannedTime() *UserUpdateOne {
+	_u.mutation.ClearUnbannedTime()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserUpdateOne) SetUpdatedAt(v uint32) *UserUpdateOne {
+	_u.mutation.ResetUpdatedAt()
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableUpdatedAt(v *uint32) *UserUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// AddUpdatedAt adds value to the "updated_at" field.
+func (_u *UserUpdateOne) AddUpdatedAt(v int32) *UserUpdateOne {
+	_u.mutation.AddUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *UserUpdateOne) ClearUpdatedAt() *UserUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetSchool sets the "school" edge to the School entity.
+func (_u *UserUpdateOne) SetSchool(v *School) *UserUpdateOne {
+	return _u.SetSchoolID(v.ID)
+}
+
+// SetMajor sets the "major" edge to the Major entity.
+func (_u *UserUpdateOne) SetMajor(v *Major) *UserUpdateOne {
+	return _u.SetMajorID(v.ID)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
 }
 
+// ClearSchool clears the "school" edge to the School entity.
+func (_u *UserUpdateOne) ClearSchool() *UserUpdateOne {
+	_u.mutation.ClearSchool()
+	return _u
+}
+
+// ClearMajor clears the "major" edge to the Major entity.
+func (_u *UserUpdateOne) ClearMajor() *UserUpdateOne {
+	_u.mutation.ClearMajor()
+	return _u
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -190,6 +952,31 @@ func (_u *UserUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserUpdateOne) check() error {
+	if v, ok := _u.mutation.Nickname(); ok {
+		if err := user.NicknameValidator(v); err != nil {
+			return &ValidationError{Name: "nickname", err: fmt.Errorf(`ent: validator failed for field "User.nickname": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Phone(); ok {
+		if err := user.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "User.phone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Password(); ok {
+		if err := user.PasswordValidator(v); err != nil {
+			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AvatarURL(); ok {
+		if err := user.AvatarURLValidator(v); err != nil {
+			return &ValidationError{Name: "avatar_url", err: fmt.Errorf(`ent: validator failed for field "User.avatar_url": %w`, err)}
+		}
+	}
+	return nil
+}
+
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (_u *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdateOne {
 	_u.modifiers = append(_u.modifiers, modifiers...)
@@ -197,6 +984,9 @@ func (_u *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUp
 }
 
 func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -222,11 +1012,147 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 			}
 		}
 	}
-	if value, ok := _u.mutation.NickName(); ok {
-		_spec.SetField(user.FieldNickName, field.TypeInt64, value)
+	if value, ok := _u.mutation.Nickname(); ok {
+		_spec.SetField(user.FieldNickname, field.TypeString, value)
+	}
+	if _u.mutation.NicknameCleared() {
+		_spec.ClearField(user.FieldNickname, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(user.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(user.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Password(); ok {
+		_spec.SetField(user.FieldPassword, field.TypeString, value)
+	}
+	if _u.mutation.PasswordCleared() {
+		_spec.ClearField(user.FieldPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdmissionGrade(); ok {
+		_spec.SetField(user.FieldAdmissionGrade, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedAdmissionGrade(); ok {
+		_spec.AddField(user.FieldAdmissionGrade, field.TypeInt32, value)
+	}
+	if _u.mutation.AdmissionGradeCleared() {
+		_spec.ClearField(user.FieldAdmissionGrade, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.AvatarURL(); ok {
+		_spec.SetField(user.FieldAvatarURL, field.TypeString, value)
+	}
+	if _u.mutation.AvatarURLCleared() {
+		_spec.ClearField(user.FieldAvatarURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Experience(); ok {
+		_spec.SetField(user.FieldExperience, field.TypeInt32, value)
 	}
-	if value, ok := _u.mutation.AddedNickName(); ok {
-		_spec.AddField(user.FieldNickName, field.TypeInt64, value)
+	if value, ok := _u.mutation.AddedExperience(); ok {
+		_spec.AddField(user.FieldExperience, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(user.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(user.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.LogoffTime(); ok {
+		_spec.SetField(user.FieldLogoffTime, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedLogoffTime(); ok {
+		_spec.AddField(user.FieldLogoffTime, field.TypeUint32, value)
+	}
+	if _u.mutation.LogoffTimeCleared() {
+		_spec.ClearField(user.FieldLogoffTime, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.BannedTime(); ok {
+		_spec.SetField(user.FieldBannedTime, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedBannedTime(); ok {
+		_spec.AddField(user.FieldBannedTime, field.TypeUint32, value)
+	}
+	if _u.mutation.BannedTimeCleared() {
+		_spec.ClearField(user.FieldBannedTime, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UnbannedTime(); ok {
+		_spec.SetField(user.FieldUnbannedTime, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUnbannedTime(); ok {
+		_spec.AddField(user.FieldUnbannedTime, field.TypeUint32, value)
+	}
+	if _u.mutation.UnbannedTimeCleared() {
+		_spec.ClearField(user.FieldUnbannedTime, field.TypeUint32)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(user.FieldCreatedAt, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(user.FieldUpdatedAt, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(user.FieldUpdatedAt, field.TypeUint32)
+	}
+	if _u.mutation.SchoolCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.SchoolTable,
+			Columns: []string{user.SchoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SchoolIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.SchoolTable,
+			Columns: []string{user.SchoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(school.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MajorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.MajorTable,
+			Columns: []string{user.MajorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MajorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.MajorTable,
+			Columns: []string{user.MajorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(major.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &User{config: _u.config}
@@ -6,13 +6,19 @@ import (
 
 type Comment struct {
 	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	PostID     primitive.ObjectID `bson:"post_id" json:"post_id"`                         // 关联的帖子ID
-	UserID     uint64             `bson:"user_id" json:"user_id"`                         // 评论者ID
-	Content    string             `bson:"content" json:"content"`                         // 评论内容
-	ParentID   primitive.ObjectID `bson:"parent_id,omitempty" json:"parent_id,omitempty"` // 父评论ID（用于回复）
-	Anonymous  bool               `bson:"anonymous" json:"anonymous"`                     // 是否匿名评论
-	CreateTime uint               `bson:"create_time" json:"create_time"`                 // 创建时间
-	Status     int8               `bson:"status" json:"status"`                           // 状态：1-正常，0-删除
+	PostID     primitive.ObjectID `bson:"post_id" json:"post_id"`                             // 关联的帖子ID
+	UserID     uint64             `bson:"user_id" json:"user_id"`                             // 评论者ID
+	Content    string             `bson:"content" json:"content"`                             // 评论内容
+	ParentID   primitive.ObjectID `bson:"parent_id,omitempty" json:"parent_id,omitempty"`     // 父评论ID（用于回复）
+	ReplyToID  primitive.ObjectID `bson:"reply_to_id,omitempty" json:"reply_to_id,omitempty"` // 被回复的评论ID，回复楼中楼时与 ParentID 不同
+	ReplyCount int64              `bson:"reply_count" json:"reply_count"`                     // 回复数（含已删除的回复），只有顶层评论有
+	Anonymous  bool               `bson:"anonymous" json:"anonymous"`                         // 是否匿名评论
+	CreateTime uint               `bson:"create_time" json:"create_time"`                     // 创建时间
+	Status     int8               `bson:"status" json:"status"`                               // 状态：1-正常，0-删除
+}
+
+func (c *Comment) CollectionName() string {
+	return "comment"
 }
 
 const (
@@ -26,6 +32,8 @@ const (
 	CommentFieldUserID     = "user_id"
 	CommentFieldContent    = "content"
 	CommentFieldParentID   = "parent_id"
+	CommentFieldReplyToID  = "reply_to_id"
+	CommentFieldReplyCount = "reply_count"
 	CommentFieldAnonymous  = "anonymous"
 	CommentFieldCreateTime = "create_time"
 	CommentFieldStatus     = "status"
@@ -0,0 +1,82 @@
+package mongomodel
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Model 需要在启动时创建索引的集合
+type Model interface {
+	CollectionName() string
+	Indexes() []mongo.IndexModel
+}
+
+// Models 所有需要创建索引的集合
+func Models() []Model {
+	return []Model{&Post{}, &PostEditor{}, &PostLike{}, &PostCollect{}, &Comment{}, &Tag{}, &UserBehavior{}}
+}
+
+// Indexes 列表按 _id 倒序分页，筛选条件在前
+func (p *Post) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		index("idx_status_id", bson.D{{Key: PostFieldStatus, Value: 1}, {Key: PostFieldID, Value: -1}}),
+		index("idx_school_status_id", bson.D{{Key: PostFieldSchoolID, Value: 1}, {Key: PostFieldStatus, Value: 1}, {Key: PostFieldID, Value: -1}}),
+		index("idx_tags_status_id", bson.D{{Key: PostFieldTags, Value: 1}, {Key: PostFieldStatus, Value: 1}, {Key: PostFieldID, Value: -1}}),
+		index("idx_user_status_id", bson.D{{Key: PostFieldUserID, Value: 1}, {Key: PostFieldStatus, Value: 1}, {Key: PostFieldID, Value: -1}}),
+	}
+}
+
+// Indexes 草稿按用户分页
+func (p *PostEditor) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		index("idx_user_id", bson.D{{Key: PostEditorFieldUserID, Value: 1}, {Key: PostEditorFieldID, Value: -1}}),
+	}
+}
+
+// Indexes 每个用户对一个帖子只有一条点赞记录，取消点赞只修改状态
+func (pl *PostLike) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		uniqueIndex("uniq_post_user", bson.D{{Key: PostLikeFieldPostID, Value: 1}, {Key: PostLikeFieldUserID, Value: 1}}),
+	}
+}
+
+// Indexes 每个用户对一个帖子只有一条收藏记录
+func (p *PostCollect) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		uniqueIndex("uniq_post_user", bson.D{{Key: PostCollectFieldPostID, Value: 1}, {Key: PostCollectFieldUserID, Value: 1}}),
+	}
+}
+
+// Indexes 按帖子统计评论数，顶层评论按帖子分页，回复按顶层评论分页
+func (c *Comment) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		index("idx_post_status", bson.D{{Key: CommentFieldPostID, Value: 1}, {Key: CommentFieldStatus, Value: 1}}),
+		index("idx_post_parent_id", bson.D{{Key: CommentFieldPostID, Value: 1}, {Key: CommentFieldParentID, Value: 1}, {Key: CommentFieldID, Value: -1}}),
+		index("idx_parent_id", bson.D{{Key: CommentFieldParentID, Value: 1}, {Key: CommentFieldID, Value: 1}}),
+	}
+}
+
+// Indexes 标签名称唯一，按前缀补全使用名称索引；官方标签按使用次数排序
+func (t *Tag) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		uniqueIndex("uniq_name", bson.D{{Key: TagFieldName, Value: 1}}),
+		index("idx_status_official_count", bson.D{{Key: TagFieldStatus, Value: 1}, {Key: TagFieldIsOfficial, Value: 1}, {Key: TagFieldPostCount, Value: -1}}),
+		index("idx_merged_into", bson.D{{Key: TagFieldMergedInto, Value: 1}}),
+	}
+}
+
+// Indexes 推荐时按用户查询最近的行为
+func (u *UserBehavior) Indexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		index("idx_user_time", bson.D{{Key: UserBehaviorFieldUserID, Value: 1}, {Key: UserBehaviorFieldTimestamp, Value: -1}}),
+	}
+}
+
+func index(name string, keys bson.D) mongo.IndexModel {
+	return mongo.IndexModel{Keys: keys, Options: options.Index().SetName(name)}
+}
+
+func uniqueIndex(name string, keys bson.D) mongo.IndexModel {
+	return mongo.IndexModel{Keys: keys, Options: options.Index().SetName(name).SetUnique(true)}
+}
@@ -31,6 +31,7 @@ const (
 	PostFieldID            = "_id"
 	PostFieldUserID        = "user_id"
 	PostFieldSchoolID      = "school_id"
+	PostFieldTitle         = "title"
 	PostFieldContent       = "content"
 	PostFieldImages        = "images"
 	PostFieldTags          = "tags"
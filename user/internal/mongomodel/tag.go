@@ -12,7 +12,8 @@ type Tag struct {
 	IsOfficial  bool               `bson:"is_official" json:"is_official"`                     // 是否为官方标签
 	CreateTime  uint               `bson:"create_time" json:"create_time"`                     // 创建时间
 	UpdateTime  uint               `bson:"update_time" json:"update_time"`                     // 更新时间
-	Status      int                `bson:"status" json:"status"`                               // 状态：1-正常，0-删除
+	Status      int                `bson:"status" json:"status"`                               // 状态：1-正常，0-删除，2-已合并
+	MergedInto  string             `bson:"merged_into,omitempty" json:"merged_into,omitempty"` // 合并到的标签名称
 }
 
 func (t *Tag) CollectionName() string {
@@ -20,16 +21,19 @@ func (t *Tag) CollectionName() string {
 }
 
 const (
-	TagFieldID         = "_id"
-	TagFieldName       = "name"
-	TagFieldPostCount  = "post_count"
-	TagFieldIsOfficial = "is_official"
-	TagFieldStatus     = "status"
-	TagFieldCreateTime = "create_time"
-	TagFieldUpdateTime = "update_time"
+	TagFieldID          = "_id"
+	TagFieldName        = "name"
+	TagFieldDescription = "description"
+	TagFieldPostCount   = "post_count"
+	TagFieldIsOfficial  = "is_official"
+	TagFieldStatus      = "status"
+	TagFieldCreateTime  = "create_time"
+	TagFieldUpdateTime  = "update_time"
+	TagFieldMergedInto  = "merged_into"
 )
 
 const (
 	TagStatusNormal  = 1
 	TagStatusDeleted = 0
+	TagStatusMerged  = 2
 )
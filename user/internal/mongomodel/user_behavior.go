@@ -8,10 +8,11 @@ import (
 // UserBehavior 用户行为模型
 type UserBehavior struct {
 	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	UserID     uint64             `bson:"user_id" json:"user_id"`         // 用户ID
-	PostID     primitive.ObjectID `bson:"post_id" json:"post_id"`         // 帖子ID
-	ActionType string             `bson:"action_type" json:"action_type"` // 行为类型: like, comment, collect, view
-	Timestamp  uint               `bson:"timestamp" json:"timestamp"`     // 时间戳
+	UserID     uint64             `bson:"user_id" json:"user_id"`               // 用户ID
+	PostID     primitive.ObjectID `bson:"post_id" json:"post_id"`               // 帖子ID
+	ActionType string             `bson:"action_type" json:"action_type"`       // 行为类型: like, comment, collect, view
+	Tags       []string           `bson:"tags,omitempty" json:"tags,omitempty"` // 行为发生时帖子的标签，用于计算标签偏好
+	Timestamp  uint               `bson:"timestamp" json:"timestamp"`           // 时间戳
 }
 
 func (u *UserBehavior) CollectionName() string {
@@ -23,6 +24,7 @@ const (
 	UserBehaviorFieldUserID     = "user_id"
 	UserBehaviorFieldPostID     = "post_id"
 	UserBehaviorFieldActionType = "action_type"
+	UserBehaviorFieldTags       = "tags"
 	UserBehaviorFieldTimestamp  = "timestamp"
 )
 
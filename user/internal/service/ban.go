@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"math"
+
+	"common/jwtutils"
+	"grpc/user/user"
+	"user/internal/ent/schema"
+	entuser "user/internal/ent/user"
+	"user/pkg/auth"
+	"user/pkg/database"
+	"user/pkg/errors"
+)
+
+// Ban 封禁用户并下线所有设备，duration 为0时永久封禁，解封时间不能超过 uint32 范围，重复封禁以最后一次为准
+func (s *UserService) Ban(ctx context.Context, req *user_service.BanReq) (*user_service.Empty, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	now := unixNow()
+	// 解封时间为 uint32 秒，超出范围时截断会使封禁立即失效或解封时间早于当前
+	if req.UserId == 0 || req.Duration < 0 || req.Duration > int64(math.MaxUint32-now) {
+		return nil, errors.ParamsError
+	}
+
+	var unbannedTime uint32
+	if req.Duration > 0 {
+		unbannedTime = now + uint32(req.Duration)
+	}
+	n, err := database.GetEntClient().User.Update().
+		Where(entuser.ID(req.UserId), entuser.StatusIn(schema.UserStatusNormal, schema.UserStatusBanned)).
+		SetStatus(schema.UserStatusBanned).
+		SetBannedTime(now).
+		SetUnbannedTime(unbannedTime).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.NewDBError("ban user %d failed: %v", req.UserId, err)
+	}
+	if n == 0 {
+		return nil, errors.UserNotFound
+	}
+	if err := auth.GetSessionManager().LogoutAll(ctx, uint64(req.UserId)); err != nil {
+		return nil, errors.NewRedisError("revoke sessions of user %d failed: %v", req.UserId, err)
+	}
+	return &user_service.Empty{}, nil
+}
+
+// Unban 解封用户，解封时间记为当前时间
+func (s *UserService) Unban(ctx context.Context, req *user_service.UnbanReq) (*user_service.Empty, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.UserId == 0 {
+		return nil, errors.ParamsError
+	}
+
+	now := unixNow()
+	n, err := database.GetEntClient().User.Update().
+		Where(entuser.ID(req.UserId), entuser.Status(schema.UserStatusBanned)).
+		SetStatus(schema.UserStatusNormal).
+		SetUnbannedTime(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		// 同一手机号封禁期间不能注册，不会与正常账号冲突
+		return nil, errors.NewDBError("unban user %d failed: %v", req.UserId, err)
+	}
+	if n == 0 {
+		return nil, errors.UserNotFound
+	}
+	return &user_service.Empty{}, nil
+}
+
+// checkAdmin 当前用户需要 admin 角色
+func checkAdmin(ctx context.Context) error {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	if !claims.HasRole(jwtutils.RoleAdmin) {
+		return errors.PermissionDenied
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"common/applog"
+	"user/config"
+	"user/internal/mongomodel"
+	kafka "user/pkg/kafka"
+	"user/pkg/mongodbutils"
+
+	kafkago "github.com/segmentio/kafka-go"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var behaviorColl = (&mongomodel.UserBehavior{}).CollectionName()
+
+// BehaviorSender 发送用户行为事件，由 kafka 组件初始化时设置
+type BehaviorSender interface {
+	TrySend(data kafka.LogData) bool
+}
+
+var behaviorSender BehaviorSender
+
+// SetBehaviorSender 设置行为事件的发送者，未设置时不记录行为
+func SetBehaviorSender(s BehaviorSender) {
+	behaviorSender = s
+}
+
+// emitBehavior 异步发送用户行为事件，不影响请求结果；发送缓冲区满时丢弃。
+// 事件的 _id 在发送时生成，消费者重复写入时按 _id 去重
+func emitBehavior(ctx context.Context, userID uint64, post *mongomodel.Post, action string) {
+	topic := config.GetConfig().Content.BehaviorTopic
+	if behaviorSender == nil || topic == "" {
+		return
+	}
+	data, err := json.Marshal(&mongomodel.UserBehavior{
+		ID:         primitive.NewObjectID(),
+		UserID:     userID,
+		PostID:     post.ID,
+		ActionType: action,
+		Tags:       post.Tags,
+		Timestamp:  uint(unixNow()),
+	})
+	if err != nil {
+		applog.WrapGDPLogger(ctx).Warn("encode behavior event failed", err)
+		return
+	}
+	if !behaviorSender.TrySend(kafka.LogData{Topic: topic, Data: data}) {
+		applog.WrapGDPLogger(ctx).Warn(fmt.Sprintf("behavior event dropped: user=%d post=%s action=%s", userID, post.ID.Hex(), action))
+	}
+}
+
+// BehaviorConsumer 消费用户行为事件，按数量或时间间隔批量写入 mongodb，写入成功后提交偏移量
+type BehaviorConsumer struct {
+	reader   *kafka.KafkaReader
+	batch    int
+	interval time.Duration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// StartBehaviorConsumer 启动消费者，batch 条或 interval 时间到时写入一次
+func StartBehaviorConsumer(brokers []string, group, topic string, batch int, interval time.Duration) *BehaviorConsumer {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &BehaviorConsumer{
+		// 默认的 MinBytes 会让 broker 攒够 10KB 或等待 MaxWait（10秒）才返回，超过写入间隔
+		reader:   kafka.GetReaderWithMaxWait(brokers, group, topic, interval),
+		batch:    batch,
+		interval: interval,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	go c.loop(ctx)
+	return c
+}
+
+// Stop 停止消费，写入已拉取的事件后关闭
+func (c *BehaviorConsumer) Stop(ctx context.Context) error {
+	defer c.reader.Close()
+	c.cancel()
+	select {
+	case <-c.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *BehaviorConsumer) loop(ctx context.Context) {
+	defer close(c.done)
+	logger := applog.WrapGDPLogger(ctx)
+	var (
+		msgs     []kafkago.Message
+		docs     []interface{}
+		deadline time.Time
+	)
+	for {
+		if len(msgs) >= c.batch || (len(msgs) > 0 && !time.Now().Before(deadline)) {
+			if err := c.flush(msgs, docs); err != nil {
+				// 写入失败时保留本批，稍后重试，未提交的消息不会丢失
+				logger.Error("persist behavior events failed", err)
+				if !sleepCtx(ctx, time.Second) {
+					return
+				}
+				continue
+			}
+			msgs, docs = msgs[:0], docs[:0]
+		}
+		if len(msgs) == 0 {
+			deadline = time.Now().Add(c.interval)
+		}
+
+		fetchCtx, cancel := context.WithDeadline(ctx, deadline)
+		m, err := c.reader.R.FetchMessage(fetchCtx)
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				if len(msgs) > 0 {
+					if err := c.flush(msgs, docs); err != nil {
+						logger.Error("persist behavior events before stop failed", err)
+					}
+				}
+				return
+			}
+			if stderrors.Is(err, context.DeadlineExceeded) {
+				continue
+			}
+			logger.Warn("fetch behavior event failed", err)
+			if !sleepCtx(ctx, time.Second) {
+				return
+			}
+			continue
+		}
+
+		msgs = append(msgs, m)
+		var b mongomodel.UserBehavior
+		if err := json.Unmarshal(m.Value, &b); err != nil || b.ID.IsZero() {
+			// 无法解析的事件跳过，随本批一起提交
+			logger.Warn(fmt.Sprintf("invalid behavior event at partition %d offset %d", m.Partition, m.Offset), err)
+			continue
+		}
+		docs = append(docs, &b)
+	}
+}
+
+// flush 写入一批事件后提交偏移量；停止时 ctx 已取消，使用独立的超时
+func (c *BehaviorConsumer) flush(msgs []kafkago.Message, docs []interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if len(docs) > 0 {
+		if err := mongodbutils.InsertManyIgnoreDuplicate(ctx, behaviorColl, docs); err != nil {
+			return err
+		}
+	}
+	if err := c.reader.R.CommitMessages(ctx, msgs...); err != nil {
+		return fmt.Errorf("commit behavior events: %w", err)
+	}
+	return nil
+}
+
+// sleepCtx 等待 d，ctx 取消时返回 false
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
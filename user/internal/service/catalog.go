@@ -0,0 +1,365 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"common/applog"
+	"grpc/user/user"
+	"user/internal/ent"
+	"user/internal/ent/major"
+	"user/internal/ent/schema"
+	"user/internal/ent/school"
+	"user/internal/ent/schoolmajor"
+	"user/pkg/constants"
+	"user/pkg/database"
+	"user/pkg/errors"
+	"user/pkg/redisutils"
+)
+
+// CatalogService 学校、专业目录，查询结果缓存在 redis，修改后递增缓存版本使旧缓存失效
+type CatalogService struct {
+	user_service.UnimplementedCatalogServer
+}
+
+func NewCatalogService() *CatalogService {
+	return &CatalogService{}
+}
+
+// ListSchools 分页查询学校，默认只返回启用的学校
+func (s *CatalogService) ListSchools(ctx context.Context, req *user_service.ListSchoolsReq) (*user_service.ListSchoolsResp, error) {
+	if req.IncludeDisabled {
+		if err := checkAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+	offset, limit := pagination(req.Page, req.PageSize)
+	keyword, err := checkKeyword(req.Keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	key := catalogCacheKey(ctx, "schools", offset, limit, req.IncludeDisabled, keyword)
+	return loadCatalog(ctx, key, func(ctx context.Context) (*user_service.ListSchoolsResp, error) {
+		query := database.GetEntClient().School.Query()
+		if !req.IncludeDisabled {
+			query.Where(school.Status(schema.SchoolStatusEnabled))
+		}
+		if keyword != "" {
+			query.Where(school.NameContains(keyword))
+		}
+		total, err := query.Clone().Count(ctx)
+		if err != nil {
+			return nil, errors.NewDBError("count schools failed: %v", err)
+		}
+		schools, err := query.Order(ent.Asc(school.FieldID)).Offset(offset).Limit(limit).All(ctx)
+		if err != nil {
+			return nil, errors.NewDBError("list schools failed: %v", err)
+		}
+		resp := &user_service.ListSchoolsResp{Total: int64(total)}
+		for _, sc := range schools {
+			resp.List = append(resp.List, toSchool(sc))
+		}
+		return resp, nil
+	})
+}
+
+// ListMajors 分页查询专业
+func (s *CatalogService) ListMajors(ctx context.Context, req *user_service.ListMajorsReq) (*user_service.ListMajorsResp, error) {
+	offset, limit := pagination(req.Page, req.PageSize)
+	keyword, err := checkKeyword(req.Keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	key := catalogCacheKey(ctx, "majors", offset, limit, keyword)
+	return loadCatalog(ctx, key, func(ctx context.Context) (*user_service.ListMajorsResp, error) {
+		query := database.GetEntClient().Major.Query()
+		if keyword != "" {
+			query.Where(major.NameContains(keyword))
+		}
+		total, err := query.Clone().Count(ctx)
+		if err != nil {
+			return nil, errors.NewDBError("count majors failed: %v", err)
+		}
+		majors, err := query.Order(ent.Asc(major.FieldID)).Offset(offset).Limit(limit).All(ctx)
+		if err != nil {
+			return nil, errors.NewDBError("list majors failed: %v", err)
+		}
+		resp := &user_service.ListMajorsResp{Total: int64(total)}
+		for _, m := range majors {
+			resp.List = append(resp.List, toMajor(m))
+		}
+		return resp, nil
+	})
+}
+
+// GetSchoolMajors 查询启用的学校开设的专业
+func (s *CatalogService) GetSchoolMajors(ctx context.Context, req *user_service.GetSchoolMajorsReq) (*user_service.MajorList, error) {
+	if req.SchoolId == 0 {
+		return nil, errors.ParamsError
+	}
+
+	key := catalogCacheKey(ctx, "school_majors", req.SchoolId)
+	return loadCatalog(ctx, key, func(ctx context.Context) (*user_service.MajorList, error) {
+		if err := checkSchool(ctx, req.SchoolId); err != nil {
+			return nil, err
+		}
+		majors, err := database.GetEntClient().Major.Query().
+			Where(major.HasSchoolsWith(school.ID(req.SchoolId))).
+			Order(ent.Asc(major.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, errors.NewDBError("query majors of school %d failed: %v", req.SchoolId, err)
+		}
+		resp := &user_service.MajorList{}
+		for _, m := range majors {
+			resp.List = append(resp.List, toMajor(m))
+		}
+		return resp, nil
+	})
+}
+
+// CreateSchool 创建学校，默认启用
+func (s *CatalogService) CreateSchool(ctx context.Context, req *user_service.CreateSchoolReq) (*user_service.School, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" || utf8.RuneCountInString(name) > constants.CatalogNameMaxLen || len(req.LogoUrl) > constants.AvatarURLMaxLen {
+		return nil, errors.ParamsError
+	}
+
+	now := unixNow()
+	sc, err := database.GetEntClient().School.Create().
+		SetName(name).
+		SetLogoURL(req.LogoUrl).
+		SetStatus(schema.SchoolStatusEnabled).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.SchoolExists
+		}
+		return nil, errors.NewDBError("create school failed: %v", err)
+	}
+	invalidateCatalog(ctx)
+	return toSchool(sc), nil
+}
+
+// CreateMajor 创建专业，专业名称全局唯一，通过 AddSchoolMajors 关联到学校
+func (s *CatalogService) CreateMajor(ctx context.Context, req *user_service.CreateMajorReq) (*user_service.Major, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" || utf8.RuneCountInString(name) > constants.CatalogNameMaxLen ||
+		utf8.RuneCountInString(req.Description) > constants.CatalogDescMaxLen {
+		return nil, errors.ParamsError
+	}
+
+	now := unixNow()
+	m, err := database.GetEntClient().Major.Create().
+		SetName(name).
+		SetDescription(req.Description).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.MajorExists
+		}
+		return nil, errors.NewDBError("create major failed: %v", err)
+	}
+	invalidateCatalog(ctx)
+	return toMajor(m), nil
+}
+
+// AddSchoolMajors 为学校添加专业，已存在的关联忽略
+func (s *CatalogService) AddSchoolMajors(ctx context.Context, req *user_service.AddSchoolMajorsReq) (*user_service.Empty, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	majorIDs := uniqueIDs(req.MajorIds)
+	if req.SchoolId == 0 || len(majorIDs) == 0 || len(majorIDs) > constants.MaxPageSize {
+		return nil, errors.ParamsError
+	}
+
+	// 校验和写入在同一事务中，事务内读主库，刚创建的学校、专业不受从库延迟影响
+	err := database.WithTx(ctx, func(ctx context.Context, tx *ent.Tx) error {
+		exist, err := tx.School.Query().Where(school.ID(req.SchoolId)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("query school: %w", err)
+		}
+		if !exist {
+			return errors.SchoolNotFound
+		}
+		n, err := tx.Major.Query().Where(major.IDIn(majorIDs...)).Count(ctx)
+		if err != nil {
+			return fmt.Errorf("query majors: %w", err)
+		}
+		if n != len(majorIDs) {
+			return errors.MajorNotFound
+		}
+
+		now := unixNow()
+		builders := make([]*ent.SchoolMajorCreate, 0, len(majorIDs))
+		for _, id := range majorIDs {
+			builders = append(builders, tx.SchoolMajor.Create().SetSchoolID(req.SchoolId).SetMajorID(id).SetCreatedAt(now))
+		}
+		if err := tx.SchoolMajor.CreateBulk(builders...).OnConflict().Ignore().Exec(ctx); err != nil {
+			return err
+		}
+		database.AfterCommit(ctx, invalidateCatalog)
+		return nil
+	})
+	if err != nil {
+		switch {
+		case stderrors.Is(err, errors.SchoolNotFound):
+			return nil, errors.SchoolNotFound
+		case stderrors.Is(err, errors.MajorNotFound):
+			return nil, errors.MajorNotFound
+		}
+		return nil, errors.NewDBError("add majors to school %d failed: %v", req.SchoolId, err)
+	}
+	return &user_service.Empty{}, nil
+}
+
+// SetSchoolStatus 启用/停用学校，停用后不出现在列表中，已选择该学校的用户不受影响
+func (s *CatalogService) SetSchoolStatus(ctx context.Context, req *user_service.SetSchoolStatusReq) (*user_service.Empty, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	status := int8(req.Status)
+	if req.SchoolId == 0 || (status != schema.SchoolStatusEnabled && status != schema.SchoolStatusDisabled) {
+		return nil, errors.ParamsError
+	}
+
+	n, err := database.GetEntClient().School.Update().
+		Where(school.ID(req.SchoolId)).
+		SetStatus(status).
+		SetUpdatedAt(unixNow()).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.NewDBError("set status of school %d failed: %v", req.SchoolId, err)
+	}
+	if n == 0 {
+		return nil, errors.SchoolNotFound
+	}
+	invalidateCatalog(ctx)
+	return &user_service.Empty{}, nil
+}
+
+// checkSchool 学校存在且已启用
+func checkSchool(ctx context.Context, schoolID uint32) error {
+	exist, err := database.GetEntClient().School.Query().
+		Where(school.ID(schoolID), school.Status(schema.SchoolStatusEnabled)).
+		Exist(ctx)
+	if err != nil {
+		return errors.NewDBError("query school %d failed: %v", schoolID, err)
+	}
+	if !exist {
+		return errors.SchoolNotFound
+	}
+	return nil
+}
+
+// checkSchoolMajor 学校开设了该专业
+func checkSchoolMajor(ctx context.Context, schoolID, majorID uint32) error {
+	exist, err := database.GetEntClient().SchoolMajor.Query().
+		Where(schoolmajor.SchoolID(schoolID), schoolmajor.MajorID(majorID)).
+		Exist(ctx)
+	if err != nil {
+		return errors.NewDBError("query major %d of school %d failed: %v", majorID, schoolID, err)
+	}
+	if !exist {
+		return errors.MajorNotInSchool
+	}
+	return nil
+}
+
+// catalogCacheKey 带缓存版本的键，读取版本失败时返回空，不使用缓存
+func catalogCacheKey(ctx context.Context, parts ...any) string {
+	version, _, err := redisutils.Get(ctx, constants.CatalogVersionKey)
+	if err != nil {
+		applog.WrapGDPLogger(ctx).Warn("get catalog cache version failed", err)
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("catalog:v")
+	b.WriteString(version)
+	for _, p := range parts {
+		b.WriteString(":")
+		b.WriteString(fmt.Sprint(p))
+	}
+	return b.String()
+}
+
+// loadCatalog 读取目录缓存，未命中时从主库加载，避免目录修改后从库的旧数据被重新缓存
+func loadCatalog[T any](ctx context.Context, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	return redisutils.GetOrLoad(database.WithMaster(ctx), key, constants.CatalogCacheTTL, load)
+}
+
+// invalidateCatalog 递增缓存版本，失败时旧缓存在过期后失效
+func invalidateCatalog(ctx context.Context) {
+	if _, err := redisutils.Incr(ctx, constants.CatalogVersionKey); err != nil {
+		applog.WrapGDPLogger(ctx).Warn("invalidate catalog cache failed", err)
+	}
+}
+
+func checkKeyword(keyword string) (string, error) {
+	keyword = strings.TrimSpace(keyword)
+	if utf8.RuneCountInString(keyword) > constants.CatalogKeywordMaxLen {
+		return "", errors.ParamsError
+	}
+	return keyword, nil
+}
+
+// pagination page 从1开始，返回 offset、limit
+func pagination(page, pageSize int32) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = constants.DefaultPageSize
+	}
+	if pageSize > constants.MaxPageSize {
+		pageSize = constants.MaxPageSize
+	}
+	return int(page-1) * int(pageSize), int(pageSize)
+}
+
+func uniqueIDs(ids []uint32) []uint32 {
+	seen := make(map[uint32]struct{}, len(ids))
+	out := make([]uint32, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok || id == 0 {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+	}
+	return out
+}
+
+func toSchool(sc *ent.School) *user_service.School {
+	return &user_service.School{
+		Id:        sc.ID,
+		Name:      sc.Name,
+		LogoUrl:   sc.LogoURL,
+		Status:    int32(sc.Status),
+		CreatedAt: sc.CreatedAt,
+	}
+}
+
+func toMajor(m *ent.Major) *user_service.Major {
+	return &user_service.Major{
+		Id:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+	}
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+
+	"common/jwtutils"
+	"grpc/user/user"
+	"user/internal/mongomodel"
+	"user/pkg/constants"
+	"user/pkg/errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 评论分两层：顶层评论和回复，回复楼中楼时归到所在的顶层评论下。
+// 删除评论后记录保留，列表中以占位显示，有回复的顶层评论删除后楼层不变
+
+// ListComments 按时间倒序查询帖子的顶层评论，每条附带最早的几条回复；
+// 已删除且没有回复的顶层评论不返回
+func (s *ContentService) ListComments(ctx context.Context, req *user_service.ListCommentsReq) (*user_service.CommentList, error) {
+	viewer, _ := jwtutils.ClaimsFromContext(ctx)
+	postID, err := objectID(req.PostId)
+	if err != nil {
+		return nil, err
+	}
+	post, err := getPost(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if !canView(post, viewer) {
+		return nil, errors.PostNotFound
+	}
+
+	filter := bson.M{
+		mongomodel.CommentFieldPostID:   postID,
+		mongomodel.CommentFieldParentID: nil,
+		"$or": []bson.M{
+			{mongomodel.CommentFieldStatus: mongomodel.CommentStatusNormal},
+			{mongomodel.CommentFieldReplyCount: bson.M{"$gt": 0}},
+		},
+	}
+	comments, next, err := findPage(ctx, commentColl, filter, req.Cursor, req.Limit, commentID)
+	if err != nil {
+		return nil, err
+	}
+	n := replyLimit(req.ReplyLimit)
+	resp := &user_service.CommentList{NextCursor: next}
+	for _, c := range comments {
+		item := toComment(c, viewer)
+		if c.ReplyCount > 0 {
+			replies, _, err := findPageSorted(ctx, commentColl, bson.M{mongomodel.CommentFieldParentID: c.ID}, "", n, true, commentID)
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range replies {
+				item.Replies = append(item.Replies, toComment(r, viewer))
+			}
+		}
+		resp.List = append(resp.List, item)
+	}
+	return resp, nil
+}
+
+// ListReplies 按时间正序查询顶层评论的回复
+func (s *ContentService) ListReplies(ctx context.Context, req *user_service.ListRepliesReq) (*user_service.CommentList, error) {
+	viewer, _ := jwtutils.ClaimsFromContext(ctx)
+	id, err := objectID(req.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := findComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !comment.ParentID.IsZero() {
+		// 回复没有下一层，回复楼中楼的评论在顶层评论下查询
+		return nil, errors.ParamsError
+	}
+	if comment.Status != mongomodel.CommentStatusNormal && comment.ReplyCount == 0 {
+		return nil, errors.CommentNotFound
+	}
+	post, err := getPost(ctx, comment.PostID)
+	if err != nil {
+		return nil, err
+	}
+	if !canView(post, viewer) {
+		return nil, errors.PostNotFound
+	}
+
+	replies, next, err := findPageSorted(ctx, commentColl, bson.M{mongomodel.CommentFieldParentID: id}, req.Cursor, req.Limit, true, commentID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &user_service.CommentList{NextCursor: next}
+	for _, r := range replies {
+		resp.List = append(resp.List, toComment(r, viewer))
+	}
+	return resp, nil
+}
+
+// replyLimit 顶层评论附带的回复数，默认3、最大10
+func replyLimit(limit int32) int32 {
+	if limit <= 0 {
+		return constants.CommentReplyLimit
+	}
+	if limit > constants.CommentReplyLimitMax {
+		return constants.CommentReplyLimitMax
+	}
+	return limit
+}
+
+func commentID(c *mongomodel.Comment) primitive.ObjectID {
+	return c.ID
+}
@@ -0,0 +1,582 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"unicode/utf8"
+
+	"common/jwtutils"
+	"grpc/user/user"
+	"user/internal/mongomodel"
+	"user/pkg/constants"
+	"user/pkg/errors"
+	"user/pkg/mongodbutils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	postColl  = (&mongomodel.Post{}).CollectionName()
+	draftColl = (&mongomodel.PostEditor{}).CollectionName()
+)
+
+// ContentService 帖子和草稿，数据存储在 mongodb，列表按 _id 倒序游标分页
+type ContentService struct {
+	user_service.UnimplementedContentServer
+}
+
+func NewContentService() *ContentService {
+	return &ContentService{}
+}
+
+// SaveDraft 保存草稿，id 为空时新建
+func (s *ContentService) SaveDraft(ctx context.Context, req *user_service.SaveDraftReq) (*user_service.Draft, error) {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	draft, err := checkDraft(req)
+	if err != nil {
+		return nil, err
+	}
+	draft.UserID = claims.UserID
+	draft.UpdateTime = uint(unixNow())
+
+	if req.Id == "" {
+		id, err := mongodbutils.InsertOne(ctx, draftColl, draft)
+		if err != nil {
+			return nil, errors.NewDBError("create draft of user %d failed: %v", claims.UserID, err)
+		}
+		draft.ID = id
+		return toDraft(draft), nil
+	}
+
+	if draft.ID, err = objectID(req.Id); err != nil {
+		return nil, err
+	}
+	res, err := mongodbutils.UpdateOne(ctx, draftColl,
+		bson.M{mongomodel.PostEditorFieldID: draft.ID, mongomodel.PostEditorFieldUserID: claims.UserID},
+		bson.M{"$set": bson.M{
+			mongomodel.PostEditorFieldTitle:      draft.Title,
+			mongomodel.PostEditorFieldContent:    draft.Content,
+			mongomodel.PostEditorFieldTags:       draft.Tags,
+			mongomodel.PostEditorFieldImageUrls:  draft.Images,
+			mongomodel.PostEditorFieldUpdateTime: draft.UpdateTime,
+		}})
+	if err != nil {
+		return nil, errors.NewDBError("update draft %s failed: %v", req.Id, err)
+	}
+	if res.MatchedCount == 0 {
+		return nil, errors.DraftNotFound
+	}
+	return toDraft(draft), nil
+}
+
+// ListDrafts 当前用户的草稿，按创建时间倒序
+func (s *ContentService) ListDrafts(ctx context.Context, req *user_service.ListDraftsReq) (*user_service.DraftList, error) {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{mongomodel.PostEditorFieldUserID: claims.UserID}
+	drafts, next, err := findPage(ctx, draftColl, filter, req.Cursor, req.Limit, func(d *mongomodel.PostEditor) primitive.ObjectID { return d.ID })
+	if err != nil {
+		return nil, err
+	}
+	resp := &user_service.DraftList{NextCursor: next}
+	for _, d := range drafts {
+		resp.List = append(resp.List, toDraft(d))
+	}
+	return resp, nil
+}
+
+// DeleteDraft 删除草稿
+func (s *ContentService) DeleteDraft(ctx context.Context, req *user_service.DeleteDraftReq) (*user_service.Empty, error) {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := objectID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	res, err := mongodbutils.DeleteOne(ctx, draftColl, bson.M{mongomodel.PostEditorFieldID: id, mongomodel.PostEditorFieldUserID: claims.UserID})
+	if err != nil {
+		return nil, errors.NewDBError("delete draft %s failed: %v", req.Id, err)
+	}
+	if res.DeletedCount == 0 {
+		return nil, errors.DraftNotFound
+	}
+	return &user_service.Empty{}, nil
+}
+
+// PublishDraft 发布草稿，学校取作者资料中的学校；帖子写入和草稿删除在同一个事务中，草稿只能发布一次
+func (s *ContentService) PublishDraft(ctx context.Context, req *user_service.PublishDraftReq) (*user_service.Post, error) {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := objectID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	anonymousName := strings.TrimSpace(req.AnonymousName)
+	if utf8.RuneCountInString(anonymousName) > constants.AnonymousNameMaxLen {
+		return nil, errors.ParamsError
+	}
+	u, err := getUser(ctx, int64(claims.UserID))
+	if err != nil {
+		return nil, err
+	}
+	if err := checkBanned(ctx, u); err != nil {
+		return nil, err
+	}
+
+	var draft mongomodel.PostEditor
+	draftFilter := bson.M{mongomodel.PostEditorFieldID: id, mongomodel.PostEditorFieldUserID: claims.UserID}
+	if err := mongodbutils.FindOne(ctx, draftColl, draftFilter, &draft); err != nil {
+		return nil, errors.NewDBError("query draft %s failed: %v", req.Id, err)
+	}
+	if draft.ID.IsZero() {
+		return nil, errors.DraftNotFound
+	}
+	// 草稿允许为空，发布时需要有正文或图片
+	if strings.TrimSpace(draft.Content) == "" && len(draft.Images) == 0 {
+		return nil, errors.ParamsError
+	}
+	// 草稿中的标签可能在规范化之前保存
+	tags, err := checkTags(draft.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if tags, err = resolveTags(ctx, tags); err != nil {
+		return nil, err
+	}
+
+	now := uint(unixNow())
+	post := &mongomodel.Post{
+		ID:         primitive.NewObjectID(),
+		UserID:     claims.UserID,
+		SchoolID:   uint(u.SchoolID),
+		Title:      draft.Title,
+		Content:    draft.Content,
+		Images:     nonNil(draft.Images),
+		Tags:       tags,
+		Anonymous:  req.Anonymous,
+		CreateTime: now,
+		UpdateTime: now,
+		Status:     mongomodel.PostStatusNormal,
+	}
+	if req.Anonymous {
+		post.AnonymousName = anonymousName
+		if post.AnonymousName == "" {
+			post.AnonymousName = constants.DefaultAnonymousName
+		}
+	}
+	if req.Private {
+		post.Status = mongomodel.PostStatusPrivate
+	}
+
+	err = withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		if _, err := mongodbutils.InsertOne(ctx, postColl, post); err != nil {
+			return err
+		}
+		res, err := mongodbutils.DeleteOne(ctx, draftColl, draftFilter)
+		if err != nil {
+			return err
+		}
+		// 并发发布同一草稿时只有一个成功
+		if res.DeletedCount == 0 {
+			return errors.DraftNotFound
+		}
+		return adjustTagCounts(ctx, nil, post.Tags)
+	})
+	if err != nil {
+		if stderrors.Is(err, errors.DraftNotFound) {
+			return nil, errors.DraftNotFound
+		}
+		return nil, errors.NewDBError("publish draft %s failed: %v", req.Id, err)
+	}
+	return toPost(post, claims), nil
+}
+
+// GetPost 查询帖子，非正常状态的帖子只有作者和 admin 可见
+func (s *ContentService) GetPost(ctx context.Context, req *user_service.GetPostReq) (*user_service.Post, error) {
+	id, err := objectID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	post, err := getPost(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	viewer, _ := jwtutils.ClaimsFromContext(ctx)
+	if !canView(post, viewer) {
+		return nil, errors.PostNotFound
+	}
+	if viewer != nil && viewer.UserID != post.UserID {
+		emitBehavior(ctx, viewer.UserID, post, mongomodel.ActionTypeView)
+	}
+	return toPost(post, viewer), nil
+}
+
+// ListPosts 按学校、标签、发布者查询正常状态的帖子；查询自己的帖子时包含仅自己可见和审核中的帖子，
+// 查询他人的帖子时不包含匿名帖子
+func (s *ContentService) ListPosts(ctx context.Context, req *user_service.ListPostsReq) (*user_service.PostList, error) {
+	viewer, _ := jwtutils.ClaimsFromContext(ctx)
+	filter := bson.M{mongomodel.PostFieldStatus: mongomodel.PostStatusNormal}
+	if req.SchoolId != 0 {
+		filter[mongomodel.PostFieldSchoolID] = req.SchoolId
+	}
+	if tag := normalizeTag(req.Tag); tag != "" {
+		if utf8.RuneCountInString(tag) > constants.PostTagMaxLen {
+			return nil, errors.ParamsError
+		}
+		tags, err := resolveTags(ctx, []string{tag})
+		if err != nil {
+			return nil, err
+		}
+		filter[mongomodel.PostFieldTags] = tags[0]
+	}
+	if req.UserId != 0 {
+		filter[mongomodel.PostFieldUserID] = req.UserId
+		if viewer != nil && viewer.UserID == req.UserId {
+			filter[mongomodel.PostFieldStatus] = bson.M{"$in": []int{
+				mongomodel.PostStatusNormal, mongomodel.PostStatusAudit, mongomodel.PostStatusFail, mongomodel.PostStatusPrivate,
+			}}
+		} else {
+			filter[mongomodel.PostFieldAnonymous] = false
+		}
+	}
+
+	posts, next, err := findPage(ctx, postColl, filter, req.Cursor, req.Limit, func(p *mongomodel.Post) primitive.ObjectID { return p.ID })
+	if err != nil {
+		return nil, err
+	}
+	resp := &user_service.PostList{NextCursor: next}
+	for _, p := range posts {
+		resp.List = append(resp.List, toPost(p, viewer))
+	}
+	return resp, nil
+}
+
+// DeletePost 删除帖子（status=0），作者本人或 admin 可删除
+func (s *ContentService) DeletePost(ctx context.Context, req *user_service.DeletePostReq) (*user_service.Empty, error) {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := objectID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	post, err := getPost(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID != claims.UserID && !claims.HasRole(jwtutils.RoleAdmin) {
+		return nil, errors.PermissionDenied
+	}
+
+	err = withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		res, err := mongodbutils.UpdateOne(ctx, postColl,
+			bson.M{mongomodel.PostFieldID: id, mongomodel.PostFieldStatus: bson.M{"$ne": mongomodel.PostStatusDeleted}},
+			bson.M{"$set": bson.M{mongomodel.PostFieldStatus: mongomodel.PostStatusDeleted, mongomodel.PostFieldUpdateTime: uint(unixNow())}})
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount == 0 {
+			return errors.PostNotFound
+		}
+		// 帖子的标签在删除前可能被修改，以事务中读到的为准
+		var cur mongomodel.Post
+		if err := mongodbutils.FindById(ctx, postColl, id, &cur); err != nil {
+			return err
+		}
+		return adjustTagCounts(ctx, cur.Tags, nil)
+	})
+	if err != nil {
+		if stderrors.Is(err, errors.PostNotFound) {
+			return nil, errors.PostNotFound
+		}
+		return nil, errors.NewDBError("delete post %s failed: %v", req.Id, err)
+	}
+	return &user_service.Empty{}, nil
+}
+
+// SetPostVisibility 作者设置帖子仅自己可见或恢复正常，审核中和审核失败的帖子不能修改
+func (s *ContentService) SetPostVisibility(ctx context.Context, req *user_service.SetPostVisibilityReq) (*user_service.Post, error) {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := objectID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	post, err := getPost(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID != claims.UserID {
+		return nil, errors.PermissionDenied
+	}
+
+	status := mongomodel.PostStatusNormal
+	if req.Private {
+		status = mongomodel.PostStatusPrivate
+	}
+	if post.Status == status {
+		return toPost(post, claims), nil
+	}
+	if post.Status != mongomodel.PostStatusNormal && post.Status != mongomodel.PostStatusPrivate {
+		return nil, errors.ParamsError
+	}
+
+	now := uint(unixNow())
+	// 以读取时的状态为条件，避免覆盖并发的删除
+	res, err := mongodbutils.UpdateOne(ctx, postColl,
+		bson.M{mongomodel.PostFieldID: id, mongomodel.PostFieldStatus: post.Status},
+		bson.M{"$set": bson.M{mongomodel.PostFieldStatus: status, mongomodel.PostFieldUpdateTime: now}})
+	if err != nil {
+		return nil, errors.NewDBError("set visibility of post %s failed: %v", req.Id, err)
+	}
+	if res.MatchedCount == 0 {
+		return nil, errors.PostNotFound
+	}
+	post.Status, post.UpdateTime = status, now
+	return toPost(post, claims), nil
+}
+
+// UpdatePost 作者修改帖子内容，不修改状态；标签的帖子数按修改前后的差异在同一个事务中修改
+func (s *ContentService) UpdatePost(ctx context.Context, req *user_service.UpdatePostReq) (*user_service.Post, error) {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := objectID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	edit, err := checkContent(req.Title, req.Content, req.Tags, req.Images)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(edit.Content) == "" && len(edit.Images) == 0 {
+		return nil, errors.ParamsError
+	}
+	post, err := getPost(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID != claims.UserID {
+		return nil, errors.PermissionDenied
+	}
+	if err := checkUserBanned(ctx, claims.UserID); err != nil {
+		return nil, err
+	}
+	tags, err := resolveTags(ctx, edit.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uint(unixNow())
+	err = withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		// 并发修改同一帖子时写冲突由驱动重试，重试时读到最新的标签
+		var cur mongomodel.Post
+		if err := mongodbutils.FindById(ctx, postColl, id, &cur); err != nil {
+			return err
+		}
+		if cur.ID.IsZero() || cur.Status == mongomodel.PostStatusDeleted {
+			return errors.PostNotFound
+		}
+		_, err := mongodbutils.UpdateById(ctx, postColl, id, bson.M{"$set": bson.M{
+			mongomodel.PostFieldTitle:      edit.Title,
+			mongomodel.PostFieldContent:    edit.Content,
+			mongomodel.PostFieldImages:     edit.Images,
+			mongomodel.PostFieldTags:       tags,
+			mongomodel.PostFieldUpdateTime: now,
+		}})
+		if err != nil {
+			return err
+		}
+		*post = cur
+		return adjustTagCounts(ctx, cur.Tags, tags)
+	})
+	if err != nil {
+		if stderrors.Is(err, errors.PostNotFound) {
+			return nil, errors.PostNotFound
+		}
+		return nil, errors.NewDBError("update post %s failed: %v", req.Id, err)
+	}
+	post.Title, post.Content, post.Images, post.Tags, post.UpdateTime = edit.Title, edit.Content, edit.Images, tags, now
+	return toPost(post, claims), nil
+}
+
+// getPost 查询未删除的帖子
+func getPost(ctx context.Context, id primitive.ObjectID) (*mongomodel.Post, error) {
+	var post mongomodel.Post
+	if err := mongodbutils.FindById(ctx, postColl, id, &post); err != nil {
+		return nil, errors.NewDBError("query post %s failed: %v", id.Hex(), err)
+	}
+	if post.ID.IsZero() || post.Status == mongomodel.PostStatusDeleted {
+		return nil, errors.PostNotFound
+	}
+	return &post, nil
+}
+
+// canView 正常状态的帖子所有人可见，其他状态只有作者和 admin 可见
+func canView(post *mongomodel.Post, viewer *jwtutils.Claims) bool {
+	if post.Status == mongomodel.PostStatusNormal {
+		return true
+	}
+	return viewer != nil && (viewer.UserID == post.UserID || viewer.HasRole(jwtutils.RoleAdmin))
+}
+
+// findPage 按 _id 倒序查询一页，多查一条判断是否有下一页，返回下一页的游标
+func findPage[T any](ctx context.Context, coll string, filter bson.M, cursor string, limit int32, id func(T) primitive.ObjectID) ([]T, string, error) {
+	return findPageSorted(ctx, coll, filter, cursor, limit, false, id)
+}
+
+// findPageSorted 同 findPage，asc 为 true 时按 _id 正序
+func findPageSorted[T any](ctx context.Context, coll string, filter bson.M, cursor string, limit int32, asc bool, id func(T) primitive.ObjectID) ([]T, string, error) {
+	order, op := -1, "$lt"
+	if asc {
+		order, op = 1, "$gt"
+	}
+	if cursor != "" {
+		after, err := objectID(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		filter["_id"] = bson.M{op: after}
+	}
+	n := pageLimit(limit)
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: order}}).SetLimit(int64(n + 1))
+	var results []T
+	if err := mongodbutils.Find(ctx, coll, filter, &results, opts); err != nil {
+		return nil, "", errors.NewDBError("query %s failed: %v", coll, err)
+	}
+	if len(results) <= n {
+		return results, "", nil
+	}
+	results = results[:n]
+	return results, id(results[n-1]).Hex(), nil
+}
+
+// pageLimit 游标分页每页数量，默认20、最大100
+func pageLimit(limit int32) int {
+	_, n := pagination(1, limit)
+	return n
+}
+
+func objectID(s string) (primitive.ObjectID, error) {
+	id, err := primitive.ObjectIDFromHex(s)
+	if err != nil {
+		return primitive.NilObjectID, errors.ParamsError
+	}
+	return id, nil
+}
+
+// checkDraft 校验草稿内容，标签规范化后去重
+func checkDraft(req *user_service.SaveDraftReq) (*mongomodel.PostEditor, error) {
+	return checkContent(req.Title, req.Content, req.Tags, req.Images)
+}
+
+// checkContent 校验草稿和帖子的内容
+func checkContent(title, content string, tags, images []string) (*mongomodel.PostEditor, error) {
+	title = strings.TrimSpace(title)
+	if utf8.RuneCountInString(title) > constants.PostTitleMaxLen ||
+		utf8.RuneCountInString(content) > constants.PostContentMaxLen ||
+		len(images) > constants.PostImagesMax {
+		return nil, errors.ParamsError
+	}
+	for _, img := range images {
+		if img == "" || len(img) > constants.PostImageURLMaxLen {
+			return nil, errors.ParamsError
+		}
+	}
+	tags, err := checkTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	return &mongomodel.PostEditor{
+		Title:   title,
+		Content: content,
+		Tags:    tags,
+		Images:  nonNil(images),
+	}, nil
+}
+
+func checkTags(tags []string) ([]string, error) {
+	out := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = normalizeTag(tag)
+		if tag == "" {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > constants.PostTagMaxLen {
+			return nil, errors.ParamsError
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		out = append(out, tag)
+	}
+	if len(out) > constants.PostTagsMax {
+		return nil, errors.ParamsError
+	}
+	return out, nil
+}
+
+// nonNil 空切片存储为 [] 而不是 null
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// toPost 匿名帖子只对作者返回 user_id
+func toPost(p *mongomodel.Post, viewer *jwtutils.Claims) *user_service.Post {
+	mine := viewer != nil && viewer.UserID == p.UserID
+	post := &user_service.Post{
+		Id:            p.ID.Hex(),
+		UserId:        p.UserID,
+		SchoolId:      uint32(p.SchoolID),
+		Title:         p.Title,
+		Content:       p.Content,
+		Images:        p.Images,
+		Tags:          p.Tags,
+		Anonymous:     p.Anonymous,
+		AnonymousName: p.AnonymousName,
+		CreateTime:    uint32(p.CreateTime),
+		UpdateTime:    uint32(p.UpdateTime),
+		Status:        int32(p.Status),
+		LikeCount:     p.LikeCount,
+		CommentCount:  p.CommentCount,
+		CollectCount:  p.CollectCount,
+		Mine:          mine,
+	}
+	if p.Anonymous && !mine {
+		post.UserId = 0
+	}
+	return post
+}
+
+func toDraft(d *mongomodel.PostEditor) *user_service.Draft {
+	return &user_service.Draft{
+		Id:         d.ID.Hex(),
+		Title:      d.Title,
+		Content:    d.Content,
+		Tags:       d.Tags,
+		Images:     d.Images,
+		UpdateTime: uint32(d.UpdateTime),
+	}
+}
@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"common/applog"
+	"common/jwtutils"
+	"grpc/user/user"
+	"user/internal/mongomodel"
+	"user/pkg/constants"
+	"user/pkg/errors"
+	"user/pkg/mongodbutils"
+	"user/pkg/redisutils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// behaviorWeights 计算标签偏好时各行为的权重
+var behaviorWeights = map[string]float64{
+	mongomodel.ActionTypeView:    1,
+	mongomodel.ActionTypeLike:    3,
+	mongomodel.ActionTypeComment: 4,
+	mongomodel.ActionTypeCollect: 5,
+}
+
+// feedProjection 推荐排序需要的字段
+var feedProjection = bson.M{
+	mongomodel.PostFieldUserID:       1,
+	mongomodel.PostFieldTags:         1,
+	mongomodel.PostFieldCreateTime:   1,
+	mongomodel.PostFieldLikeCount:    1,
+	mongomodel.PostFieldCommentCount: 1,
+	mongomodel.PostFieldCollectCount: 1,
+}
+
+// Feed 推荐帖子。登录用户按最近行为的标签召回帖子，与热门列表合并后按标签偏好、新鲜度、热度排序，
+// 不包含自己发布和已点赞、评论、收藏的帖子；未登录或没有最近行为时返回热门列表
+func (s *ContentService) Feed(ctx context.Context, req *user_service.FeedReq) (*user_service.FeedResp, error) {
+	viewer, _ := jwtutils.ClaimsFromContext(ctx)
+	offset, limit := pagination(req.Page, req.PageSize)
+
+	var (
+		ids          []string
+		personalized bool
+		err          error
+	)
+	if viewer != nil {
+		if ids, err = personalFeed(ctx, viewer.UserID, req.Page <= 1); err != nil {
+			return nil, errors.NewDBError("rank feed of user %d failed: %v", viewer.UserID, err)
+		}
+		personalized = len(ids) > 0
+	}
+	if !personalized {
+		if ids, err = hotPostIDs(ctx); err != nil {
+			return nil, errors.NewDBError("rank hot posts failed: %v", err)
+		}
+	}
+
+	resp := &user_service.FeedResp{Personalized: personalized}
+	if offset >= len(ids) {
+		return resp, nil
+	}
+	end := min(offset+limit, len(ids))
+	resp.HasMore = end < len(ids)
+	// 列表缓存期间被删除或设为仅自己可见的帖子不返回
+	posts, err := loadPosts(ctx, ids[offset:end])
+	if err != nil {
+		return nil, errors.NewDBError("query feed posts failed: %v", err)
+	}
+	for _, p := range posts {
+		resp.List = append(resp.List, toPost(p, viewer))
+	}
+	return resp, nil
+}
+
+// feedCache 缓存的推荐列表及计算时间
+type feedCache struct {
+	IDs      []string `json:"ids"`
+	RankedAt int64    `json:"ranked_at"`
+}
+
+// personalFeed 用户的推荐列表，翻页时使用缓存的同一份列表；回到第一页且列表已计算超过
+// FeedRefreshMinAge 时重新计算，避免频繁请求第一页时每次都重新排序
+func personalFeed(ctx context.Context, userID uint64, firstPage bool) ([]string, error) {
+	key := fmt.Sprintf("%s:%d", constants.FeedKey, userID)
+	load := func(ctx context.Context) (feedCache, error) {
+		ids, err := rankFeed(ctx, userID)
+		return feedCache{IDs: ids, RankedAt: time.Now().Unix()}, err
+	}
+	feed, err := redisutils.GetOrLoad(ctx, key, constants.FeedCacheTTL, load)
+	if err != nil || !firstPage || time.Since(time.Unix(feed.RankedAt, 0)) < constants.FeedRefreshMinAge {
+		return feed.IDs, err
+	}
+	if err := redisutils.Delete(ctx, key); err != nil {
+		applog.WrapGDPLogger(ctx).Warn("delete feed cache failed", key, err)
+	}
+	feed, err = redisutils.GetOrLoad(ctx, key, constants.FeedCacheTTL, load)
+	return feed.IDs, err
+}
+
+// rankFeed 计算用户的推荐列表，没有最近的行为时返回空
+func rankFeed(ctx context.Context, userID uint64) ([]string, error) {
+	affinity, engaged, err := tagAffinity(ctx, userID)
+	if err != nil || len(affinity) == 0 {
+		return []string{}, err
+	}
+	tags := make([]string, 0, len(affinity))
+	for t := range affinity {
+		tags = append(tags, t)
+	}
+	candidates, err := recentPosts(ctx, bson.M{mongomodel.PostFieldTags: bson.M{"$in": tags}}, constants.FeedCandidateDays, constants.FeedCandidates)
+	if err != nil {
+		return nil, err
+	}
+	hotIDs, err := hotPostIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hot, err := loadPosts(ctx, hotIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*mongomodel.Post, 0, len(candidates)+len(hot))
+	seen := make(map[primitive.ObjectID]struct{}, cap(posts))
+	for _, p := range append(candidates, hot...) {
+		if _, ok := seen[p.ID]; ok {
+			continue
+		}
+		seen[p.ID] = struct{}{}
+		if _, ok := engaged[p.ID]; ok || p.UserID == userID {
+			continue
+		}
+		posts = append(posts, p)
+	}
+
+	now := time.Now()
+	maxPop := 0.0
+	for _, p := range posts {
+		maxPop = math.Max(maxPop, popularity(p))
+	}
+	scores := make(map[primitive.ObjectID]float64, len(posts))
+	for _, p := range posts {
+		aff := 0.0
+		for _, t := range p.Tags {
+			aff += affinity[t]
+		}
+		pop := 0.0
+		if maxPop > 0 {
+			pop = math.Log1p(popularity(p)) / math.Log1p(maxPop)
+		}
+		scores[p.ID] = constants.FeedAffinityWeight*math.Min(aff, 1) +
+			constants.FeedRecencyWeight*math.Pow(0.5, ageHours(p, now)/constants.FeedHalfLifeHours) +
+			constants.FeedPopularityWeight*pop
+	}
+	sort.SliceStable(posts, func(i, j int) bool { return scores[posts[i].ID] > scores[posts[j].ID] })
+	return postIDs(posts, constants.FeedMaxSize), nil
+}
+
+// tagAffinity 按最近的行为计算标签偏好，取偏好最高的几个标签并归一化到 0~1；
+// 同时返回点赞、评论、收藏过的帖子，推荐时排除
+func tagAffinity(ctx context.Context, userID uint64) (map[string]float64, map[primitive.ObjectID]struct{}, error) {
+	since := uint(time.Now().AddDate(0, 0, -constants.FeedBehaviorDays).Unix())
+	var behaviors []*mongomodel.UserBehavior
+	opts := options.Find().
+		SetSort(bson.D{{Key: mongomodel.UserBehaviorFieldTimestamp, Value: -1}}).
+		SetLimit(constants.FeedBehaviorLimit).
+		SetProjection(bson.M{mongomodel.UserBehaviorFieldPostID: 1, mongomodel.UserBehaviorFieldActionType: 1, mongomodel.UserBehaviorFieldTags: 1})
+	filter := bson.M{mongomodel.UserBehaviorFieldUserID: userID, mongomodel.UserBehaviorFieldTimestamp: bson.M{"$gte": since}}
+	if err := mongodbutils.Find(ctx, behaviorColl, filter, &behaviors, opts); err != nil {
+		return nil, nil, fmt.Errorf("query behaviors: %w", err)
+	}
+
+	weights := make(map[string]float64)
+	engaged := make(map[primitive.ObjectID]struct{})
+	for _, b := range behaviors {
+		if w := behaviorWeights[b.ActionType]; w > 0 {
+			for _, t := range b.Tags {
+				weights[t] += w
+			}
+		}
+		if b.ActionType != mongomodel.ActionTypeView {
+			engaged[b.PostID] = struct{}{}
+		}
+	}
+
+	tags := make([]string, 0, len(weights))
+	for t := range weights {
+		tags = append(tags, t)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if weights[tags[i]] != weights[tags[j]] {
+			return weights[tags[i]] > weights[tags[j]]
+		}
+		return tags[i] < tags[j]
+	})
+	if len(tags) > constants.FeedTopTags {
+		tags = tags[:constants.FeedTopTags]
+	}
+	affinity := make(map[string]float64, len(tags))
+	for _, t := range tags {
+		affinity[t] = weights[t] / weights[tags[0]]
+	}
+	return affinity, engaged, nil
+}
+
+// hotPostIDs 热门列表，最近几天的正常帖子按热度随时间衰减排序，所有用户共用缓存
+func hotPostIDs(ctx context.Context) ([]string, error) {
+	return redisutils.GetOrLoad(ctx, constants.FeedHotKey, constants.FeedHotCacheTTL, func(ctx context.Context) ([]string, error) {
+		posts, err := recentPosts(ctx, bson.M{}, constants.FeedHotDays, constants.FeedHotCandidates)
+		if err != nil {
+			return nil, err
+		}
+		now := time.Now()
+		scores := make(map[primitive.ObjectID]float64, len(posts))
+		for _, p := range posts {
+			scores[p.ID] = popularity(p) / math.Pow(ageHours(p, now)+2, 1.5)
+		}
+		sort.SliceStable(posts, func(i, j int) bool { return scores[posts[i].ID] > scores[posts[j].ID] })
+		return postIDs(posts, constants.FeedMaxSize), nil
+	})
+}
+
+// recentPosts 最近 days 天发布的正常帖子，按发布时间倒序，只查询排序需要的字段
+func recentPosts(ctx context.Context, filter bson.M, days, limit int) ([]*mongomodel.Post, error) {
+	filter[mongomodel.PostFieldStatus] = mongomodel.PostStatusNormal
+	filter[mongomodel.PostFieldID] = bson.M{"$gte": primitive.NewObjectIDFromTimestamp(time.Now().AddDate(0, 0, -days))}
+	opts := options.Find().
+		SetSort(bson.D{{Key: mongomodel.PostFieldID, Value: -1}}).
+		SetLimit(int64(limit)).
+		SetProjection(feedProjection)
+	var posts []*mongomodel.Post
+	if err := mongodbutils.Find(ctx, postColl, filter, &posts, opts); err != nil {
+		return nil, fmt.Errorf("query recent posts: %w", err)
+	}
+	return posts, nil
+}
+
+// loadPosts 按 ids 的顺序查询正常状态的帖子
+func loadPosts(ctx context.Context, ids []string) ([]*mongomodel.Post, error) {
+	oids := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		if oid, err := primitive.ObjectIDFromHex(id); err == nil {
+			oids = append(oids, oid)
+		}
+	}
+	if len(oids) == 0 {
+		return nil, nil
+	}
+	var posts []*mongomodel.Post
+	filter := bson.M{mongomodel.PostFieldID: bson.M{"$in": oids}, mongomodel.PostFieldStatus: mongomodel.PostStatusNormal}
+	if err := mongodbutils.Find(ctx, postColl, filter, &posts); err != nil {
+		return nil, err
+	}
+	byID := make(map[primitive.ObjectID]*mongomodel.Post, len(posts))
+	for _, p := range posts {
+		byID[p.ID] = p
+	}
+	out := make([]*mongomodel.Post, 0, len(posts))
+	for _, oid := range oids {
+		if p, ok := byID[oid]; ok {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+// popularity 帖子热度，评论和收藏比点赞权重高
+func popularity(p *mongomodel.Post) float64 {
+	return float64(1 + p.LikeCount + 2*p.CommentCount + 3*p.CollectCount)
+}
+
+func ageHours(p *mongomodel.Post, now time.Time) float64 {
+	return math.Max(0, now.Sub(time.Unix(int64(p.CreateTime), 0)).Hours())
+}
+
+func postIDs(posts []*mongomodel.Post, n int) []string {
+	if len(posts) > n {
+		posts = posts[:n]
+	}
+	ids := make([]string, 0, len(posts))
+	for _, p := range posts {
+		ids = append(ids, p.ID.Hex())
+	}
+	return ids
+}
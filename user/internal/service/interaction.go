@@ -0,0 +1,348 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"common/jwtutils"
+	"grpc/user/user"
+	"user/internal/mongomodel"
+	"user/pkg/constants"
+	"user/pkg/errors"
+	"user/pkg/mongodbutils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var (
+	likeColl    = (&mongomodel.PostLike{}).CollectionName()
+	collectColl = (&mongomodel.PostCollect{}).CollectionName()
+	commentColl = (&mongomodel.Comment{}).CollectionName()
+)
+
+// 点赞、收藏、评论与帖子计数在同一个事务中修改，记录有唯一索引（post_id + user_id），
+// 并发的重复操作产生写冲突由驱动重试，重试时读到已有记录不再修改计数
+
+// LikePost 点赞，已点赞时不修改
+func (s *ContentService) LikePost(ctx context.Context, req *user_service.PostActionReq) (*user_service.Empty, error) {
+	claims, post, err := visiblePost(ctx, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{mongomodel.PostLikeFieldPostID: post.ID, mongomodel.PostLikeFieldUserID: claims.UserID}
+	liked := false
+	err = withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		liked = false
+		var like mongomodel.PostLike
+		if err := mongodbutils.FindOne(ctx, likeColl, filter, &like); err != nil {
+			return err
+		}
+		now := uint(unixNow())
+		switch {
+		case like.ID.IsZero():
+			like = mongomodel.PostLike{PostID: post.ID, UserID: claims.UserID, Status: mongomodel.PostLikeStatusNormal, CreateTime: now}
+			if _, err := mongodbutils.InsertOne(ctx, likeColl, &like); err != nil {
+				return err
+			}
+		case like.Status == mongomodel.PostLikeStatusNormal:
+			return nil
+		default:
+			// 取消后再次点赞复用原记录
+			_, err := mongodbutils.UpdateById(ctx, likeColl, like.ID, bson.M{"$set": bson.M{
+				mongomodel.PostLikeFieldStatus:     mongomodel.PostLikeStatusNormal,
+				mongomodel.PostLikeFieldCreateTime: now,
+			}})
+			if err != nil {
+				return err
+			}
+		}
+		liked = true
+		return incPostCounter(ctx, post.ID, mongomodel.PostFieldLikeCount, 1)
+	})
+	if err != nil {
+		return nil, errors.NewDBError("like post %s failed: %v", req.PostId, err)
+	}
+	if liked {
+		emitBehavior(ctx, claims.UserID, post, mongomodel.ActionTypeLike)
+	}
+	return &user_service.Empty{}, nil
+}
+
+// UnlikePost 取消点赞，未点赞时不修改
+func (s *ContentService) UnlikePost(ctx context.Context, req *user_service.PostActionReq) (*user_service.Empty, error) {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	postID, err := objectID(req.PostId)
+	if err != nil {
+		return nil, err
+	}
+	err = withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		res, err := mongodbutils.UpdateOne(ctx, likeColl,
+			bson.M{mongomodel.PostLikeFieldPostID: postID, mongomodel.PostLikeFieldUserID: claims.UserID, mongomodel.PostLikeFieldStatus: mongomodel.PostLikeStatusNormal},
+			bson.M{"$set": bson.M{mongomodel.PostLikeFieldStatus: mongomodel.PostLikeStatusDeleted}})
+		if err != nil || res.ModifiedCount == 0 {
+			return err
+		}
+		return incPostCounter(ctx, postID, mongomodel.PostFieldLikeCount, -1)
+	})
+	if err != nil {
+		return nil, errors.NewDBError("unlike post %s failed: %v", req.PostId, err)
+	}
+	return &user_service.Empty{}, nil
+}
+
+// CollectPost 收藏，已收藏时不修改
+func (s *ContentService) CollectPost(ctx context.Context, req *user_service.PostActionReq) (*user_service.Empty, error) {
+	claims, post, err := visiblePost(ctx, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{mongomodel.PostCollectFieldPostID: post.ID, mongomodel.PostCollectFieldUserID: claims.UserID}
+	collected := false
+	err = withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		collected = false
+		n, err := mongodbutils.CountDocuments(ctx, collectColl, filter)
+		if err != nil || n > 0 {
+			return err
+		}
+		collect := &mongomodel.PostCollect{PostID: post.ID, UserID: claims.UserID, CreateTime: uint(unixNow())}
+		if _, err := mongodbutils.InsertOne(ctx, collectColl, collect); err != nil {
+			return err
+		}
+		collected = true
+		return incPostCounter(ctx, post.ID, mongomodel.PostFieldCollectCount, 1)
+	})
+	if err != nil {
+		return nil, errors.NewDBError("collect post %s failed: %v", req.PostId, err)
+	}
+	if collected {
+		emitBehavior(ctx, claims.UserID, post, mongomodel.ActionTypeCollect)
+	}
+	return &user_service.Empty{}, nil
+}
+
+// UncollectPost 取消收藏，未收藏时不修改；帖子已删除时也可以取消
+func (s *ContentService) UncollectPost(ctx context.Context, req *user_service.PostActionReq) (*user_service.Empty, error) {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	postID, err := objectID(req.PostId)
+	if err != nil {
+		return nil, err
+	}
+	err = withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		res, err := mongodbutils.DeleteOne(ctx, collectColl, bson.M{mongomodel.PostCollectFieldPostID: postID, mongomodel.PostCollectFieldUserID: claims.UserID})
+		if err != nil || res.DeletedCount == 0 {
+			return err
+		}
+		return incPostCounter(ctx, postID, mongomodel.PostFieldCollectCount, -1)
+	})
+	if err != nil {
+		return nil, errors.NewDBError("uncollect post %s failed: %v", req.PostId, err)
+	}
+	return &user_service.Empty{}, nil
+}
+
+// CreateComment 评论帖子，parent_id 不为空时回复评论；回复楼中楼时归到所在的顶层评论下，
+// reply_to_id 记录被回复的评论
+func (s *ContentService) CreateComment(ctx context.Context, req *user_service.CreateCommentReq) (*user_service.Comment, error) {
+	content := strings.TrimSpace(req.Content)
+	if content == "" || utf8.RuneCountInString(content) > constants.CommentMaxLen {
+		return nil, errors.ParamsError
+	}
+	postID := req.PostId
+	var replyTo *mongomodel.Comment
+	if req.ParentId != "" {
+		id, err := objectID(req.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		if replyTo, err = getComment(ctx, id); err != nil {
+			return nil, err
+		}
+		if postID != "" && postID != replyTo.PostID.Hex() {
+			return nil, errors.ParamsError
+		}
+		postID = replyTo.PostID.Hex()
+	}
+	claims, post, err := visiblePost(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUserBanned(ctx, claims.UserID); err != nil {
+		return nil, err
+	}
+
+	comment := &mongomodel.Comment{
+		ID:         primitive.NewObjectID(),
+		PostID:     post.ID,
+		UserID:     claims.UserID,
+		Content:    content,
+		Anonymous:  req.Anonymous,
+		CreateTime: uint(unixNow()),
+		Status:     mongomodel.CommentStatusNormal,
+	}
+	if replyTo != nil {
+		comment.ParentID, comment.ReplyToID = replyTo.ID, replyTo.ID
+		if !replyTo.ParentID.IsZero() {
+			comment.ParentID = replyTo.ParentID
+		}
+	}
+	if err := insertComment(ctx, comment); err != nil {
+		return nil, errors.NewDBError("comment post %s failed: %v", postID, err)
+	}
+	emitBehavior(ctx, claims.UserID, post, mongomodel.ActionTypeComment)
+	return toComment(comment, claims), nil
+}
+
+// DeleteComment 删除评论（status=0），只有评论者本人可删除，匿名评论同样以评论者判断
+func (s *ContentService) DeleteComment(ctx context.Context, req *user_service.DeleteCommentReq) (*user_service.Empty, error) {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := objectID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := getComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.UserID != claims.UserID {
+		return nil, errors.PermissionDenied
+	}
+
+	err = withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		res, err := mongodbutils.UpdateOne(ctx, commentColl,
+			bson.M{mongomodel.CommentFieldID: id, mongomodel.CommentFieldStatus: mongomodel.CommentStatusNormal},
+			bson.M{"$set": bson.M{mongomodel.CommentFieldStatus: mongomodel.CommentStatusDeleted}})
+		if err != nil || res.ModifiedCount == 0 {
+			return err
+		}
+		return incPostCounter(ctx, comment.PostID, mongomodel.PostFieldCommentCount, -1)
+	})
+	if err != nil {
+		return nil, errors.NewDBError("delete comment %s failed: %v", req.Id, err)
+	}
+	return &user_service.Empty{}, nil
+}
+
+// visiblePost 当前用户和可见的帖子，点赞、收藏、评论前检查
+func visiblePost(ctx context.Context, postID string) (*jwtutils.Claims, *mongomodel.Post, error) {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	id, err := objectID(postID)
+	if err != nil {
+		return nil, nil, err
+	}
+	post, err := getPost(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !canView(post, claims) {
+		return nil, nil, errors.PostNotFound
+	}
+	return claims, post, nil
+}
+
+// checkUserBanned 发布内容前检查用户未被封禁
+func checkUserBanned(ctx context.Context, userID uint64) error {
+	u, err := getUser(ctx, int64(userID))
+	if err != nil {
+		return err
+	}
+	return checkBanned(ctx, u)
+}
+
+// getComment 查询未删除的评论
+func getComment(ctx context.Context, id primitive.ObjectID) (*mongomodel.Comment, error) {
+	comment, err := findComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.Status != mongomodel.CommentStatusNormal {
+		return nil, errors.CommentNotFound
+	}
+	return comment, nil
+}
+
+// findComment 查询评论，包括已删除的
+func findComment(ctx context.Context, id primitive.ObjectID) (*mongomodel.Comment, error) {
+	var comment mongomodel.Comment
+	if err := mongodbutils.FindById(ctx, commentColl, id, &comment); err != nil {
+		return nil, errors.NewDBError("query comment %s failed: %v", id.Hex(), err)
+	}
+	if comment.ID.IsZero() {
+		return nil, errors.CommentNotFound
+	}
+	return &comment, nil
+}
+
+// insertComment 写入评论并增加帖子评论数，回复同时增加顶层评论的回复数
+func insertComment(ctx context.Context, comment *mongomodel.Comment) error {
+	return withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		// 重试时 _id 相同，已写入的评论不会重复写入
+		if _, err := mongodbutils.InsertOne(ctx, commentColl, comment); err != nil {
+			return err
+		}
+		if !comment.ParentID.IsZero() {
+			_, err := mongodbutils.UpdateById(ctx, commentColl, comment.ParentID, bson.M{"$inc": bson.M{mongomodel.CommentFieldReplyCount: 1}})
+			if err != nil {
+				return err
+			}
+		}
+		return incPostCounter(ctx, comment.PostID, mongomodel.PostFieldCommentCount, 1)
+	})
+}
+
+// incPostCounter 修改帖子的计数，需要在事务中与对应的记录一起修改
+func incPostCounter(ctx context.Context, postID primitive.ObjectID, field string, delta int) error {
+	_, err := mongodbutils.UpdateById(ctx, postColl, postID, bson.M{"$inc": bson.M{field: delta}})
+	return err
+}
+
+// withTransaction 执行 mongodb 事务，fn 返回的业务错误原样返回
+func withTransaction(ctx context.Context, fn func(ctx mongo.SessionContext) error) error {
+	_, err := mongodbutils.ExecuteTransaction(ctx, func(ctx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(ctx)
+	})
+	return err
+}
+
+// toComment 匿名评论只对评论者返回 user_id，已删除的评论只保留楼层信息
+func toComment(c *mongomodel.Comment, viewer *jwtutils.Claims) *user_service.Comment {
+	mine := viewer != nil && viewer.UserID == c.UserID
+	comment := &user_service.Comment{
+		Id:         c.ID.Hex(),
+		PostId:     c.PostID.Hex(),
+		UserId:     c.UserID,
+		Content:    c.Content,
+		Anonymous:  c.Anonymous,
+		CreateTime: uint32(c.CreateTime),
+		Status:     int32(c.Status),
+		Mine:       mine,
+		ReplyCount: c.ReplyCount,
+	}
+	if !c.ParentID.IsZero() {
+		comment.ParentId = c.ParentID.Hex()
+	}
+	if !c.ReplyToID.IsZero() {
+		comment.ReplyToId = c.ReplyToID.Hex()
+	}
+	if c.Anonymous && !mine {
+		comment.UserId = 0
+	}
+	if c.Status != mongomodel.CommentStatusNormal {
+		comment.UserId, comment.Content, comment.Anonymous, comment.Mine = 0, constants.DeletedCommentContent, false, false
+	}
+	return comment
+}
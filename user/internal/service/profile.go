@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"grpc/user/user"
+	"user/internal/ent"
+	"user/internal/ent/schema"
+	entuser "user/internal/ent/user"
+	"user/pkg/constants"
+	"user/pkg/database"
+	"user/pkg/errors"
+)
+
+// GetProfile 查询用户资料，他人查询时手机号脱敏
+func (s *UserService) GetProfile(ctx context.Context, req *user_service.GetProfileReq) (*user_service.Profile, error) {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id := req.UserId
+	if id == 0 {
+		id = int64(claims.UserID)
+	}
+	u, err := getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toProfile(u, id == int64(claims.UserID)), nil
+}
+
+// UpdateProfile 修改当前用户资料，只更新 fields 中列出的字段
+func (s *UserService) UpdateProfile(ctx context.Context, req *user_service.UpdateProfileReq) (*user_service.Profile, error) {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Fields) == 0 {
+		return nil, errors.ParamsError
+	}
+
+	update := database.GetEntClient().User.UpdateOneID(int64(claims.UserID)).
+		Where(entuser.StatusIn(schema.UserStatusNormal, schema.UserStatusBanned))
+	var schoolSet, majorSet bool
+	for _, f := range req.Fields {
+		switch f {
+		case "nickname":
+			if req.Nickname == "" || utf8.RuneCountInString(req.Nickname) > constants.NicknameMaxLen {
+				return nil, errors.ParamsError
+			}
+			update.SetNickname(req.Nickname)
+		case "school_id":
+			schoolSet = true
+			setOrClear(req.SchoolId, update.SetSchoolID, update.ClearSchoolID)
+		case "major_id":
+			majorSet = true
+			setOrClear(req.MajorId, update.SetMajorID, update.ClearMajorID)
+		case "admission_grade":
+			if req.AdmissionGrade != 0 &&
+				(req.AdmissionGrade < constants.MinAdmissionGrade || int(req.AdmissionGrade) > time.Now().Year()) {
+				return nil, errors.ParamsError
+			}
+			update.SetAdmissionGrade(req.AdmissionGrade)
+		case "avatar_url":
+			if req.AvatarUrl == "" || len(req.AvatarUrl) > constants.AvatarURLMaxLen {
+				return nil, errors.ParamsError
+			}
+			update.SetAvatarURL(req.AvatarUrl)
+		default:
+			return nil, errors.NewErrorWithCode(errors.ParamsErrorCode, "unknown profile field %q", f)
+		}
+	}
+
+	if schoolSet || majorSet {
+		if err := checkProfileSchool(ctx, int64(claims.UserID), req, schoolSet, majorSet, update); err != nil {
+			return nil, err
+		}
+	}
+
+	u, err := update.SetUpdatedAt(unixNow()).Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.UserNotFound
+		}
+		return nil, errors.NewDBError("update profile of user %d failed: %v", claims.UserID, err)
+	}
+	return toProfile(u, true), nil
+}
+
+// checkProfileSchool 学校需已启用，专业需属于学校；只修改学校时清空原专业
+func checkProfileSchool(ctx context.Context, userID int64, req *user_service.UpdateProfileReq, schoolSet, majorSet bool, update *ent.UserUpdateOne) error {
+	schoolID, majorID := req.SchoolId, req.MajorId
+	if !schoolSet {
+		u, err := getUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		schoolID = u.SchoolID
+	}
+	if !majorSet {
+		majorID = 0
+		update.ClearMajorID()
+	}
+
+	if schoolID != 0 && schoolSet {
+		if err := checkSchool(ctx, schoolID); err != nil {
+			return err
+		}
+	}
+	if majorID != 0 {
+		if schoolID == 0 {
+			return errors.MajorNotInSchool
+		}
+		return checkSchoolMajor(ctx, schoolID, majorID)
+	}
+	return nil
+}
+
+// setOrClear 0 表示未选择，存为 NULL
+func setOrClear(id uint32, set func(uint32) *ent.UserUpdateOne, clear func() *ent.UserUpdateOne) {
+	if id == 0 {
+		clear()
+		return
+	}
+	set(id)
+}
+
+func toProfile(u *ent.User, self bool) *user_service.Profile {
+	phone := u.Phone
+	if !self {
+		phone = maskPhone(phone)
+	}
+	return &user_service.Profile{
+		UserId:         u.ID,
+		Nickname:       u.Nickname,
+		Phone:          phone,
+		SchoolId:       u.SchoolID,
+		MajorId:        u.MajorID,
+		AdmissionGrade: u.AdmissionGrade,
+		AvatarUrl:      u.AvatarURL,
+		Experience:     u.Experience,
+		Status:         int32(u.Status),
+		UnbannedTime:   u.UnbannedTime,
+		CreatedAt:      u.CreatedAt,
+	}
+}
+
+// maskPhone 138****1234
+func maskPhone(phone string) string {
+	if len(phone) < 7 {
+		return phone
+	}
+	return phone[:3] + "****" + phone[len(phone)-4:]
+}
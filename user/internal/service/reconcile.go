@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"common/applog"
+	"grpc/user/user"
+	"user/config"
+	"user/internal/mongomodel"
+	"user/pkg/constants"
+	"user/pkg/errors"
+	"user/pkg/mongodbutils"
+	"user/pkg/redisutils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CounterDrift 帖子计数与记录不一致
+type CounterDrift struct {
+	PostID primitive.ObjectID
+	Field  string
+	Stored int64 // 帖子中记录的计数
+	Actual int64 // 按点赞、收藏、评论记录统计的计数
+}
+
+// ReconcileReport 一次校正的结果
+type ReconcileReport struct {
+	Checked int64
+	Drifted int64 // 存在差异的计数个数
+	Fixed   int64 // 已修正的帖子数
+	Drifts  []CounterDrift
+}
+
+// ReconcileCounters 按 _id 分批重新统计帖子的点赞、收藏、评论数，fix 为 true 时修正差异。
+// 修正以统计时读到的计数为条件，期间计数被并发修改的帖子跳过，留到下次校正
+func ReconcileCounters(ctx context.Context, batch int, fix bool) (*ReconcileReport, error) {
+	logger := applog.WrapGDPLogger(ctx)
+	report := &ReconcileReport{}
+	projection := bson.M{
+		mongomodel.PostFieldLikeCount:    1,
+		mongomodel.PostFieldCommentCount: 1,
+		mongomodel.PostFieldCollectCount: 1,
+	}
+	last := primitive.NilObjectID
+	for {
+		filter := bson.M{}
+		if !last.IsZero() {
+			filter[mongomodel.PostFieldID] = bson.M{"$gt": last}
+		}
+		var posts []*mongomodel.Post
+		opts := options.Find().SetSort(bson.D{{Key: mongomodel.PostFieldID, Value: 1}}).SetLimit(int64(batch)).SetProjection(projection)
+		if err := mongodbutils.Find(ctx, postColl, filter, &posts, opts); err != nil {
+			return report, fmt.Errorf("query posts: %w", err)
+		}
+		if len(posts) == 0 {
+			return report, nil
+		}
+
+		ids := make([]primitive.ObjectID, 0, len(posts))
+		for _, p := range posts {
+			ids = append(ids, p.ID)
+		}
+		likes, err := countByPost(ctx, likeColl, bson.M{mongomodel.PostLikeFieldStatus: mongomodel.PostLikeStatusNormal}, ids)
+		if err != nil {
+			return report, err
+		}
+		collects, err := countByPost(ctx, collectColl, bson.M{}, ids)
+		if err != nil {
+			return report, err
+		}
+		comments, err := countByPost(ctx, commentColl, bson.M{mongomodel.CommentFieldStatus: mongomodel.CommentStatusNormal}, ids)
+		if err != nil {
+			return report, err
+		}
+
+		for _, p := range posts {
+			report.Checked++
+			cond, set := bson.M{mongomodel.PostFieldID: p.ID}, bson.M{}
+			check := func(field string, stored, actual int64) {
+				if stored == actual {
+					return
+				}
+				report.Drifted++
+				if len(report.Drifts) < constants.ReconcileMaxDrifts {
+					report.Drifts = append(report.Drifts, CounterDrift{PostID: p.ID, Field: field, Stored: stored, Actual: actual})
+				}
+				logger.Warn(fmt.Sprintf("post %s %s drift: stored=%d actual=%d", p.ID.Hex(), field, stored, actual))
+				cond[field], set[field] = stored, actual
+			}
+			check(mongomodel.PostFieldLikeCount, p.LikeCount, likes[p.ID])
+			check(mongomodel.PostFieldCollectCount, p.CollectCount, collects[p.ID])
+			check(mongomodel.PostFieldCommentCount, p.CommentCount, comments[p.ID])
+			if !fix || len(set) == 0 {
+				continue
+			}
+			res, err := mongodbutils.UpdateOne(ctx, postColl, cond, bson.M{"$set": set})
+			if err != nil {
+				return report, fmt.Errorf("fix counters of post %s: %w", p.ID.Hex(), err)
+			}
+			report.Fixed += res.ModifiedCount
+		}
+
+		last = posts[len(posts)-1].ID
+		if len(posts) < batch {
+			return report, nil
+		}
+	}
+}
+
+// countByPost 按帖子统计记录数
+func countByPost(ctx context.Context, coll string, match bson.M, postIDs []primitive.ObjectID) (map[primitive.ObjectID]int64, error) {
+	match["post_id"] = bson.M{"$in": postIDs}
+	pipeline := []bson.M{
+		{"$match": match},
+		{"$group": bson.M{"_id": "$post_id", "n": bson.M{"$sum": 1}}},
+	}
+	var rows []struct {
+		PostID primitive.ObjectID `bson:"_id"`
+		N      int64              `bson:"n"`
+	}
+	if err := mongodbutils.Aggregate(ctx, coll, pipeline, &rows); err != nil {
+		return nil, fmt.Errorf("count %s: %w", coll, err)
+	}
+	counts := make(map[primitive.ObjectID]int64, len(rows))
+	for _, r := range rows {
+		counts[r.PostID] = r.N
+	}
+	return counts, nil
+}
+
+// ReconcileCounters 手动校正帖子计数，需要 admin 角色
+func (s *ContentService) ReconcileCounters(ctx context.Context, req *user_service.ReconcileCountersReq) (*user_service.ReconcileCountersResp, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	report, err := ReconcileCounters(ctx, config.GetConfig().Content.ReconcileBatch, req.Fix)
+	if err != nil {
+		return nil, errors.NewDBError("reconcile counters failed: %v", err)
+	}
+	resp := &user_service.ReconcileCountersResp{
+		Checked: report.Checked,
+		Drifted: report.Drifted,
+		Fixed:   report.Fixed,
+	}
+	for _, d := range report.Drifts {
+		resp.Drifts = append(resp.Drifts, &user_service.CounterDrift{
+			PostId: d.PostID.Hex(),
+			Field:  d.Field,
+			Stored: d.Stored,
+			Actual: d.Actual,
+		})
+	}
+	return resp, nil
+}
+
+// CounterReconciler 定时校正帖子计数，多实例部署时通过 redis 锁保证每个周期只有一个实例执行
+type CounterReconciler struct {
+	interval time.Duration
+	batch    int
+	fix      bool
+
+	closeOnce sync.Once
+	closeCh   chan struct{}
+	wg        sync.WaitGroup
+}
+
+// StartCounterReconciler 启动定时校正，interval 小于等于0时不启动
+func StartCounterReconciler(interval time.Duration, batch int, fix bool) *CounterReconciler {
+	r := &CounterReconciler{interval: interval, batch: batch, fix: fix, closeCh: make(chan struct{})}
+	if interval <= 0 {
+		return r
+	}
+	r.wg.Add(1)
+	go r.loop()
+	return r
+}
+
+// Stop 停止定时校正，等待进行中的校正结束
+func (r *CounterReconciler) Stop(ctx context.Context) error {
+	r.closeOnce.Do(func() { close(r.closeCh) })
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *CounterReconciler) loop() {
+	defer r.wg.Done()
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.closeCh:
+			return
+		case <-ticker.C:
+			r.run()
+		}
+	}
+}
+
+// reconcileLockScript 锁仍由 ARGV[1] 持有时将有效期设为 ARGV[2] 毫秒，ARGV[2] 不大于0时释放锁
+const reconcileLockScript = `
+if redis.call('GET', KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+if tonumber(ARGV[2]) > 0 then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return redis.call('DEL', KEYS[1])
+`
+
+// run 获取本周期的锁后执行一次校正。执行期间定时续期，锁丢失时中止；结束后锁保留到周期结束，
+// 避免其他实例在同一周期重复执行，执行超过一个周期时直接释放
+func (r *CounterReconciler) run() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-r.closeCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	logger := applog.WrapGDPLogger(ctx)
+	token := primitive.NewObjectID().Hex()
+	ok, err := redisutils.SetNX(ctx, constants.ReconcileLockKey, token, constants.ReconcileLockTTL)
+	if err != nil {
+		logger.Warn("acquire reconcile lock failed", err)
+		return
+	}
+	if !ok {
+		return
+	}
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		r.keepLock(ctx, cancel, token)
+	}()
+	report, err := ReconcileCounters(ctx, r.batch, r.fix)
+	cancel()
+	wg.Wait()
+
+	// 停止时 ctx 已取消，使用独立的上下文处理锁
+	if _, lockErr := setReconcileLock(context.Background(), token, r.interval-time.Since(start)-time.Second); lockErr != nil {
+		logger.Warn("update reconcile lock failed", lockErr)
+	}
+	if err != nil {
+		logger.Error("reconcile post counters failed", err)
+		return
+	}
+	logger.Info(fmt.Sprintf("reconcile post counters done: checked=%d drifted=%d fixed=%d cost=%s",
+		report.Checked, report.Drifted, report.Fixed, time.Since(start)))
+}
+
+// keepLock 校正期间定时续期，锁已被其他实例持有时取消校正
+func (r *CounterReconciler) keepLock(ctx context.Context, cancel context.CancelFunc, token string) {
+	ticker := time.NewTicker(constants.ReconcileLockTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			held, err := setReconcileLock(ctx, token, constants.ReconcileLockTTL)
+			if err != nil {
+				// 网络抖动时下次再试，锁在有效期内仍然有效
+				applog.WrapGDPLogger(ctx).Warn("extend reconcile lock failed", err)
+				continue
+			}
+			if !held {
+				applog.WrapGDPLogger(ctx).Warn("reconcile lock lost, stop reconciling")
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// setReconcileLock 锁仍由 token 持有时设置有效期，ttl 不大于0时释放，返回锁是否仍由 token 持有
+func setReconcileLock(ctx context.Context, token string, ttl time.Duration) (bool, error) {
+	res, err := redisutils.Eval(ctx, reconcileLockScript, []string{constants.ReconcileLockKey}, token, ttl.Milliseconds())
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.(int64)
+	return n == 1, nil
+}
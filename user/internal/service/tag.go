@@ -0,0 +1,377 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"grpc/user/user"
+	"user/internal/mongomodel"
+	"user/pkg/constants"
+	"user/pkg/errors"
+	"user/pkg/mongodbutils"
+	"user/pkg/redisutils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var tagColl = (&mongomodel.Tag{}).CollectionName()
+
+// 帖子的标签以规范化后的名称存储，发布、修改、删除帖子时在同一个事务中修改标签的帖子数，
+// 使用新标签时自动创建。标签合并后记录 merged_into，之后用到旧名称时改为合并到的标签
+
+// SearchTags 按前缀补全标签，prefix 为空时返回官方标签
+func (s *ContentService) SearchTags(ctx context.Context, req *user_service.SearchTagsReq) (*user_service.TagList, error) {
+	prefix := normalizeTag(req.Prefix)
+	if utf8.RuneCountInString(prefix) > constants.PostTagMaxLen {
+		return nil, errors.ParamsError
+	}
+	filter := bson.M{mongomodel.TagFieldStatus: mongomodel.TagStatusNormal}
+	if prefix == "" {
+		filter[mongomodel.TagFieldIsOfficial] = true
+	} else {
+		// 以 ^ 开头且区分大小写的正则可以使用名称索引
+		filter[mongomodel.TagFieldName] = bson.M{"$regex": "^" + regexp.QuoteMeta(prefix)}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: mongomodel.TagFieldIsOfficial, Value: -1}, {Key: mongomodel.TagFieldPostCount, Value: -1}, {Key: mongomodel.TagFieldName, Value: 1}}).
+		SetLimit(int64(tagLimit(req.Limit)))
+	var tags []*mongomodel.Tag
+	if err := mongodbutils.Find(ctx, tagColl, filter, &tags, opts); err != nil {
+		return nil, errors.NewDBError("search tags %q failed: %v", prefix, err)
+	}
+	resp := &user_service.TagList{}
+	for _, t := range tags {
+		resp.List = append(resp.List, toTag(t))
+	}
+	return resp, nil
+}
+
+// trendingTag 热门标签的缓存
+type trendingTag struct {
+	Name       string `json:"name"`
+	PostCount  int64  `json:"post_count"`
+	IsOfficial bool   `json:"is_official"`
+}
+
+// TrendingTags 统计最近一段时间内正常帖子使用最多的标签，按 _id 的时间筛选帖子
+func (s *ContentService) TrendingTags(ctx context.Context, req *user_service.TrendingTagsReq) (*user_service.TagList, error) {
+	hours := req.Hours
+	if hours <= 0 {
+		hours = constants.TagTrendingHours
+	}
+	if hours > constants.TagTrendingHoursMax {
+		return nil, errors.ParamsError
+	}
+	limit := tagLimit(req.Limit)
+	key := fmt.Sprintf("%s:%d:%d", constants.TagTrendingKey, hours, limit)
+	tags, err := redisutils.GetOrLoad(ctx, key, constants.TagTrendingCacheTTL, func(ctx context.Context) ([]trendingTag, error) {
+		return loadTrendingTags(ctx, time.Duration(hours)*time.Hour, limit)
+	})
+	if err != nil {
+		return nil, errors.NewDBError("query trending tags failed: %v", err)
+	}
+	resp := &user_service.TagList{}
+	for _, t := range tags {
+		resp.List = append(resp.List, &user_service.Tag{Name: t.Name, PostCount: t.PostCount, IsOfficial: t.IsOfficial})
+	}
+	return resp, nil
+}
+
+func loadTrendingTags(ctx context.Context, window time.Duration, limit int32) ([]trendingTag, error) {
+	since := primitive.NewObjectIDFromTimestamp(time.Now().Add(-window))
+	pipeline := []bson.M{
+		{"$match": bson.M{mongomodel.PostFieldStatus: mongomodel.PostStatusNormal, mongomodel.PostFieldID: bson.M{"$gte": since}}},
+		{"$unwind": "$" + mongomodel.PostFieldTags},
+		{"$group": bson.M{"_id": "$" + mongomodel.PostFieldTags, "n": bson.M{"$sum": 1}}},
+		{"$sort": bson.D{{Key: "n", Value: -1}, {Key: "_id", Value: 1}}},
+		{"$limit": limit},
+	}
+	var rows []struct {
+		Name string `bson:"_id"`
+		N    int64  `bson:"n"`
+	}
+	if err := mongodbutils.Aggregate(ctx, postColl, pipeline, &rows); err != nil {
+		return nil, err
+	}
+	tags := make([]trendingTag, 0, len(rows))
+	if len(rows) == 0 {
+		return tags, nil
+	}
+
+	names := make([]string, 0, len(rows))
+	for _, r := range rows {
+		names = append(names, r.Name)
+	}
+	var official []*mongomodel.Tag
+	err := mongodbutils.Find(ctx, tagColl,
+		bson.M{mongomodel.TagFieldName: bson.M{"$in": names}, mongomodel.TagFieldIsOfficial: true},
+		&official, options.Find().SetProjection(bson.M{mongomodel.TagFieldName: 1}))
+	if err != nil {
+		return nil, err
+	}
+	isOfficial := make(map[string]bool, len(official))
+	for _, t := range official {
+		isOfficial[t.Name] = true
+	}
+	for _, r := range rows {
+		tags = append(tags, trendingTag{Name: r.Name, PostCount: r.N, IsOfficial: isOfficial[r.Name]})
+	}
+	return tags, nil
+}
+
+// SetTagOfficial 设置或取消官方标签，标签不存在时创建，需要 admin 角色
+func (s *ContentService) SetTagOfficial(ctx context.Context, req *user_service.SetTagOfficialReq) (*user_service.Tag, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	name := normalizeTag(req.Name)
+	desc := strings.TrimSpace(req.Description)
+	if name == "" || utf8.RuneCountInString(name) > constants.PostTagMaxLen ||
+		utf8.RuneCountInString(desc) > constants.TagDescriptionMaxLen {
+		return nil, errors.ParamsError
+	}
+
+	now := uint(unixNow())
+	set := bson.M{mongomodel.TagFieldIsOfficial: req.Official, mongomodel.TagFieldUpdateTime: now}
+	if desc != "" {
+		set[mongomodel.TagFieldDescription] = desc
+	}
+	// 已合并的标签不能再设置
+	filter := bson.M{mongomodel.TagFieldName: name, mongomodel.TagFieldStatus: bson.M{"$ne": mongomodel.TagStatusMerged}}
+	_, err := mongodbutils.UpsertOne(ctx, tagColl, filter, bson.M{
+		"$set": set,
+		"$setOnInsert": bson.M{
+			mongomodel.TagFieldPostCount:  0,
+			mongomodel.TagFieldStatus:     mongomodel.TagStatusNormal,
+			mongomodel.TagFieldCreateTime: now,
+		},
+	})
+	if err != nil {
+		// 已合并的标签不匹配条件，插入时名称唯一索引冲突
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, errors.TagNotFound
+		}
+		return nil, errors.NewDBError("set official of tag %q failed: %v", name, err)
+	}
+	tag, err := findTag(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return toTag(tag), nil
+}
+
+// MergeTags 将 source 合并到 target，需要 admin 角色。帖子中的 source 替换为 target（已有 target 的去掉 source），
+// 重新统计 target 的帖子数；合并到 source 的旧标签改为合并到 target
+func (s *ContentService) MergeTags(ctx context.Context, req *user_service.MergeTagsReq) (*user_service.Tag, error) {
+	if err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	source, target := normalizeTag(req.Source), normalizeTag(req.Target)
+	if source == "" || target == "" || source == target {
+		return nil, errors.ParamsError
+	}
+	if _, err := findTag(ctx, source); err != nil {
+		return nil, err
+	}
+	if _, err := findTag(ctx, target); err != nil {
+		return nil, err
+	}
+
+	now := uint(unixNow())
+	err := withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		res, err := mongodbutils.UpdateOne(ctx, tagColl,
+			bson.M{mongomodel.TagFieldName: source, mongomodel.TagFieldStatus: mongomodel.TagStatusNormal},
+			bson.M{"$set": bson.M{
+				mongomodel.TagFieldStatus:     mongomodel.TagStatusMerged,
+				mongomodel.TagFieldMergedInto: target,
+				mongomodel.TagFieldPostCount:  0,
+				mongomodel.TagFieldUpdateTime: now,
+			}})
+		if err != nil {
+			return err
+		}
+		// 并发合并时 source 已不是正常状态
+		if res.ModifiedCount == 0 {
+			return errors.TagNotFound
+		}
+		_, err = mongodbutils.UpdateMany(ctx, tagColl,
+			bson.M{mongomodel.TagFieldMergedInto: source},
+			bson.M{"$set": bson.M{mongomodel.TagFieldMergedInto: target, mongomodel.TagFieldUpdateTime: now}})
+		if err != nil {
+			return err
+		}
+
+		_, err = mongodbutils.UpdateMany(ctx, postColl,
+			bson.M{mongomodel.PostFieldTags: bson.M{"$all": []string{source, target}}},
+			bson.M{"$pull": bson.M{mongomodel.PostFieldTags: source}})
+		if err != nil {
+			return err
+		}
+		_, err = mongodbutils.UpdateMany(ctx, postColl,
+			bson.M{mongomodel.PostFieldTags: source},
+			bson.M{"$set": bson.M{mongomodel.PostFieldTags + ".$": target}})
+		if err != nil {
+			return err
+		}
+
+		n, err := mongodbutils.CountDocuments(ctx, postColl, bson.M{
+			mongomodel.PostFieldTags:   target,
+			mongomodel.PostFieldStatus: bson.M{"$ne": mongomodel.PostStatusDeleted},
+		})
+		if err != nil {
+			return err
+		}
+		res, err = mongodbutils.UpdateOne(ctx, tagColl,
+			bson.M{mongomodel.TagFieldName: target, mongomodel.TagFieldStatus: mongomodel.TagStatusNormal},
+			bson.M{"$set": bson.M{mongomodel.TagFieldPostCount: n, mongomodel.TagFieldUpdateTime: now}})
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount == 0 {
+			return errors.TagNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		if stderrors.Is(err, errors.TagNotFound) {
+			return nil, errors.TagNotFound
+		}
+		return nil, errors.NewDBError("merge tag %q into %q failed: %v", source, target, err)
+	}
+	tag, err := findTag(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	return toTag(tag), nil
+}
+
+// normalizeTag 去掉首尾空白和开头的 #，合并连续空白，英文转小写
+func normalizeTag(tag string) string {
+	tag = strings.TrimLeft(strings.TrimSpace(tag), "#＃")
+	return strings.ToLower(strings.Join(strings.Fields(tag), " "))
+}
+
+// resolveTags 将已合并的标签改为合并到的标签，去重后保持原顺序
+func resolveTags(ctx context.Context, tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return []string{}, nil
+	}
+	var merged []*mongomodel.Tag
+	err := mongodbutils.Find(ctx, tagColl,
+		bson.M{mongomodel.TagFieldName: bson.M{"$in": tags}, mongomodel.TagFieldStatus: mongomodel.TagStatusMerged},
+		&merged, options.Find().SetProjection(bson.M{mongomodel.TagFieldName: 1, mongomodel.TagFieldMergedInto: 1}))
+	if err != nil {
+		return nil, errors.NewDBError("query merged tags failed: %v", err)
+	}
+	if len(merged) == 0 {
+		return tags, nil
+	}
+	into := make(map[string]string, len(merged))
+	for _, t := range merged {
+		into[t.Name] = t.MergedInto
+	}
+	out := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		if target, ok := into[tag]; ok {
+			tag = target
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		out = append(out, tag)
+	}
+	return out, nil
+}
+
+// adjustTagCounts 按帖子修改前后的标签修改标签的帖子数，新标签不存在时创建；需要在事务中与帖子一起修改
+func adjustTagCounts(ctx context.Context, before, after []string) error {
+	added, removed := diffTags(before, after)
+	now := uint(unixNow())
+	for _, name := range added {
+		_, err := mongodbutils.UpsertOne(ctx, tagColl, bson.M{mongomodel.TagFieldName: name}, bson.M{
+			"$inc": bson.M{mongomodel.TagFieldPostCount: 1},
+			"$set": bson.M{mongomodel.TagFieldUpdateTime: now},
+			"$setOnInsert": bson.M{
+				mongomodel.TagFieldIsOfficial: false,
+				mongomodel.TagFieldStatus:     mongomodel.TagStatusNormal,
+				mongomodel.TagFieldCreateTime: now,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, name := range removed {
+		_, err := mongodbutils.UpdateOne(ctx, tagColl,
+			bson.M{mongomodel.TagFieldName: name, mongomodel.TagFieldPostCount: bson.M{"$gt": 0}},
+			bson.M{"$inc": bson.M{mongomodel.TagFieldPostCount: -1}, "$set": bson.M{mongomodel.TagFieldUpdateTime: now}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffTags 修改后新增和去掉的标签
+func diffTags(before, after []string) (added, removed []string) {
+	old := make(map[string]struct{}, len(before))
+	for _, t := range before {
+		old[t] = struct{}{}
+	}
+	cur := make(map[string]struct{}, len(after))
+	for _, t := range after {
+		cur[t] = struct{}{}
+		if _, ok := old[t]; !ok {
+			added = append(added, t)
+		}
+	}
+	for _, t := range before {
+		if _, ok := cur[t]; !ok {
+			removed = append(removed, t)
+		}
+	}
+	return added, removed
+}
+
+// findTag 查询正常状态的标签
+func findTag(ctx context.Context, name string) (*mongomodel.Tag, error) {
+	var tag mongomodel.Tag
+	err := mongodbutils.FindOne(ctx, tagColl, bson.M{mongomodel.TagFieldName: name, mongomodel.TagFieldStatus: mongomodel.TagStatusNormal}, &tag)
+	if err != nil {
+		return nil, errors.NewDBError("query tag %q failed: %v", name, err)
+	}
+	if tag.ID.IsZero() {
+		return nil, errors.TagNotFound
+	}
+	return &tag, nil
+}
+
+// tagLimit 标签列表返回数，默认10、最大50
+func tagLimit(limit int32) int32 {
+	if limit <= 0 {
+		return constants.TagSearchLimit
+	}
+	if limit > constants.TagSearchLimitMax {
+		return constants.TagSearchLimitMax
+	}
+	return limit
+}
+
+func toTag(t *mongomodel.Tag) *user_service.Tag {
+	return &user_service.Tag{
+		Name:        t.Name,
+		Description: t.Description,
+		PostCount:   t.PostCount,
+		IsOfficial:  t.IsOfficial,
+	}
+}
@@ -2,11 +2,31 @@ package service
 
 import (
 	"context"
+	stderrors "errors"
+	"fmt"
+	"regexp"
+	"time"
+	"unicode/utf8"
 
+	"common/applog"
+	"common/idgen"
+	"common/jwtutils"
+	"common/session"
 	"grpc/user/user"
+	"user/config"
+	"user/internal/ent"
+	"user/internal/ent/schema"
+	entuser "user/internal/ent/user"
+	"user/pkg/auth"
+	"user/pkg/constants"
+	"user/pkg/database"
 	"user/pkg/errors"
+
+	"golang.org/x/crypto/bcrypt"
 )
 
+var phoneRegexp = regexp.MustCompile(`^1[3-9]\d{9}$`)
+
 type UserService struct {
 	user_service.UnimplementedUserServer
 }
@@ -18,3 +38,255 @@ func NewUserService() *UserService {
 func (s *UserService) Test(ctx context.Context, req *user_service.Req) (*user_service.Resp, error) {
 	return nil, errors.NewDBError("错误")
 }
+
+// Register 手机号+密码注册，同一手机号只能有一个未注销的账号（正常或封禁）
+func (s *UserService) Register(ctx context.Context, req *user_service.RegisterReq) (*user_service.RegisterResp, error) {
+	if !phoneRegexp.MatchString(req.Phone) {
+		return nil, errors.NoLegalMobile
+	}
+	if err := checkPassword(req.Password); err != nil {
+		return nil, err
+	}
+	nickname := req.Nickname
+	if nickname == "" {
+		nickname = fmt.Sprintf(constants.DefaultNicknameFmt, req.Phone[len(req.Phone)-4:])
+	}
+	if utf8.RuneCountInString(nickname) > constants.NicknameMaxLen {
+		return nil, errors.ParamsError
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, errors.NewErrorWithCode(errors.PasswordInvalidCode, "hash password failed: %v", err)
+	}
+	// 查重和创建在同一事务中，事务内读主库，不受从库延迟影响
+	var u *ent.User
+	err = database.WithTx(ctx, func(ctx context.Context, tx *ent.Tx) error {
+		exist, err := tx.User.Query().
+			Where(entuser.Phone(req.Phone), entuser.StatusIn(schema.UserStatusNormal, schema.UserStatusBanned)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("query user by phone: %w", err)
+		}
+		if exist {
+			return errors.PhoneRegistered
+		}
+		now := unixNow()
+		u, err = tx.User.Create().
+			SetID(idgen.NextID()).
+			SetPhone(req.Phone).
+			SetPassword(string(hash)).
+			SetNickname(nickname).
+			SetStatus(schema.UserStatusNormal).
+			SetLogoffTime(0).
+			SetCreatedAt(now).
+			SetUpdatedAt(now).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		// 并发注册时由 uk_phone_status_logoff_time 拦截
+		if stderrors.Is(err, errors.PhoneRegistered) || ent.IsConstraintError(err) {
+			return nil, errors.PhoneRegistered
+		}
+		return nil, errors.NewDBError("register user failed: %v", err)
+	}
+	return &user_service.RegisterResp{UserId: u.ID}, nil
+}
+
+// Login 手机号+密码登录，封禁到期的账号在登录时自动解封
+func (s *UserService) Login(ctx context.Context, req *user_service.LoginReq) (*user_service.TokenResp, error) {
+	if req.Phone == "" || req.Password == "" || req.DeviceId == "" {
+		return nil, errors.ParamsError
+	}
+	// 读主库，刚注册、封禁或修改的账号不受从库延迟影响
+	u, err := database.GetEntClient().User.Query().
+		Where(entuser.Phone(req.Phone), entuser.StatusIn(schema.UserStatusNormal, schema.UserStatusBanned)).
+		Only(database.WithMaster(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.LoginFailed
+		}
+		return nil, errors.NewDBError("query user by phone failed: %v", err)
+	}
+	if bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(req.Password)) != nil {
+		return nil, errors.LoginFailed
+	}
+	if err := checkBanned(ctx, u); err != nil {
+		return nil, err
+	}
+
+	tokens, evicted, err := auth.GetSessionManager().Login(ctx, uint64(u.ID), req.DeviceId, roles(u.ID)...)
+	if err != nil {
+		return nil, errors.NewRedisError("create session failed: %v", err)
+	}
+	if len(evicted) > 0 {
+		applog.WrapGDPLogger(ctx).Info(fmt.Sprintf("user %d login on %s, evicted devices %v", u.ID, req.DeviceId, evicted))
+	}
+	return tokenResp(u.ID, tokens), nil
+}
+
+// RefreshToken 用 refresh token 换取新的令牌
+func (s *UserService) RefreshToken(ctx context.Context, req *user_service.RefreshTokenReq) (*user_service.TokenResp, error) {
+	if req.RefreshToken == "" || req.DeviceId == "" {
+		return nil, errors.ParamsError
+	}
+	tokens, err := auth.GetSessionManager().Refresh(ctx, req.RefreshToken, req.DeviceId)
+	if err != nil {
+		return nil, sessionError(err)
+	}
+	claims, err := auth.GetKeyring().Parse(tokens.AccessToken, jwtutils.TokenTypeAccess, req.DeviceId)
+	if err != nil {
+		return nil, jwtutils.ToBError(err)
+	}
+	return tokenResp(int64(claims.UserID), tokens), nil
+}
+
+// Logout 下线当前设备或所有设备
+func (s *UserService) Logout(ctx context.Context, req *user_service.LogoutReq) (*user_service.Empty, error) {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.All {
+		err = auth.GetSessionManager().LogoutAll(ctx, claims.UserID)
+	} else {
+		err = auth.GetSessionManager().Logout(ctx, claims.UserID, claims.DeviceID)
+	}
+	if err != nil {
+		return nil, errors.NewRedisError("revoke session failed: %v", err)
+	}
+	return &user_service.Empty{}, nil
+}
+
+// Logoff 注销当前用户，注销后 status、logoff_time 变化，手机号可以重新注册
+func (s *UserService) Logoff(ctx context.Context, req *user_service.LogoffReq) (*user_service.Empty, error) {
+	claims, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := getUser(ctx, int64(claims.UserID))
+	if err != nil {
+		return nil, err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(req.Password)) != nil {
+		return nil, errors.LoginFailed
+	}
+
+	now := unixNow()
+	n, err := database.GetEntClient().User.Update().
+		Where(entuser.ID(u.ID), entuser.StatusIn(schema.UserStatusNormal, schema.UserStatusBanned)).
+		SetStatus(schema.UserStatusLogoff).
+		SetLogoffTime(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		// 同一手机号一秒内重复注册、注销
+		if ent.IsConstraintError(err) {
+			return nil, errors.NewErrorWithCode(errors.ParamsErrorCode, "logoff user %d conflict: %v", u.ID, err)
+		}
+		return nil, errors.NewDBError("logoff user %d failed: %v", u.ID, err)
+	}
+	if n == 0 {
+		return nil, errors.UserNotFound
+	}
+	if err := auth.GetSessionManager().LogoutAll(ctx, claims.UserID); err != nil {
+		return nil, errors.NewRedisError("revoke sessions of user %d failed: %v", u.ID, err)
+	}
+	return &user_service.Empty{}, nil
+}
+
+// currentUser 当前登录用户，由 AuthInterceptor 从令牌解析
+func currentUser(ctx context.Context) (*jwtutils.Claims, error) {
+	claims, ok := jwtutils.ClaimsFromContext(ctx)
+	if !ok || claims.UserID == 0 {
+		return nil, errors.Unauthorized
+	}
+	return claims, nil
+}
+
+// getUser 查询未注销的用户
+func getUser(ctx context.Context, id int64) (*ent.User, error) {
+	u, err := database.GetEntClient().User.Query().
+		Where(entuser.ID(id), entuser.StatusIn(schema.UserStatusNormal, schema.UserStatusBanned)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.UserNotFound
+		}
+		return nil, errors.NewDBError("query user %d failed: %v", id, err)
+	}
+	return u, nil
+}
+
+// checkBanned 封禁中返回 UserBanned，封禁已到期则解封
+func checkBanned(ctx context.Context, u *ent.User) error {
+	if u.Status != schema.UserStatusBanned {
+		return nil
+	}
+	now := unixNow()
+	if u.UnbannedTime == 0 || u.UnbannedTime > now {
+		return errors.UserBanned
+	}
+	// 条件更新，避免覆盖并发的重新封禁
+	err := database.GetEntClient().User.Update().
+		Where(entuser.ID(u.ID), entuser.Status(schema.UserStatusBanned), entuser.UnbannedTime(u.UnbannedTime)).
+		SetStatus(schema.UserStatusNormal).
+		SetUpdatedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return errors.NewDBError("unban user %d failed: %v", u.ID, err)
+	}
+	u.Status = schema.UserStatusNormal
+	return nil
+}
+
+func checkPassword(password string) error {
+	if n := len(password); n < constants.PasswordMinLen || n > constants.PasswordMaxLen {
+		return errors.PasswordInvalid
+	}
+	return nil
+}
+
+// roles 令牌中的角色，管理员由 [server] admins 配置
+func roles(userID int64) []string {
+	for _, id := range config.GetConfig().Server.Admins {
+		if id == userID {
+			return []string{jwtutils.RoleAdmin}
+		}
+	}
+	return nil
+}
+
+// sessionError 会话错误转换为业务错误，refresh token 无效或被重复使用都需要重新登录
+func sessionError(err error) error {
+	switch {
+	case stderrors.Is(err, session.ErrSessionNotFound),
+		stderrors.Is(err, session.ErrRefreshReused),
+		stderrors.Is(err, session.ErrRevoked):
+		return errors.Unauthorized
+	case stderrors.Is(err, jwtutils.ErrTokenExpired),
+		stderrors.Is(err, jwtutils.ErrTokenMalformed),
+		stderrors.Is(err, jwtutils.ErrSignatureInvalid),
+		stderrors.Is(err, jwtutils.ErrTokenType),
+		stderrors.Is(err, jwtutils.ErrDeviceMismatch),
+		stderrors.Is(err, jwtutils.ErrClaimsInvalid):
+		return jwtutils.ToBError(err)
+	default:
+		return errors.NewRedisError("refresh session failed: %v", err)
+	}
+}
+
+func tokenResp(userID int64, tokens *session.Tokens) *user_service.TokenResp {
+	return &user_service.TokenResp{
+		UserId:        userID,
+		AccessToken:   tokens.AccessToken,
+		RefreshToken:  tokens.RefreshToken,
+		AccessExpire:  tokens.AccessExpire,
+		RefreshExpire: tokens.RefreshExpire,
+	}
+}
+
+func unixNow() uint32 {
+	return uint32(time.Now().Unix())
+}
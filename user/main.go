@@ -2,30 +2,20 @@ package main
 
 import (
 	"context"
+	"log"
 
 	srv "common"
-	"user/pkg/grpc"
 	"user/pkg/initialize"
 )
 
 func main() {
 	ctx := context.Background()
-	initialize.MustInit(ctx)
-	//grpc服务注册
-	gc, err := grpc.RegisterGrpc()
+	//按依赖顺序启动组件：applog -> redis/mysql/mongo -> grpc -> etcd
+	//关闭时先从etcd注销，等待客户端感知后停止grpc，再刷新日志和追踪，最后关闭存储连接
+	lc, err := initialize.Init(ctx)
 	if err != nil {
-		panic(err)
-	}
-	//grpc服务注册到etcd
-	r, err := grpc.RegisterEtcdServer(ctx)
-	if err != nil {
-		panic(err)
-	}
-
-	stop := func() {
-		gc.Stop()
-		r.Stop()
+		log.Fatalf("user service start failed: %v", err)
 	}
 
-	srv.Run(stop)
+	srv.Run(lc)
 }
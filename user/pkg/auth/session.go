@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"errors"
+
+	"common/jwtutils"
+	"common/session"
+	"user/config"
+	"user/pkg/redisutils"
+)
+
+var (
+	keyring  *jwtutils.Keyring
+	sessions *session.Manager
+)
+
+// InitSession 加载签名密钥并创建会话管理，依赖 redis
+func InitSession() (err error) {
+	client := redisutils.GetClient()
+	if client == nil {
+		return errors.New("redis not initialized")
+	}
+	cfg := config.GetConfig()
+	if keyring, err = jwtutils.NewKeyring(cfg.Jwt); err != nil {
+		return err
+	}
+	sessions = session.NewManager(session.NewStore(client, cfg.Session, cfg.Jwt), keyring)
+	return nil
+}
+
+// GetKeyring 签名和校验令牌的密钥
+func GetKeyring() *jwtutils.Keyring {
+	return keyring
+}
+
+// GetSessionManager 会话管理：登录签发令牌、刷新、下线
+func GetSessionManager() *session.Manager {
+	return sessions
+}
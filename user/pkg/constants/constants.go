@@ -1 +1,96 @@
 package constants
+
+import "time"
+
+// 密码长度限制，bcrypt 最多使用前72字节
+const (
+	PasswordMinLen = 8
+	PasswordMaxLen = 32
+)
+
+// 资料字段限制
+const (
+	NicknameMaxLen     = 50
+	AvatarURLMaxLen    = 255
+	MinAdmissionGrade  = 1949
+	DefaultNicknameFmt = "用户%s" // 注册未填写昵称时使用，%s 为手机号后四位
+)
+
+// 分页
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// 学校、专业目录
+const (
+	CatalogNameMaxLen    = 100
+	CatalogDescMaxLen    = 255
+	CatalogKeywordMaxLen = 50
+	CatalogCacheTTL      = 10 * time.Minute
+	// CatalogVersionKey 目录缓存版本，每次修改后递增，旧版本的缓存随过期时间淘汰
+	CatalogVersionKey = "catalog:version"
+)
+
+// 帖子内容限制
+const (
+	PostTitleMaxLen      = 100
+	PostContentMaxLen    = 10000
+	PostImagesMax        = 9
+	PostTagsMax          = 5
+	PostTagMaxLen        = 20
+	PostImageURLMaxLen   = 255
+	AnonymousNameMaxLen  = 20
+	DefaultAnonymousName = "匿名用户"
+	CommentMaxLen        = 1000
+)
+
+// 楼中楼评论
+const (
+	CommentReplyLimit    = 3  // 顶层评论列表中默认附带的回复数
+	CommentReplyLimitMax = 10 // 顶层评论列表中最多附带的回复数
+	// DeletedCommentContent 已删除评论的占位内容
+	DeletedCommentContent = "该评论已删除"
+)
+
+// 标签
+const (
+	TagSearchLimit       = 10 // 标签补全默认返回数
+	TagSearchLimitMax    = 50
+	TagDescriptionMaxLen = 200
+	TagTrendingHours     = 24 // 热门标签默认统计最近24小时
+	TagTrendingHoursMax  = 720
+	TagTrendingCacheTTL  = 5 * time.Minute
+	TagTrendingKey       = "content:tags:trending"
+)
+
+// 推荐
+const (
+	FeedBehaviorDays  = 30  // 按最近30天的行为计算标签偏好
+	FeedBehaviorLimit = 500 // 最多使用最近500条行为
+	FeedTopTags       = 10  // 取偏好最高的10个标签
+	FeedCandidateDays = 7   // 候选帖子的发布时间范围
+	FeedCandidates    = 300 // 按标签召回的候选帖子数
+	FeedMaxSize       = 200 // 推荐列表长度
+	FeedHotDays       = 3   // 热门列表统计最近3天的帖子
+	FeedHotCandidates = 500
+	FeedCacheTTL      = 10 * time.Minute // 个人推荐列表缓存，翻页时使用同一份列表
+	FeedRefreshMinAge = time.Minute      // 回到第一页时，列表计算超过1分钟才重新计算
+	FeedHotCacheTTL   = 5 * time.Minute
+	FeedKey           = "content:feed"
+	FeedHotKey        = "content:feed:hot"
+
+	// 推荐得分 = 标签偏好 * 0.5 + 新鲜度 * 0.3 + 热度 * 0.2，各项归一化到 0~1
+	FeedAffinityWeight   = 0.5
+	FeedRecencyWeight    = 0.3
+	FeedPopularityWeight = 0.2
+	FeedHalfLifeHours    = 24 // 新鲜度每24小时减半
+)
+
+// 帖子计数校正
+const (
+	ReconcileMaxDrifts = 100 // 接口返回的差异条数上限，日志中记录全部差异
+	// ReconcileLockKey 定时校正的锁，多实例部署时每个周期只有一个实例执行
+	ReconcileLockKey = "content:reconcile:lock"
+	ReconcileLockTTL = time.Minute // 校正期间锁的有效期，每1/3有效期续期一次，实例异常退出后锁最多保留该时间
+)
@@ -0,0 +1,243 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"common/applog"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const defaultSlowQuery = 200 * time.Millisecond
+
+// LogOptions SQL 日志配置，对应 [mysql] 中的同名配置
+type LogOptions struct {
+	OutLog    bool          // 是否打印每条 SQL，慢查询不受影响
+	SQLLen    int           // 打印 SQL 的长度，0 不打印，-1 全部
+	ArgsLen   int           // 打印参数的长度，0 不打印，-1 全部
+	LogID     bool          // 是否以 SQL 注释传递 trace id，便于在慢日志、processlist 中定位请求
+	SlowQuery time.Duration // 超过该耗时的语句记录为慢查询，0 使用默认值
+}
+
+// LogDriver 记录 SQL 日志、慢查询和链路追踪的 ent 驱动，替代 ent.Debug()
+type LogDriver struct {
+	dialect.Driver
+	opts LogOptions
+}
+
+var _ dialect.Driver = (*LogDriver)(nil)
+
+// NewLogDriver 包装驱动
+func NewLogDriver(drv dialect.Driver, opts LogOptions) *LogDriver {
+	if opts.SlowQuery <= 0 {
+		opts.SlowQuery = defaultSlowQuery
+	}
+	return &LogDriver{Driver: drv, opts: opts}
+}
+
+// Exec 执行写语句
+func (d *LogDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return d.opts.do(ctx, "exec", query, args, func(ctx context.Context, query string) error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+// Query 执行查询
+func (d *LogDriver) Query(ctx context.Context, query string, args, v any) error {
+	return d.opts.do(ctx, "query", query, args, func(ctx context.Context, query string) error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+// ExecContext 供 ent 的 sql/execquery 特性使用
+func (d *LogDriver) ExecContext(ctx context.Context, query string, args ...any) (res sql.Result, err error) {
+	ex, ok := d.Driver.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	err = d.opts.do(ctx, "exec", query, args, func(ctx context.Context, query string) (err error) {
+		res, err = ex.ExecContext(ctx, query, args...)
+		return err
+	})
+	return res, err
+}
+
+// QueryContext 供 ent 的 sql/execquery 特性使用
+func (d *LogDriver) QueryContext(ctx context.Context, query string, args ...any) (rows *sql.Rows, err error) {
+	q, ok := d.Driver.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	err = d.opts.do(ctx, "query", query, args, func(ctx context.Context, query string) (err error) {
+		rows, err = q.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
+
+// Tx 开启事务，事务内的语句同样记录
+func (d *LogDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &logTx{Tx: tx, opts: d.opts}, nil
+}
+
+// BeginTx 带选项开启事务
+func (d *LogDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &logTx{Tx: tx, opts: d.opts}, nil
+}
+
+// logTx 记录日志的事务
+type logTx struct {
+	dialect.Tx
+	opts LogOptions
+}
+
+func (tx *logTx) Exec(ctx context.Context, query string, args, v any) error {
+	return tx.opts.do(ctx, "exec", query, args, func(ctx context.Context, query string) error {
+		return tx.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+func (tx *logTx) Query(ctx context.Context, query string, args, v any) error {
+	return tx.opts.do(ctx, "query", query, args, func(ctx context.Context, query string) error {
+		return tx.Tx.Query(ctx, query, args, v)
+	})
+}
+
+// ExecContext 保存点等原生语句经由这里执行
+func (tx *logTx) ExecContext(ctx context.Context, query string, args ...any) (res sql.Result, err error) {
+	ex, ok := tx.Tx.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	err = tx.opts.do(ctx, "exec", query, args, func(ctx context.Context, query string) (err error) {
+		res, err = ex.ExecContext(ctx, query, args...)
+		return err
+	})
+	return res, err
+}
+
+func (tx *logTx) QueryContext(ctx context.Context, query string, args ...any) (rows *sql.Rows, err error) {
+	q, ok := tx.Tx.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	err = tx.opts.do(ctx, "query", query, args, func(ctx context.Context, query string) (err error) {
+		rows, err = q.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
+
+// do 为语句创建 span，按配置注入 trace id 注释，执行后记录日志和慢查询
+func (o LogOptions) do(ctx context.Context, op, query string, args any, fn func(ctx context.Context, query string) error) error {
+	ctx, span := otel.Tracer("user/database").Start(ctx, "mysql."+op,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "mysql"),
+			attribute.String("db.operation", op),
+			attribute.String("db.statement", truncate(query, o.SQLLen)),
+		))
+	defer span.End()
+
+	// 日志中已有 trace id，注释只加在发往 MySQL 的语句上
+	sent := query
+	if o.LogID {
+		if id := traceID(ctx); id != "" {
+			sent = "/* trace_id=" + id + " */ " + query
+		}
+	}
+
+	start := time.Now()
+	err := fn(ctx, sent)
+	cost := time.Since(start)
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	slow := cost >= o.SlowQuery
+	if slow {
+		span.SetAttributes(attribute.Bool("db.slow", true))
+	}
+	if !o.OutLog && !slow && err == nil {
+		return nil
+	}
+
+	msg := fmt.Sprintf("[mysql] %s cost=%s sql=%s args=%s", op, cost, truncate(query, o.SQLLen), truncate(formatArgs(args), o.ArgsLen))
+	logger := applog.WrapGDPLogger(ctx)
+	switch {
+	case err != nil:
+		// 出错的语句总是记录，错误本身由上层处理
+		logger.Warn(msg, err)
+	case slow:
+		logger.Warn("[slow query] " + msg)
+	default:
+		logger.Info(msg)
+	}
+	return err
+}
+
+// traceID 当前请求的 trace id，只接受字母、数字和 -，避免注入注释
+func traceID(ctx context.Context) string {
+	id := ""
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		id = sc.TraceID().String()
+	} else {
+		id = applog.WrapGDPLogger(ctx).ID()
+	}
+	for _, r := range id {
+		if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '-') {
+			return ""
+		}
+	}
+	return id
+}
+
+func formatArgs(args any) string {
+	if args == nil {
+		return "[]"
+	}
+	return fmt.Sprint(args)
+}
+
+// truncate 按字符截断，n 为0返回空，小于0不截断
+func truncate(s string, n int) string {
+	switch {
+	case n == 0:
+		return ""
+	case n < 0 || utf8.RuneCountInString(s) <= n:
+		return s
+	default:
+		return string([]rune(s)[:n]) + "..."
+	}
+}
@@ -1,7 +1,9 @@
 package database
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -14,40 +16,13 @@ import (
 )
 
 var (
-	mysqlClientConn *sql.DB   // 主库连接（写）
-	slaveDBs        []*sql.DB // 从库连接（读）
-	slaveEntDrvs    []*dialectsql.Driver
+	mysqlClientConn *sql.DB     // 主库连接（写）
+	slaveDBs        []*sql.DB   // 从库连接（读）
 	entClient       *ent.Client // Ent 客户端实例
 )
 
 func InitMysqlConnect() (err error) {
 	mysqlConfig := config.GetConfig().Mysql
-	var (
-		DefaultMaxConnectLifeTime = 10  // 最长连接生命周期（s）
-		DefaultMaxIdleConnect     = 50  // 最大空闲连接数
-		DefaultMaxOpenConnect     = 100 // 最大打开连接数
-	)
-
-	// 设置连接池（复用原有逻辑）
-	setDBPool := func(db *sql.DB) {
-		maxOpenConnect := DefaultMaxOpenConnect
-		if mysqlConfig.MaxOpenCount > 0 {
-			maxOpenConnect = mysqlConfig.MaxOpenCount
-		}
-		db.SetMaxOpenConns(maxOpenConnect)
-
-		maxIdleConnect := DefaultMaxIdleConnect
-		if mysqlConfig.MaxIdleCount > 0 {
-			maxIdleConnect = mysqlConfig.MaxIdleCount
-		}
-		db.SetMaxIdleConns(maxIdleConnect)
-
-		maxConnectLifeTime := time.Second * time.Duration(DefaultMaxConnectLifeTime)
-		if mysqlConfig.ConnMaxLifeTime > 0 {
-			maxConnectLifeTime = time.Second * time.Duration(mysqlConfig.ConnMaxLifeTime)
-		}
-		db.SetConnMaxLifetime(maxConnectLifeTime)
-	}
 
 	// 单库模式（无读写分离）
 	if !mysqlConfig.Separation {
@@ -63,13 +38,13 @@ func InitMysqlConnect() (err error) {
 		if err != nil {
 			return fmt.Errorf("init single mysql connect failed: %v", err)
 		}
-		setDBPool(db)
+		setPool(db, mysqlConfig)
 		mysqlClientConn = db
 		slaveDBs = []*sql.DB{}
 
 		// 初始化 Ent 客户端（单库模式） )
 		drv := dialectsql.OpenDB(dialect.MySQL, db)
-		entClient = ent.NewClient(ent.Driver(drv), ent.Debug())
+		entClient = ent.NewClient(ent.Driver(NewLogDriver(drv, logOptions(mysqlConfig))))
 		return nil
 	}
 
@@ -85,12 +60,11 @@ func InitMysqlConnect() (err error) {
 	if err != nil {
 		return fmt.Errorf("init master mysql connect failed: %v", err)
 	}
-	setDBPool(masterDB)
+	setPool(masterDB, mysqlConfig)
 	mysqlClientConn = masterDB
 
 	// 读写分离模式：初始化从库（读）
 	slaveDBs = []*sql.DB{}
-	slaveEntDrvs = []*dialectsql.Driver{} // 初始化从库 Ent 驱动切片
 	for i, slave := range mysqlConfig.Slaves {
 		slaveDSN := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?%s",
 			slave.Username,
@@ -103,16 +77,93 @@ func InitMysqlConnect() (err error) {
 		if err != nil {
 			return fmt.Errorf("init slave %d mysql connect failed: %v", i+1, err)
 		}
-		setDBPool(slaveDB)
+		setPool(slaveDB, mysqlConfig)
 		slaveDBs = append(slaveDBs, slaveDB)
+	}
+
+	// 初始化 Ent 客户端（读写分离模式，查询走从库，写和事务走主库）
+	rwDriver := NewRWDriver(masterDB, slaveDBs, RWDriverOptions{
+		Policy:        mysqlConfig.SlavePolicy,
+		CheckInterval: time.Duration(mysqlConfig.SlaveCheckTime) * time.Second,
+		MaxLag:        time.Duration(mysqlConfig.SlaveMaxLag) * time.Second,
+	})
+	entClient = ent.NewClient(ent.Driver(NewLogDriver(rwDriver, logOptions(mysqlConfig))))
+
+	return nil
+}
+
+// logOptions SQL 日志配置
+func logOptions(mysqlConfig config.MysqlConfig) LogOptions {
+	return LogOptions{
+		OutLog:    mysqlConfig.IsOutLog,
+		SQLLen:    mysqlConfig.SQLLogLen,
+		ArgsLen:   mysqlConfig.SQLArgsLogLen,
+		LogID:     mysqlConfig.LogIDTransport,
+		SlowQuery: time.Duration(mysqlConfig.SlowQueryTime) * time.Millisecond,
+	}
+}
+
+// GetEntClient ent 客户端
+func GetEntClient() *ent.Client {
+	return entClient
+}
 
-		slaveEntDrv := dialectsql.OpenDB(dialect.MySQL, slaveDB)
-		slaveEntDrvs = append(slaveEntDrvs, slaveEntDrv)
+// setPool 设置连接池，配置为0时使用默认值
+func setPool(db *sql.DB, mysqlConfig config.MysqlConfig) {
+	var (
+		DefaultMaxConnectLifeTime = 10  // 最长连接生命周期（s）
+		DefaultMaxIdleConnect     = 50  // 最大空闲连接数
+		DefaultMaxOpenConnect     = 100 // 最大打开连接数
+	)
+
+	maxOpenConnect := DefaultMaxOpenConnect
+	if mysqlConfig.MaxOpenCount > 0 {
+		maxOpenConnect = mysqlConfig.MaxOpenCount
+	}
+	db.SetMaxOpenConns(maxOpenConnect)
+
+	maxIdleConnect := DefaultMaxIdleConnect
+	if mysqlConfig.MaxIdleCount > 0 {
+		maxIdleConnect = mysqlConfig.MaxIdleCount
+	}
+	db.SetMaxIdleConns(maxIdleConnect)
+
+	maxConnectLifeTime := time.Second * time.Duration(DefaultMaxConnectLifeTime)
+	if mysqlConfig.ConnMaxLifeTime > 0 {
+		maxConnectLifeTime = time.Second * time.Duration(mysqlConfig.ConnMaxLifeTime)
+	}
+	db.SetConnMaxLifetime(maxConnectLifeTime)
+}
+
+// ResizePool 按当前配置调整主从库连接池，用于配置热更新
+func ResizePool() error {
+	if mysqlClientConn == nil {
+		return errors.New("mysql not initialized")
+	}
+	mysqlConfig := config.GetConfig().Mysql
+	setPool(mysqlClientConn, mysqlConfig)
+	for _, db := range slaveDBs {
+		setPool(db, mysqlConfig)
 	}
+	return nil
+}
 
-	// 初始化 Ent 客户端（读写分离模式，默认连接主库）
-	drv := dialectsql.OpenDB(dialect.MySQL, masterDB)
-	entClient = ent.NewClient(ent.Driver(drv), ent.Debug())
+// Ping 检查主库连接（ent 客户端使用的连接）
+func Ping(ctx context.Context) error {
+	if mysqlClientConn == nil {
+		return errors.New("mysql not initialized")
+	}
+	return mysqlClientConn.PingContext(ctx)
+}
 
+// Close 关闭 ent 客户端及主从库连接
+func Close(ctx context.Context) error {
+	if entClient == nil {
+		return nil
+	}
+	// ent 客户端关闭时会关闭主库连接，读写分离模式下由 RWDriver 同时关闭从库连接
+	if err := entClient.Close(); err != nil {
+		return fmt.Errorf("close mysql failed: %w", err)
+	}
 	return nil
 }
@@ -0,0 +1,282 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"common/applog"
+
+	"entgo.io/ent/dialect"
+	dialectsql "entgo.io/ent/dialect/sql"
+)
+
+// 从库负载均衡策略
+const (
+	PolicyRoundRobin = "round_robin" // 轮询
+	PolicyLeastConn  = "least_conn"  // 最少进行中查询
+)
+
+const (
+	defaultCheckInterval = 5 * time.Second
+	defaultMaxLag        = 10 * time.Second
+	checkTimeout         = 2 * time.Second
+)
+
+type masterKey struct{}
+
+// WithMaster 标记 ctx 中的查询强制走主库，用于写后立即读（read-your-writes）
+func WithMaster(ctx context.Context) context.Context {
+	return context.WithValue(ctx, masterKey{}, true)
+}
+
+// UseMaster ctx 是否标记为强制走主库
+func UseMaster(ctx context.Context) bool {
+	v, _ := ctx.Value(masterKey{}).(bool)
+	return v
+}
+
+// replica 从库及其健康状态
+type replica struct {
+	name     string
+	db       *sql.DB
+	drv      *dialectsql.Driver
+	healthy  atomic.Bool
+	inflight atomic.Int64 // 进行中的查询数，least_conn 策略使用
+}
+
+// RWDriverOptions 读写分离驱动配置，零值使用默认值
+type RWDriverOptions struct {
+	Policy        string        // round_robin / least_conn，默认 round_robin
+	CheckInterval time.Duration // 从库健康检查间隔
+	MaxLag        time.Duration // 复制延迟超过该值时摘除从库
+}
+
+// RWDriver 读写分离的 ent 驱动：Exec 和事务走主库，Query 走健康的从库，
+// 没有健康从库或 ctx 标记 WithMaster 时走主库
+type RWDriver struct {
+	master   *dialectsql.Driver
+	replicas []*replica
+	opts     RWDriverOptions
+	next     atomic.Uint64
+
+	closeOnce sync.Once
+	closeCh   chan struct{}
+	wg        sync.WaitGroup
+}
+
+var _ dialect.Driver = (*RWDriver)(nil)
+
+// NewRWDriver 创建读写分离驱动，同步检查一次从库后在后台周期检查
+func NewRWDriver(master *sql.DB, slaves []*sql.DB, opts RWDriverOptions) *RWDriver {
+	if opts.Policy == "" {
+		opts.Policy = PolicyRoundRobin
+	}
+	if opts.CheckInterval <= 0 {
+		opts.CheckInterval = defaultCheckInterval
+	}
+	if opts.MaxLag <= 0 {
+		opts.MaxLag = defaultMaxLag
+	}
+	d := &RWDriver{
+		master:  dialectsql.OpenDB(dialect.MySQL, master),
+		opts:    opts,
+		closeCh: make(chan struct{}),
+	}
+	for i, db := range slaves {
+		d.replicas = append(d.replicas, &replica{
+			name: "slave" + strconv.Itoa(i+1),
+			db:   db,
+			drv:  dialectsql.OpenDB(dialect.MySQL, db),
+		})
+	}
+	if len(d.replicas) > 0 {
+		d.checkAll()
+		d.wg.Add(1)
+		go d.loop()
+	}
+	return d
+}
+
+// Exec 写操作走主库
+func (d *RWDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return d.master.Exec(ctx, query, args, v)
+}
+
+// Query 读操作走从库，ctx 标记 WithMaster 或没有健康从库时走主库
+func (d *RWDriver) Query(ctx context.Context, query string, args, v any) error {
+	if UseMaster(ctx) {
+		return d.master.Query(ctx, query, args, v)
+	}
+	r := d.pick()
+	if r == nil {
+		return d.master.Query(ctx, query, args, v)
+	}
+	r.inflight.Add(1)
+	defer r.inflight.Add(-1)
+	return r.drv.Query(ctx, query, args, v)
+}
+
+// Tx 事务内的读写都走主库
+func (d *RWDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.master.Tx(ctx)
+}
+
+// BeginTx 带选项的事务，ent 客户端的 BeginTx 使用
+func (d *RWDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	return d.master.BeginTx(ctx, opts)
+}
+
+// Dialect 方言
+func (d *RWDriver) Dialect() string {
+	return dialect.MySQL
+}
+
+// Close 停止健康检查并关闭主从库连接
+func (d *RWDriver) Close() error {
+	d.closeOnce.Do(func() { close(d.closeCh) })
+	d.wg.Wait()
+
+	var errs []error
+	if err := d.master.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("close mysql master failed: %w", err))
+	}
+	for _, r := range d.replicas {
+		if err := r.drv.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("close mysql %s failed: %w", r.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// HealthySlaves 当前健康的从库数量
+func (d *RWDriver) HealthySlaves() int {
+	n := 0
+	for _, r := range d.replicas {
+		if r.healthy.Load() {
+			n++
+		}
+	}
+	return n
+}
+
+// pick 按策略选择健康的从库，没有时返回 nil
+func (d *RWDriver) pick() *replica {
+	switch d.opts.Policy {
+	case PolicyLeastConn:
+		var best *replica
+		for _, r := range d.replicas {
+			if r.healthy.Load() && (best == nil || r.inflight.Load() < best.inflight.Load()) {
+				best = r
+			}
+		}
+		return best
+	default:
+		// 只在健康的从库间轮询，摘除的从库不会把流量集中到相邻的从库
+		healthy := make([]*replica, 0, len(d.replicas))
+		for _, r := range d.replicas {
+			if r.healthy.Load() {
+				healthy = append(healthy, r)
+			}
+		}
+		if len(healthy) == 0 {
+			return nil
+		}
+		return healthy[d.next.Add(1)%uint64(len(healthy))]
+	}
+}
+
+func (d *RWDriver) loop() {
+	defer d.wg.Done()
+	ticker := time.NewTicker(d.opts.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.closeCh:
+			return
+		case <-ticker.C:
+			d.checkAll()
+		}
+	}
+}
+
+// checkAll 检查所有从库，ping 失败或复制延迟超过阈值时摘除，恢复后重新加入
+func (d *RWDriver) checkAll() {
+	logger := applog.WrapGDPLogger(context.Background())
+	for _, r := range d.replicas {
+		ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+		err := d.check(ctx, r)
+		cancel()
+
+		healthy := err == nil
+		if r.healthy.Swap(healthy) == healthy {
+			continue
+		}
+		if healthy {
+			logger.Info(fmt.Sprintf("mysql %s joined read pool", r.name))
+		} else {
+			logger.Warn(fmt.Sprintf("mysql %s ejected from read pool", r.name), err)
+		}
+	}
+}
+
+func (d *RWDriver) check(ctx context.Context, r *replica) error {
+	if err := r.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("ping: %w", err)
+	}
+	lag, err := replicationLag(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	if lag > d.opts.MaxLag {
+		return fmt.Errorf("replication lag %s exceeds %s", lag, d.opts.MaxLag)
+	}
+	return nil
+}
+
+// replicationLag 从库复制延迟，MySQL 8.0.22 起使用 SHOW REPLICA STATUS，旧版本回退到 SHOW SLAVE STATUS；
+// 未配置复制时返回0，复制线程停止（延迟为 NULL）时返回错误
+func replicationLag(ctx context.Context, db *sql.DB) (time.Duration, error) {
+	rows, err := db.QueryContext(ctx, "SHOW REPLICA STATUS")
+	if err != nil {
+		if rows, err = db.QueryContext(ctx, "SHOW SLAVE STATUS"); err != nil {
+			return 0, fmt.Errorf("replication status: %w", err)
+		}
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return 0, rows.Err()
+	}
+	cols, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	values := make([]sql.NullString, len(cols))
+	dest := make([]any, len(cols))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return 0, err
+	}
+	for i, col := range cols {
+		if col != "Seconds_Behind_Source" && col != "Seconds_Behind_Master" {
+			continue
+		}
+		if !values[i].Valid {
+			return 0, errors.New("replication is not running")
+		}
+		sec, err := strconv.ParseInt(values[i].String, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parse %s: %w", col, err)
+		}
+		return time.Duration(sec) * time.Second, nil
+	}
+	return 0, errors.New("replication lag column not found")
+}
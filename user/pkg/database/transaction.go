@@ -1 +1,207 @@
 package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"common/applog"
+	"user/internal/ent"
+
+	"github.com/go-sql-driver/mysql"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	txMaxAttempts  = 3                     // 死锁/锁等待超时时最多执行的次数
+	txRetryBackoff = 20 * time.Millisecond // 首次重试等待，之后翻倍并加随机抖动
+)
+
+// MySQL 可重试的错误码
+const (
+	errLockWaitTimeout = 1205 // ER_LOCK_WAIT_TIMEOUT
+	errLockDeadlock    = 1213 // ER_LOCK_DEADLOCK
+)
+
+// Message 事务提交后发布的消息
+type Message struct {
+	Topic string
+	Key   []byte
+	Value []byte
+}
+
+// Publisher 消息发布者，由 kafka 组件初始化时设置
+type Publisher interface {
+	Publish(ctx context.Context, msgs ...Message) error
+}
+
+var publisher Publisher
+
+// SetPublisher 设置事务提交后发布消息使用的发布者
+func SetPublisher(p Publisher) {
+	publisher = p
+}
+
+type txStateKey struct{}
+
+// txState 当前事务的状态，嵌套调用共享
+type txState struct {
+	tx         *ent.Tx
+	savepoints int                     // 已创建的保存点数量，用于生成保存点名称
+	hooks      []func(context.Context) // 提交成功后执行
+	msgs       []Message               // 提交成功后发布
+}
+
+// WithTx 在事务中执行 fn，fn 返回错误或 panic 时回滚。
+// ctx 中已有事务时使用保存点嵌套，fn 失败只回滚到保存点；
+// 最外层遇到死锁或锁等待超时时回滚并重新执行 fn，因此 fn 需要可重复执行，
+// 事务外的副作用（发消息、写缓存）应通过 AfterCommit / Publish 在提交后执行
+func WithTx(ctx context.Context, fn func(ctx context.Context, tx *ent.Tx) error) error {
+	if state, ok := ctx.Value(txStateKey{}).(*txState); ok {
+		return withSavepoint(ctx, state, fn)
+	}
+
+	ctx, span := otel.Tracer("user/database").Start(ctx, "mysql.tx")
+	defer span.End()
+
+	var err error
+retry:
+	for attempt := 1; attempt <= txMaxAttempts; attempt++ {
+		span.SetAttributes(attribute.Int("db.tx.attempts", attempt))
+		var state *txState
+		state, err = runTx(ctx, fn)
+		if err == nil {
+			afterCommit(ctx, state)
+			return nil
+		}
+		if !isRetryable(err) || attempt == txMaxAttempts {
+			break
+		}
+		span.AddEvent("retry")
+		applog.WrapGDPLogger(ctx).Warn(fmt.Sprintf("mysql tx attempt %d failed, retrying", attempt), err)
+
+		backoff := txRetryBackoff << (attempt - 1)
+		backoff += rand.N(backoff)
+		select {
+		case <-ctx.Done():
+			err = errors.Join(err, ctx.Err())
+			break retry
+		case <-time.After(backoff):
+		}
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	return err
+}
+
+// runTx 开启事务执行 fn 并提交
+func runTx(ctx context.Context, fn func(ctx context.Context, tx *ent.Tx) error) (state *txState, err error) {
+	tx, err := GetEntClient().Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	state = &txState{tx: tx}
+	txCtx := ent.NewTxContext(context.WithValue(ctx, txStateKey{}, state), tx)
+
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(txCtx, tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = errors.Join(err, fmt.Errorf("rollback tx: %w", rerr))
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx: %w", err)
+	}
+	return state, nil
+}
+
+// withSavepoint 嵌套事务，失败或 panic 时回滚到保存点，提交后回调也一并撤销
+func withSavepoint(ctx context.Context, state *txState, fn func(ctx context.Context, tx *ent.Tx) error) error {
+	state.savepoints++
+	name := fmt.Sprintf("sp_%d", state.savepoints)
+	if _, err := state.tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("create savepoint: %w", err)
+	}
+	hooks, msgs := len(state.hooks), len(state.msgs)
+	rollback := func() error {
+		state.hooks, state.msgs = state.hooks[:hooks], state.msgs[:msgs]
+		// 死锁时 MySQL 已回滚整个事务，保存点不存在，由最外层重试
+		if _, err := state.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); err != nil {
+			return fmt.Errorf("rollback to savepoint: %w", err)
+		}
+		return nil
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			_ = rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(ctx, state.tx); err != nil {
+		if rerr := rollback(); rerr != nil && !isRetryable(err) {
+			err = errors.Join(err, rerr)
+		}
+		return err
+	}
+	if _, err := state.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("release savepoint: %w", err)
+	}
+	return nil
+}
+
+// AfterCommit 注册最外层事务提交成功后执行的回调，不在事务中时立即执行；
+// 回滚（包括回滚到保存点）时回调被丢弃
+func AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	if state, ok := ctx.Value(txStateKey{}).(*txState); ok {
+		state.hooks = append(state.hooks, fn)
+		return
+	}
+	fn(ctx)
+}
+
+// Publish 在最外层事务提交成功后发布消息，回滚时不发布；不在事务中时立即发布。
+// 提交后发布失败只记录日志（数据已提交，无法回滚），需要严格送达的消息应使用发件箱表
+func Publish(ctx context.Context, msgs ...Message) error {
+	if publisher == nil {
+		return errors.New("message publisher not initialized")
+	}
+	if state, ok := ctx.Value(txStateKey{}).(*txState); ok {
+		state.msgs = append(state.msgs, msgs...)
+		return nil
+	}
+	return publisher.Publish(ctx, msgs...)
+}
+
+// afterCommit 执行提交后回调并发布消息，请求取消不影响已提交事务的后续处理
+func afterCommit(ctx context.Context, state *txState) {
+	ctx = context.WithoutCancel(ctx)
+	for _, fn := range state.hooks {
+		fn(ctx)
+	}
+	if len(state.msgs) == 0 {
+		return
+	}
+	if err := publisher.Publish(ctx, state.msgs...); err != nil {
+		applog.WrapGDPLogger(ctx).Error(fmt.Sprintf("publish %d messages after commit failed", len(state.msgs)), err)
+	}
+}
+
+// isRetryable 是否为死锁或锁等待超时
+func isRetryable(err error) bool {
+	var me *mysql.MySQLError
+	if errors.As(err, &me) {
+		return me.Number == errLockDeadlock || me.Number == errLockWaitTimeout
+	}
+	return false
+}
@@ -0,0 +1,260 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+
+	"common/applog"
+	"user/internal/ent"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestMain(m *testing.M) {
+	// 重试时会记录日志
+	dir, err := os.MkdirTemp("", "database_test")
+	if err != nil {
+		panic(err)
+	}
+	if err := applog.InitLoggers(applog.LogConfig{Path: dir, LogFile: "test", MaxAge: 1}); err != nil {
+		panic(err)
+	}
+	code := m.Run()
+	_ = os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// recorder 按顺序记录提交后回调和消息发布
+type recorder struct {
+	events []string
+}
+
+func (r *recorder) Publish(_ context.Context, msgs ...Message) error {
+	for _, m := range msgs {
+		r.events = append(r.events, "publish:"+string(m.Value))
+	}
+	return nil
+}
+
+func (r *recorder) hook(name string) func(context.Context) {
+	return func(context.Context) { r.events = append(r.events, "hook:"+name) }
+}
+
+// setupMock 使用 sqlmock 替换 ent 客户端和消息发布者
+func setupMock(t *testing.T) (sqlmock.Sqlmock, *recorder) {
+	t.Helper()
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldClient, oldPublisher := entClient, publisher
+	entClient = ent.NewClient(ent.Driver(entsql.OpenDB(dialect.MySQL, db)))
+	rec := &recorder{}
+	publisher = rec
+	t.Cleanup(func() {
+		entClient, publisher = oldClient, oldPublisher
+		_ = db.Close()
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+	})
+	return mock, rec
+}
+
+func msg(v string) Message {
+	return Message{Topic: "test", Value: []byte(v)}
+}
+
+func TestWithTxCommitRunsHooksInOrder(t *testing.T) {
+	mock, rec := setupMock(t)
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	err := WithTx(context.Background(), func(ctx context.Context, tx *ent.Tx) error {
+		AfterCommit(ctx, rec.hook("a"))
+		if err := Publish(ctx, msg("m1")); err != nil {
+			return err
+		}
+		AfterCommit(ctx, rec.hook("b"))
+		if len(rec.events) != 0 {
+			t.Errorf("hooks ran before commit: %v", rec.events)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WithTx() error: %v", err)
+	}
+	// 回调按注册顺序执行，消息在回调之后一起发布
+	want := []string{"hook:a", "hook:b", "publish:m1"}
+	if !reflect.DeepEqual(rec.events, want) {
+		t.Errorf("events = %v, want %v", rec.events, want)
+	}
+}
+
+func TestWithTxRollbackDropsHooks(t *testing.T) {
+	mock, rec := setupMock(t)
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	errBiz := errors.New("biz")
+	err := WithTx(context.Background(), func(ctx context.Context, tx *ent.Tx) error {
+		AfterCommit(ctx, rec.hook("a"))
+		_ = Publish(ctx, msg("m1"))
+		return errBiz
+	})
+	if !errors.Is(err, errBiz) {
+		t.Fatalf("WithTx() error = %v, want %v", err, errBiz)
+	}
+	if len(rec.events) != 0 {
+		t.Errorf("events = %v, want none", rec.events)
+	}
+}
+
+func TestWithTxPanicRollsBack(t *testing.T) {
+	mock, rec := setupMock(t)
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	defer func() {
+		if v := recover(); v != "boom" {
+			t.Errorf("recover() = %v, want boom", v)
+		}
+		if len(rec.events) != 0 {
+			t.Errorf("events = %v, want none", rec.events)
+		}
+	}()
+	_ = WithTx(context.Background(), func(ctx context.Context, tx *ent.Tx) error {
+		AfterCommit(ctx, rec.hook("a"))
+		panic("boom")
+	})
+	t.Fatal("panic was not propagated")
+}
+
+func TestWithTxSavepoint(t *testing.T) {
+	mock, rec := setupMock(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("SAVEPOINT sp_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("RELEASE SAVEPOINT sp_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SAVEPOINT sp_2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ROLLBACK TO SAVEPOINT sp_2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SAVEPOINT sp_3").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ROLLBACK TO SAVEPOINT sp_3").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	errInner := errors.New("inner")
+	err := WithTx(context.Background(), func(ctx context.Context, outer *ent.Tx) error {
+		AfterCommit(ctx, rec.hook("outer"))
+		// 成功的嵌套事务释放保存点，回调保留到最外层提交
+		err := WithTx(ctx, func(ctx context.Context, tx *ent.Tx) error {
+			if tx != outer {
+				t.Error("nested WithTx should share the outer tx")
+			}
+			AfterCommit(ctx, rec.hook("inner ok"))
+			return Publish(ctx, msg("inner ok"))
+		})
+		if err != nil {
+			return err
+		}
+		// 失败的嵌套事务回滚到保存点，其回调和消息被丢弃
+		if err := WithTx(ctx, func(ctx context.Context, tx *ent.Tx) error {
+			AfterCommit(ctx, rec.hook("inner failed"))
+			_ = Publish(ctx, msg("inner failed"))
+			return errInner
+		}); !errors.Is(err, errInner) {
+			t.Errorf("nested WithTx() error = %v, want %v", err, errInner)
+		}
+		// panic 时同样回滚到保存点后继续向上抛出
+		func() {
+			defer func() { _ = recover() }()
+			_ = WithTx(ctx, func(ctx context.Context, tx *ent.Tx) error {
+				AfterCommit(ctx, rec.hook("inner panic"))
+				panic("boom")
+			})
+		}()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WithTx() error: %v", err)
+	}
+	want := []string{"hook:outer", "hook:inner ok", "publish:inner ok"}
+	if !reflect.DeepEqual(rec.events, want) {
+		t.Errorf("events = %v, want %v", rec.events, want)
+	}
+}
+
+func TestWithTxRetry(t *testing.T) {
+	deadlock := &mysql.MySQLError{Number: errLockDeadlock, Message: "Deadlock found"}
+	lockWait := &mysql.MySQLError{Number: errLockWaitTimeout, Message: "Lock wait timeout exceeded"}
+	duplicate := &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}
+	tests := []struct {
+		name      string
+		errs      []error // 每次执行 fn 返回的错误
+		wantCalls int
+		wantErr   error
+	}{
+		{"deadlock then success", []error{deadlock, nil}, 2, nil},
+		{"lock wait then success", []error{lockWait, nil}, 2, nil},
+		{"wrapped deadlock", []error{errors.Join(errors.New("update"), deadlock), nil}, 2, nil},
+		{"attempts exhausted", []error{deadlock, deadlock, deadlock}, txMaxAttempts, deadlock},
+		{"not retryable", []error{duplicate}, 1, duplicate},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, rec := setupMock(t)
+			for _, err := range tt.errs {
+				mock.ExpectBegin()
+				if err != nil {
+					mock.ExpectRollback()
+				} else {
+					mock.ExpectCommit()
+				}
+			}
+
+			calls := 0
+			err := WithTx(context.Background(), func(ctx context.Context, tx *ent.Tx) error {
+				AfterCommit(ctx, rec.hook("done"))
+				err := tt.errs[calls]
+				calls++
+				return err
+			})
+			if calls != tt.wantCalls {
+				t.Errorf("fn called %d times, want %d", calls, tt.wantCalls)
+			}
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("WithTx() error: %v", err)
+				}
+				// 失败的尝试注册的回调不执行
+				if !reflect.DeepEqual(rec.events, []string{"hook:done"}) {
+					t.Errorf("events = %v, want one hook", rec.events)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("WithTx() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(rec.events) != 0 {
+				t.Errorf("events = %v, want none", rec.events)
+			}
+		})
+	}
+}
+
+func TestAfterCommitOutsideTx(t *testing.T) {
+	_, rec := setupMock(t)
+	ctx := context.Background()
+	AfterCommit(ctx, rec.hook("a"))
+	if err := Publish(ctx, msg("m1")); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"hook:a", "publish:m1"}
+	if !reflect.DeepEqual(rec.events, want) {
+		t.Errorf("events = %v, want %v", rec.events, want)
+	}
+}
@@ -1,26 +1,84 @@
 package errors
 
-import "common/errs"
+import (
+	"common/errs"
+	"common/jwtutils"
+)
 
 const (
-	RedisErrorCode    errs.ErrorCode = 999
-	DBErrorCode       errs.ErrorCode = 998
-	ParamsErrorCode   errs.ErrorCode = 401
-	NoLegalMobileCode errs.ErrorCode = 10102001
+	RedisErrorCode       errs.ErrorCode = 999
+	DBErrorCode          errs.ErrorCode = 998
+	ParamsErrorCode      errs.ErrorCode = 401
+	NoLegalMobileCode    errs.ErrorCode = 10102001
+	PhoneRegisteredCode  errs.ErrorCode = 10102002
+	PasswordInvalidCode  errs.ErrorCode = 10102003
+	LoginFailedCode      errs.ErrorCode = 10102004
+	UserBannedCode       errs.ErrorCode = 10102005
+	UserNotFoundCode     errs.ErrorCode = 10102006
+	UnauthorizedCode     errs.ErrorCode = 10101001
+	PermissionDeniedCode errs.ErrorCode = 10101002
+	SchoolNotFoundCode   errs.ErrorCode = 10103001
+	MajorNotFoundCode    errs.ErrorCode = 10103002
+	SchoolExistsCode     errs.ErrorCode = 10103003
+	MajorExistsCode      errs.ErrorCode = 10103004
+	MajorNotInSchoolCode errs.ErrorCode = 10103005
+	PostNotFoundCode     errs.ErrorCode = 10104001
+	DraftNotFoundCode    errs.ErrorCode = 10104002
+	CommentNotFoundCode  errs.ErrorCode = 10104003
+	TagNotFoundCode      errs.ErrorCode = 10104004
 )
 
 var (
-	RedisError    = errs.NewError(RedisErrorCode, "redis错误")
-	DBError       = errs.NewError(DBErrorCode, "db错误")
-	ParamsError   = errs.NewError(ParamsErrorCode, "参数错误")
-	NoLegalMobile = errs.NewError(NoLegalMobileCode, "手机号不合法")
+	RedisError       = errs.NewError(RedisErrorCode, "redis错误")
+	DBError          = errs.NewError(DBErrorCode, "db错误")
+	ParamsError      = errs.NewError(ParamsErrorCode, "参数错误")
+	NoLegalMobile    = errs.NewError(NoLegalMobileCode, "手机号不合法")
+	PhoneRegistered  = errs.NewError(PhoneRegisteredCode, "手机号已注册")
+	PasswordInvalid  = errs.NewError(PasswordInvalidCode, "密码需为8-32位")
+	LoginFailed      = errs.NewError(LoginFailedCode, "手机号或密码错误")
+	UserBanned       = errs.NewError(UserBannedCode, "账号已被封禁")
+	UserNotFound     = errs.NewError(UserNotFoundCode, "用户不存在")
+	Unauthorized     = errs.NewError(UnauthorizedCode, "登录已失效，请重新登录")
+	PermissionDenied = errs.NewError(PermissionDeniedCode, "无权限")
+	SchoolNotFound   = errs.NewError(SchoolNotFoundCode, "学校不存在")
+	MajorNotFound    = errs.NewError(MajorNotFoundCode, "专业不存在")
+	SchoolExists     = errs.NewError(SchoolExistsCode, "学校已存在")
+	MajorExists      = errs.NewError(MajorExistsCode, "专业已存在")
+	MajorNotInSchool = errs.NewError(MajorNotInSchoolCode, "该学校没有此专业")
+	PostNotFound     = errs.NewError(PostNotFoundCode, "帖子不存在或已删除")
+	DraftNotFound    = errs.NewError(DraftNotFoundCode, "草稿不存在")
+	CommentNotFound  = errs.NewError(CommentNotFoundCode, "评论不存在或已删除")
+	TagNotFound      = errs.NewError(TagNotFoundCode, "标签不存在或已合并")
 )
 
 var UnknownError = errs.NewError(-1, "未知错误")
 
 var Errors = map[errs.ErrorCode]error{
-	RedisErrorCode:    RedisError,
-	DBErrorCode:       DBError,
-	ParamsErrorCode:   ParamsError,
-	NoLegalMobileCode: NoLegalMobile,
+	RedisErrorCode:       RedisError,
+	DBErrorCode:          DBError,
+	ParamsErrorCode:      ParamsError,
+	NoLegalMobileCode:    NoLegalMobile,
+	PhoneRegisteredCode:  PhoneRegistered,
+	PasswordInvalidCode:  PasswordInvalid,
+	LoginFailedCode:      LoginFailed,
+	UserBannedCode:       UserBanned,
+	UserNotFoundCode:     UserNotFound,
+	UnauthorizedCode:     Unauthorized,
+	PermissionDeniedCode: PermissionDenied,
+	SchoolNotFoundCode:   SchoolNotFound,
+	MajorNotFoundCode:    MajorNotFound,
+	SchoolExistsCode:     SchoolExists,
+	MajorExistsCode:      MajorExists,
+	MajorNotInSchoolCode: MajorNotInSchool,
+	PostNotFoundCode:     PostNotFound,
+	DraftNotFoundCode:    DraftNotFound,
+	CommentNotFoundCode:  CommentNotFound,
+	TagNotFoundCode:      TagNotFound,
+}
+
+// 令牌校验错误由 AuthInterceptor 返回，透传给网关
+func init() {
+	for _, err := range jwtutils.Errors {
+		Errors[err.Code] = err
+	}
 }
@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"common/applog"
+	"common/discovery"
+	"common/healthcheck"
+	"common/lifecycle"
+	userservice "grpc/user/user"
+	"user/config"
+	"user/pkg/database"
+	"user/pkg/mongodbutils"
+	"user/pkg/redisutils"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// StartHealthCheck 探测 mysql/redis/mongo，依赖不可用时将 grpc 健康状态置为 NOT_SERVING 并从 etcd 注销，恢复后重新注册
+func StartHealthCheck(ctx context.Context, r *discovery.Register) *healthcheck.Checker {
+	logger := applog.WrapGDPLogger(ctx)
+	c := healthcheck.NewChecker(
+		time.Duration(config.GetConfig().Grpc.HealthCheckInterval)*time.Second,
+		time.Duration(config.GetConfig().Grpc.HealthCheckTimeout)*time.Second,
+		logger,
+	)
+	c.AddProbe("mysql", database.Ping)
+	c.AddProbe("redis", redisutils.Ping)
+	c.AddProbe("mongo", mongodbutils.Ping)
+
+	c.OnChange(func(healthy bool) {
+		status, srvStatus := healthpb.HealthCheckResponse_NOT_SERVING, discovery.StatusNotServing
+		if healthy {
+			status, srvStatus = healthpb.HealthCheckResponse_SERVING, discovery.StatusServing
+		}
+		healthServer.SetServingStatus("", status)
+		healthServer.SetServingStatus(userservice.User_ServiceDesc.ServiceName, status)
+		healthServer.SetServingStatus(userservice.Catalog_ServiceDesc.ServiceName, status)
+		healthServer.SetServingStatus(userservice.Content_ServiceDesc.ServiceName, status)
+
+		if err := r.SetStatus(srvStatus); err != nil {
+			logger.Error("update etcd status failed", srvStatus, err)
+			return
+		}
+		logger.Info("service status changed", srvStatus)
+	})
+
+	c.Start()
+	return c
+}
+
+// Deregister 停止依赖探测，将健康状态置为 NOT_SERVING 并从 etcd 注销，用于优雅关闭的第一阶段
+func Deregister(c *healthcheck.Checker, r *discovery.Register) lifecycle.StopFunc {
+	return func(ctx context.Context) error {
+		c.Stop()
+		healthServer.Shutdown()
+		r.Stop()
+		return nil
+	}
+}
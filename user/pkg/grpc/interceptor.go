@@ -2,10 +2,13 @@ package grpc
 
 import (
 	"context"
+	stderrors "errors"
 
 	"common/applog"
 	"common/errs"
+	"common/jwtutils"
 	"common/tracer"
+	"user/pkg/auth"
 	"user/pkg/errors"
 
 	"google.golang.org/grpc"
@@ -37,15 +40,43 @@ func ErrorLogInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// AuthInterceptor 校验网关传递的 access token，配置了 [[jwt.keys]] 时只使用公钥校验，
+// 通过后可用 jwtutils.ClaimsFromContext 获取用户；未携带令牌的请求放行，由需要登录的接口自行判断
+func AuthInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		token, deviceID := jwtutils.TokenFromIncomingContext(ctx)
+		if token == "" {
+			return handler(ctx, req)
+		}
+		claims, err := auth.GetKeyring().Parse(token, jwtutils.TokenTypeAccess, deviceID)
+		if err != nil {
+			applog.WrapGDPLogger(ctx).Warn("invalid token", err)
+			return nil, errs.GrpcError(jwtutils.ToBError(err))
+		}
+		return handler(jwtutils.NewContext(ctx, claims), req)
+	}
+}
+
+// ErrorInterceptor service 返回的错误转换为 grpc status，只透传已注册的业务错误码和对应的提示，
+// 如 NewDBError 的详细信息只记录日志，不返回给调用方
 func ErrorInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		resp, err = handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
 
-		code, _ := errs.ParseGrpcError(err)
-		if _, ok := errors.Errors[errs.ErrorCode(code)]; ok {
-			return resp, errors.Errors[errs.ErrorCode(code)]
-		} else {
-			return resp, errors.UnknownError
+		var be *errs.BError
+		if !stderrors.As(err, &be) {
+			be = errs.ToBError(err)
+		}
+		known, ok := errors.Errors[be.Code].(*errs.BError)
+		if !ok {
+			known = errors.UnknownError
+		}
+		if known.Msg != be.Msg {
+			applog.WrapGDPLogger(ctx).WithReq(req).Error(err.Error())
 		}
+		return resp, errs.GrpcError(known)
 	}
 }
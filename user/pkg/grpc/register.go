@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"net"
 
 	"common/applog"
@@ -16,6 +15,16 @@ import (
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/stats"
+)
+
+var (
+	// healthServer grpc.health.v1 健康检查服务，状态由依赖探测结果驱动
+	healthServer = health.NewServer()
+	// otelHandler 链路追踪，由 InitTracer 创建
+	otelHandler stats.Handler
 )
 
 type gRPCConfig struct {
@@ -23,15 +32,21 @@ type gRPCConfig struct {
 	RegisterFunc func(*grpc.Server)
 }
 
-func RegisterGrpc() (*grpc.Server, error) {
-	otelHandler, err := tracer.JaegerServerHandler(
+// InitTracer 创建 jaeger 链路追踪
+func InitTracer() (err error) {
+	otelHandler, err = tracer.JaegerServerHandler(
 		config.GetConfig().Jaeger.Endpoints,
 		config.GetConfig().Server.Name,
 		config.GetConfig().Server.Env,
 		config.GetConfig().Jaeger.IsOpenOnlySamplerError,
 	)
-	if err != nil {
-		return nil, err
+	return err
+}
+
+// RegisterGrpc 创建grpc服务并开始监听，需先调用 InitTracer
+func RegisterGrpc() (*grpc.Server, error) {
+	if otelHandler == nil {
+		return nil, errors.New("tracer not initialized")
 	}
 
 	// 创建gRPC服务器
@@ -42,6 +57,7 @@ func RegisterGrpc() (*grpc.Server, error) {
 			// 注册其他拦截器
 			TraceIDInterceptor(),
 			ErrorLogInterceptor(),
+			AuthInterceptor(),
 			ErrorInterceptor(),
 		)),
 	)
@@ -52,6 +68,14 @@ func RegisterGrpc() (*grpc.Server, error) {
 		RegisterFunc: func(s *grpc.Server) {
 			// 注册服务
 			userservice.RegisterUserServer(s, service.NewUserService())
+			userservice.RegisterCatalogServer(s, service.NewCatalogService())
+			userservice.RegisterContentServer(s, service.NewContentService())
+			// 注册健康检查服务，依赖探测通过前为 NOT_SERVING
+			healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+			healthServer.SetServingStatus(userservice.User_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+			healthServer.SetServingStatus(userservice.Catalog_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+			healthServer.SetServingStatus(userservice.Content_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+			healthpb.RegisterHealthServer(s, healthServer)
 		},
 	}
 	c.RegisterFunc(s)
@@ -59,6 +83,7 @@ func RegisterGrpc() (*grpc.Server, error) {
 	// 启动gRPC服务监听
 	lis, err := net.Listen("tcp", c.Addr)
 	if err != nil {
+		s.Stop()
 		return nil, fmt.Errorf("listen tcp %s error: %w", c.Addr, err)
 	}
 
@@ -76,12 +101,14 @@ func RegisterEtcdServer(ctx context.Context) (*discovery.Register, error) {
 		Addr:    config.GetConfig().Grpc.EtcdAddr,
 		Version: config.GetConfig().Grpc.Version,
 		Weight:  config.GetConfig().Grpc.Weight,
+		// 依赖探测通过后才写入 etcd
+		Status: discovery.StatusNotServing,
 	}
 
 	r := discovery.NewRegister(config.GetConfig().Etcd.Addrs, applog.WrapGDPLogger(ctx))
 	r, _, err := r.Register(info, 2)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, fmt.Errorf("register %s to etcd failed: %w", info.Addr, err)
 	}
 
 	return r, nil
@@ -16,8 +16,8 @@ import (
 
 func InitRpcService() {
 	ctx := context.Background()
-	etcdRegister := discovery.NewResolver(config.GetConfig().Etcd.Addrs, applog.WrapGDPLogger(ctx))
-	resolver.Register(etcdRegister)
+	etcdBuilder := discovery.NewBuilder(config.GetConfig().Etcd.Addrs, applog.WrapGDPLogger(ctx))
+	resolver.Register(etcdBuilder)
 
 	otelHandler, err := tracer.JaegerClientHandler(
 		config.GetConfig().Jaeger.Endpoints,
@@ -33,6 +33,7 @@ func InitRpcService() {
 		discovery.BuildResolverUrl("project"),
 		grpc.WithStatsHandler(otelHandler),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(discovery.WeightRoundRobinServiceConfig),
 	)
 	if err != nil {
 		log.Fatalf("did not connect: %v", err)
@@ -2,55 +2,284 @@ package initialize
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"common/applog"
+	"common/conf"
+	"common/discovery"
 	"common/env"
+	"common/healthcheck"
+	"common/idgen"
+	"common/lifecycle"
+	"common/tracer"
 	"user/config"
+	"user/internal/mongomodel"
+	"user/internal/service"
+	"user/pkg/auth"
 	"user/pkg/database"
+	"user/pkg/grpc"
+	kafka "user/pkg/kafka"
 	"user/pkg/mongodbutils"
 	"user/pkg/redisutils"
+
+	kafkago "github.com/segmentio/kafka-go"
+	grpclib "google.golang.org/grpc"
 )
 
 func init() {
 	log.SetFlags(log.LstdFlags | log.Lshortfile | log.Lmicroseconds)
 }
 
-// MustInit 组件初始化，若失败，会panic
-func MustInit(ctx context.Context) {
-	err := env.InitEnvConfig()
-	if err != nil {
-		panic(err)
-		return
+// Init 加载配置并按依赖顺序启动组件，返回的管理器负责优雅关闭
+//
+// 依赖关系：applog -> redis(session)/mysql/mongo(mongo_index, reconciler)/kafka(behavior) -> grpc(tracer) -> discovery -> config_watcher
+// 存储依赖在启动时必须就绪，失败按 [startup] 配置重试，超过次数后关闭已启动的组件并返回错误
+func Init(ctx context.Context) (*lifecycle.Manager, error) {
+	if err := env.InitEnvConfig(); err != nil {
+		return nil, fmt.Errorf("init env failed: %w", err)
+	}
+	if err := config.InitConfig("config.toml"); err != nil {
+		return nil, fmt.Errorf("init config failed: %w", err)
 	}
 
-	err = config.InitConfig("config.toml")
-	if err != nil {
-		panic(err)
-		return
+	lc := lifecycle.NewManager(config.GetConfig().Startup, config.GetConfig().Shutdown)
+	lc.MustRegister(components(ctx, lc)...)
+
+	if err := lc.Start(ctx); err != nil {
+		return nil, err
 	}
+	return lc, nil
+}
 
-	err = applog.InitLoggers(config.GetConfig().AppLog)
-	if err != nil {
-		panic(err)
-		return
+// components 组件定义
+func components(ctx context.Context, lc *lifecycle.Manager) []lifecycle.Component {
+	var (
+		gs *grpclib.Server
+		r  *discovery.Register
+		hc *healthcheck.Checker
+		w  *conf.Watcher[config.Config]
+		kw *kafka.KafkaWriter
+		rc *service.CounterReconciler
+		bc *service.BehaviorConsumer
+	)
+
+	return []lifecycle.Component{
+		{
+			Name: "applog",
+			Start: func(ctx context.Context) error {
+				return applog.InitLoggers(config.GetConfig().AppLog)
+			},
+			Stop:      applog.Close,
+			StopPhase: lifecycle.PhaseFlush,
+		},
+		{
+			Name:      "tracer",
+			Start:     func(ctx context.Context) error { return grpc.InitTracer() },
+			Stop:      tracer.Shutdown,
+			StopPhase: lifecycle.PhaseFlush,
+		},
+		{
+			Name:      "redis",
+			DependsOn: []string{"applog"},
+			Start:     func(ctx context.Context) error { return redisutils.InitRedisConnect() },
+			Ready:     redisutils.Ping,
+			Stop:      redisutils.Close,
+			StopPhase: lifecycle.PhaseResource,
+		},
+		{
+			Name:      "session",
+			DependsOn: []string{"redis"},
+			Start:     func(context.Context) error { return auth.InitSession() },
+		},
+		{
+			Name:      "mysql",
+			DependsOn: []string{"applog"},
+			Start:     func(ctx context.Context) error { return database.InitMysqlConnect() },
+			Ready:     database.Ping,
+			Stop:      database.Close,
+			StopPhase: lifecycle.PhaseResource,
+		},
+		{
+			Name:  "idgen",
+			Start: func(context.Context) error { return idgen.Init(config.GetConfig().Server.NodeID) },
+		},
+		{
+			Name:      "mongo",
+			DependsOn: []string{"applog"},
+			Start:     mongodbutils.InitMongoConnect,
+			Ready:     mongodbutils.Ping,
+			Stop:      mongodbutils.Close,
+			StopPhase: lifecycle.PhaseResource,
+		},
+		{
+			// 帖子等集合的索引，已存在时忽略
+			Name:      "mongo_index",
+			DependsOn: []string{"mongo"},
+			Start:     createMongoIndexes,
+		},
+		{
+			// 事务提交后发布业务消息，未配置地址时不启用
+			Name: "kafka",
+			Start: func(context.Context) error {
+				if addr := config.GetConfig().Kafka.Addr; addr != "" {
+					kw = kafka.InitWriter(addr)
+					database.SetPublisher(kafkaPublisher{kw})
+					service.SetBehaviorSender(kw)
+				}
+				return nil
+			},
+			Stop: func(ctx context.Context) error {
+				if kw == nil {
+					return nil
+				}
+				return kw.Close(ctx)
+			},
+			// 服务停止后发送缓冲中剩余的消息
+			StopPhase: lifecycle.PhaseFlush,
+		},
+		{
+			// 定时校正帖子的点赞、评论、收藏计数
+			Name:      "reconciler",
+			DependsOn: []string{"redis", "mongo_index"},
+			Start: func(context.Context) error {
+				c := config.GetConfig().Content
+				rc = service.StartCounterReconciler(time.Duration(c.ReconcileInterval)*time.Minute, c.ReconcileBatch, c.ReconcileFix)
+				return nil
+			},
+			Stop: func(ctx context.Context) error {
+				return rc.Stop(ctx)
+			},
+			StopPhase: lifecycle.PhaseServer,
+		},
+		{
+			// 消费用户行为事件写入 mongodb，未配置 kafka 或 behavior_topic 时不启用
+			Name:      "behavior",
+			DependsOn: []string{"kafka", "mongo_index"},
+			Start: func(context.Context) error {
+				c := config.GetConfig()
+				if c.Kafka.Addr != "" && c.Content.BehaviorTopic != "" {
+					bc = service.StartBehaviorConsumer([]string{c.Kafka.Addr}, c.Content.BehaviorGroup, c.Content.BehaviorTopic,
+						c.Content.BehaviorBatch, time.Duration(c.Content.BehaviorFlushInterval)*time.Millisecond)
+				}
+				return nil
+			},
+			Stop: func(ctx context.Context) error {
+				if bc == nil {
+					return nil
+				}
+				return bc.Stop(ctx)
+			},
+			StopPhase: lifecycle.PhaseServer,
+		},
+		{
+			// grpc服务注册
+			Name:      "grpc",
+			DependsOn: []string{"tracer", "session", "idgen", "mysql", "mongo_index", "kafka"},
+			Start: func(context.Context) (err error) {
+				gs, err = grpc.RegisterGrpc()
+				return err
+			},
+			Stop: func(ctx context.Context) error {
+				return lifecycle.GracefulStopGrpc(gs, lc.GraceTimeout())(ctx)
+			},
+			StopPhase: lifecycle.PhaseServer,
+		},
+		{
+			// grpc服务注册到etcd，依赖健康检查通过后才在etcd中可见
+			Name:      "discovery",
+			DependsOn: []string{"grpc"},
+			Start: func(context.Context) (err error) {
+				if r, err = grpc.RegisterEtcdServer(ctx); err != nil {
+					return err
+				}
+				hc = grpc.StartHealthCheck(ctx, r)
+				return nil
+			},
+			Stop: func(ctx context.Context) error {
+				return grpc.Deregister(hc, r)(ctx)
+			},
+			StopPhase: lifecycle.PhaseDeregister,
+		},
+		{
+			// 动态配置，订阅的配置项变化时实时生效；先于 etcd 注销停止，避免注销后再写入权重
+			Name:      "config_watcher",
+			DependsOn: []string{"discovery"},
+			Start: func(ctx context.Context) (err error) {
+				w = watchConfig(ctx, r)
+				if err = startWatcher(ctx, w); err != nil {
+					_ = w.Stop(ctx)
+				}
+				return err
+			},
+			Stop: func(ctx context.Context) error {
+				return w.Stop(ctx)
+			},
+			StopPhase: lifecycle.PhaseDeregister,
+		},
 	}
+}
+
+// kafkaPublisher 通过 kafka 发布事务提交后的消息，实现 database.Publisher
+type kafkaPublisher struct {
+	w *kafka.KafkaWriter
+}
 
-	err = redisutils.InitRedisConnect()
-	if err != nil {
-		panic(err)
-		return
+func (p kafkaPublisher) Publish(ctx context.Context, msgs ...database.Message) error {
+	messages := make([]kafkago.Message, len(msgs))
+	for i, m := range msgs {
+		messages[i] = kafkago.Message{Topic: m.Topic, Key: m.Key, Value: m.Value}
 	}
+	return p.w.Publish(ctx, messages...)
+}
 
-	err = database.InitMysqlConnect()
-	if err != nil {
-		panic(err)
-		return
+// createMongoIndexes 创建 mongomodel 中定义的索引
+func createMongoIndexes(ctx context.Context) error {
+	for _, m := range mongomodel.Models() {
+		if err := mongodbutils.CreateIndexes(ctx, m.CollectionName(), m.Indexes()); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// watchConfig 创建动态配置并订阅可热更新的配置项
+func watchConfig(ctx context.Context, r *discovery.Register) *conf.Watcher[config.Config] {
+	logger := applog.WrapGDPLogger(ctx)
+	w := config.NewWatcher()
+	w.OnAudit(func(c conf.Change) {
+		logger.Info("config audit", c.String())
+	})
+	w.OnError(func(err error) {
+		logger.Error("reload config failed", err)
+	})
 
-	err = mongodbutils.InitMongoConnect(ctx)
-	if err != nil {
-		panic(err)
-		return
+	conf.Subscribe(w, "app_log.level", func(c *config.Config) string { return c.AppLog.Level }, applog.SetLevel)
+	conf.Subscribe(w, "grpc.weight", func(c *config.Config) int { return c.Grpc.Weight }, r.SetWeight)
+	conf.Subscribe(w, "jaeger.is_open_only_sampler_error", func(c *config.Config) bool { return c.Jaeger.IsOpenOnlySamplerError }, func(v bool) error {
+		tracer.SetOnlySamplerError(v)
+		return nil
+	})
+	resizePool := func(int) error { return database.ResizePool() }
+	conf.Subscribe(w, "mysql.max_open_count", func(c *config.Config) int { return c.Mysql.MaxOpenCount }, resizePool)
+	conf.Subscribe(w, "mysql.max_idle_count", func(c *config.Config) int { return c.Mysql.MaxIdleCount }, resizePool)
+	conf.Subscribe(w, "mysql.conn_max_life_time", func(c *config.Config) int { return c.Mysql.ConnMaxLifeTime }, resizePool)
+	return w
+}
+
+// startWatcher 按 [dynamic] 配置监听本地文件和 etcd
+func startWatcher(ctx context.Context, w *conf.Watcher[config.Config]) error {
+	dynamic := config.GetConfig().Dynamic
+	if dynamic.WatchFile {
+		if err := w.WatchFile(); err != nil {
+			return err
+		}
+	}
+	if dynamic.EtcdPrefix != "" {
+		if err := w.WatchEtcd(config.GetConfig().Etcd.Addrs, dynamic.EtcdPrefix); err != nil {
+			return err
+		}
 	}
+	return w.Start(ctx)
 }
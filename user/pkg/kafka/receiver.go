@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/segmentio/kafka-go"
 )
@@ -39,3 +40,17 @@ func GetReader(brokers []string, groupId, topic string) *KafkaReader {
 	//go k.readMsg()
 	return k
 }
+
+// GetReaderWithMaxWait 有消息即返回的 reader，拉取不到消息时最多等待 maxWait，
+// 用于按时间间隔批量处理的消费者，maxWait 不超过处理间隔
+func GetReaderWithMaxWait(brokers []string, groupId, topic string, maxWait time.Duration) *KafkaReader {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  brokers,
+		GroupID:  groupId,
+		Topic:    topic,
+		MinBytes: 1,
+		MaxBytes: 10e6, // 10MB
+		MaxWait:  maxWait,
+	})
+	return &KafkaReader{R: r}
+}
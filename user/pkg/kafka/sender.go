@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/segmentio/kafka-go"
@@ -15,62 +16,139 @@ type LogData struct {
 	Data []byte
 }
 type KafkaWriter struct {
-	w    *kafka.Writer
-	data chan LogData
+	w         *kafka.Writer
+	data      chan LogData
+	closeCh   chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
 }
 
+const (
+	// sendBatchMax Send 发送时每次最多合并的消息数
+	sendBatchMax = 100
+	// writeRetries 写入失败时最多尝试的次数
+	writeRetries = 3
+	// writeTimeout 每次写入的超时，超时后重试使用新的超时
+	writeTimeout = 10 * time.Second
+)
+
 func InitWriter(kkAddr string) *KafkaWriter {
 	w := &kafka.Writer{
 		Addr:     kafka.TCP(kkAddr),
 		Balancer: &kafka.LeastBytes{},
+		// 同步写入时等待凑满一批的时间，默认1秒会使每次写入至少耗时1秒
+		BatchTimeout: 10 * time.Millisecond,
 	}
 	k := &KafkaWriter{
-		w:    w,
-		data: make(chan LogData, 100),
+		w:       w,
+		data:    make(chan LogData, 1000),
+		closeCh: make(chan struct{}),
+		done:    make(chan struct{}),
 	}
 	go k.sendKafka()
 	return k
 }
 
+// Send 异步发送，缓冲区满时阻塞，关闭后丢弃
 func (w *KafkaWriter) Send(data LogData) {
-	w.data <- data
+	select {
+	case <-w.closeCh:
+	case w.data <- data:
+	}
+}
+
+// TrySend 异步发送，缓冲区满或已关闭时丢弃并返回 false，不阻塞调用方
+func (w *KafkaWriter) TrySend(data LogData) bool {
+	select {
+	case <-w.closeCh:
+		return false
+	default:
+	}
+	select {
+	case w.data <- data:
+		return true
+	default:
+		return false
+	}
+}
+
+// Publish 同步发送消息，Leader 不可用或单次写入超时时重试，ctx 取消时不再重试
+func (w *KafkaWriter) Publish(ctx context.Context, msgs ...kafka.Message) error {
+	return w.write(ctx, msgs)
 }
 
-func (w *KafkaWriter) Close() {
-	if w.w != nil {
-		w.w.Close()
+// write 写入消息，每次尝试使用独立的超时
+func (w *KafkaWriter) write(ctx context.Context, messages []kafka.Message) error {
+	var err error
+	for i := 0; i < writeRetries; i++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, writeTimeout)
+		err = w.w.WriteMessages(attemptCtx, messages...)
+		cancel()
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+		if !errors.Is(err, kafka.LeaderNotAvailable) && !errors.Is(err, context.DeadlineExceeded) {
+			return err
+		}
+		time.Sleep(time.Millisecond * 250)
+	}
+	return err
+}
+
+// Close 停止接收消息，发送完缓冲中的消息后关闭生产者，ctx 结束时不再等待
+func (w *KafkaWriter) Close(ctx context.Context) error {
+	w.closeOnce.Do(func() {
+		close(w.closeCh)
+	})
+	select {
+	case <-w.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
 
 func (w *KafkaWriter) sendKafka() {
+	defer close(w.done)
 	for {
 		select {
 		case data := <-w.data:
-			messages := []kafka.Message{
-				{
-					Topic: data.Topic,
-					Value: data.Data,
-				},
-			}
-			var err error
-			const retries = 3
-			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-			for i := 0; i < retries; i++ {
-				// attempt to create topic prior to publishing the message
-				err = w.w.WriteMessages(ctx, messages...)
-				if err == nil {
-					break
-				}
-				if errors.Is(err, kafka.LeaderNotAvailable) || errors.Is(err, context.DeadlineExceeded) {
-					time.Sleep(time.Millisecond * 250)
-					continue
-				}
-				if err != nil {
-					log.Printf("kafka send writemessage err %s \n", err.Error())
+			w.sendBatch(data)
+		case <-w.closeCh:
+			// 发送剩余消息后关闭
+			for {
+				select {
+				case data := <-w.data:
+					w.sendBatch(data)
+				default:
+					if err := w.w.Close(); err != nil {
+						log.Printf("kafka writer close err %s \n", err.Error())
+					}
+					return
 				}
 			}
-			cancel()
 		}
 	}
+}
 
+// sendBatch 合并缓冲区中已有的消息一起发送
+func (w *KafkaWriter) sendBatch(data LogData) {
+	messages := []kafka.Message{
+		{
+			Topic: data.Topic,
+			Value: data.Data,
+		},
+	}
+drain:
+	for len(messages) < sendBatchMax {
+		select {
+		case data = <-w.data:
+			messages = append(messages, kafka.Message{Topic: data.Topic, Value: data.Data})
+		default:
+			break drain
+		}
+	}
+	if err := w.write(context.Background(), messages); err != nil {
+		log.Printf("kafka send writemessage err %s \n", err.Error())
+	}
 }
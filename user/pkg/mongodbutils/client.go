@@ -74,7 +74,10 @@ func InitMongoConnect(ctx context.Context) (err error) {
 	}
 
 	// 验证连接
-	if err := client.Ping(context.TODO(), readpref.Primary()); err != nil {
+	if err := client.Ping(ctx, readpref.Primary()); err != nil {
+		// 断开连接，避免重试时泄漏连接池
+		_ = client.Disconnect(context.Background())
+		client = nil
 		return fmt.Errorf("mongodb ping failed: %w", err)
 	}
 
@@ -83,6 +86,22 @@ func InitMongoConnect(ctx context.Context) (err error) {
 	return nil
 }
 
+// Ping 检查 mongodb 连接
+func Ping(ctx context.Context) error {
+	if client == nil {
+		return errors.New("mongodb not initialized")
+	}
+	return client.Ping(ctx, readpref.Primary())
+}
+
+// Close 断开 mongodb 连接
+func Close(ctx context.Context) error {
+	if client == nil {
+		return nil
+	}
+	return client.Disconnect(ctx)
+}
+
 // GetDatabase 获取数据库实例（默认使用配置中的数据库，支持传入自定义库名）
 func GetDatabase(customDB ...string) *mongo.Database {
 	if len(customDB) > 0 && customDB[0] != "" {
@@ -38,6 +38,26 @@ func InsertMany(ctx context.Context, collectionName string, documents []interfac
 	return result.InsertedIDs, nil
 }
 
+// InsertManyIgnoreDuplicate 无序插入多条文档，_id 或唯一索引冲突的文档跳过，其他文档照常写入；
+// 用于重复投递的消息按预先生成的 _id 去重
+func InsertManyIgnoreDuplicate(ctx context.Context, collectionName string, documents []interface{}, dbName ...string) error {
+	coll := GetCollection(collectionName, dbName...)
+	_, err := coll.InsertMany(ctx, documents, options.InsertMany().SetOrdered(false))
+	var bwe mongo.BulkWriteException
+	if errors.As(err, &bwe) && bwe.WriteConcernError == nil {
+		for _, we := range bwe.WriteErrors {
+			if !mongo.IsDuplicateKeyError(we.WriteError) {
+				return fmt.Errorf("insert many documents failed: %w", err)
+			}
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("insert many documents failed: %w", err)
+	}
+	return nil
+}
+
 // FindOne 查询单条文档
 func FindOne(ctx context.Context, collectionName string, filter interface{}, result interface{}, dbName ...string) error {
 	coll := GetCollection(collectionName, dbName...)
@@ -68,6 +88,21 @@ func Find(ctx context.Context, collectionName string, filter interface{}, result
 	return nil
 }
 
+// Aggregate 聚合查询
+func Aggregate(ctx context.Context, collectionName string, pipeline interface{}, results interface{}, dbName ...string) error {
+	coll := GetCollection(collectionName, dbName...)
+	cursor, err := coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return fmt.Errorf("aggregate documents failed: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, results); err != nil {
+		return fmt.Errorf("decode documents failed: %w", err)
+	}
+	return nil
+}
+
 // UpdateOne 更新单条文档
 func UpdateOne(ctx context.Context, collectionName string, filter interface{}, update interface{}, dbName ...string) (*mongo.UpdateResult, error) {
 	coll := GetCollection(collectionName, dbName...)
@@ -149,42 +184,31 @@ func DeleteById(ctx context.Context, collectionName string, id primitive.ObjectI
 
 type TransactionFunc func(ctx mongo.SessionContext) (interface{}, error)
 
-// ExecuteTransaction 执行事务（仅使用源码中存在的函数）
+// ExecuteTransaction 执行事务，遇到写冲突等临时错误（TransientTransactionError）时由驱动重试整个 fn，
+// 因此 fn 需要可重复执行；fn 返回的错误原样返回
 func ExecuteTransaction(ctx context.Context, fn TransactionFunc) (interface{}, error) {
-	// 1. 启动会话
 	session, err := client.StartSession()
 	if err != nil {
 		return nil, fmt.Errorf("启动会话失败: %w", err)
 	}
 	defer session.EndSession(ctx) // 确保会话关闭
 
-	// 2. 将外部上下文转换为会话上下文
-	sessionCtx := mongo.NewSessionContext(ctx, session)
-
-	// 3. 配置事务选项（读关注、写关注）
+	// 配置事务选项（读关注、写关注）
 	txOpts := options.Transaction().
 		SetReadConcern(readconcern.Majority()).
 		SetWriteConcern(writeconcern.Majority())
 
-	// 4. 开始事务
-	if err := session.StartTransaction(txOpts); err != nil {
-		return nil, fmt.Errorf("开始事务失败: %w", err)
-	}
+	return session.WithTransaction(ctx, fn, txOpts)
+}
 
-	// 5. 执行事务处理函数
-	result, err := fn(sessionCtx)
-	if err != nil {
-		// 失败时回滚事务
-		if abortErr := session.AbortTransaction(sessionCtx); abortErr != nil {
-			return nil, err
-		}
-		return nil, err
+// CreateIndexes 创建索引，已存在的同名同定义索引忽略
+func CreateIndexes(ctx context.Context, collectionName string, models []mongo.IndexModel, dbName ...string) error {
+	if len(models) == 0 {
+		return nil
 	}
-
-	// 6. 提交事务
-	if err := session.CommitTransaction(sessionCtx); err != nil {
-		return nil, err
+	coll := GetCollection(collectionName, dbName...)
+	if _, err := coll.Indexes().CreateMany(ctx, models); err != nil {
+		return fmt.Errorf("create indexes of %s failed: %w", collectionName, err)
 	}
-
-	return result, nil
+	return nil
 }
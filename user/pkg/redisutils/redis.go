@@ -2,10 +2,12 @@ package redisutils
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
+	"common/applog"
 	"user/config"
 
 	"github.com/redis/go-redis/v9"
@@ -72,6 +74,27 @@ func InitRedisConnect() (err error) {
 	return nil
 }
 
+// GetClient redis 客户端，未初始化时为 nil
+func GetClient() *redis.Client {
+	return client
+}
+
+// Ping 检查 redis 连接
+func Ping(ctx context.Context) error {
+	if client == nil {
+		return errors.New("redis not initialized")
+	}
+	return client.Ping(ctx).Err()
+}
+
+// Close 关闭 redis 连接池
+func Close(ctx context.Context) error {
+	if client == nil {
+		return nil
+	}
+	return client.Close()
+}
+
 // Set 设置键值对，并可设置过期时间
 // 参数：
 // - ctx: 上下文
@@ -135,6 +158,34 @@ func Decr(ctx context.Context, key string) (int64, error) {
 	return client.Decr(ctx, key).Result()
 }
 
+// GetOrLoad 读取 json 缓存，未命中时调用 load 并写入缓存；key 为空或 redis 出错时直接使用 load 的结果
+func GetOrLoad[T any](ctx context.Context, key string, expiration time.Duration, load func(ctx context.Context) (T, error)) (T, error) {
+	logger := applog.WrapGDPLogger(ctx)
+	if key != "" {
+		val, ok, err := Get(ctx, key)
+		if err != nil {
+			logger.Warn("get cache failed", key, err)
+		} else if ok {
+			var v T
+			if err = json.Unmarshal([]byte(val), &v); err == nil {
+				return v, nil
+			}
+			logger.Warn("decode cache failed", key, err)
+		}
+	}
+
+	v, err := load(ctx)
+	if err != nil || key == "" {
+		return v, err
+	}
+	if data, err := json.Marshal(v); err != nil {
+		logger.Warn("encode cache failed", key, err)
+	} else if err := Set(ctx, key, data, expiration); err != nil {
+		logger.Warn("set cache failed", key, err)
+	}
+	return v, nil
+}
+
 func Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
 	return client.Eval(ctx, script, keys, args...).Result()
 }
@@ -0,0 +1,209 @@
+// schemacheck 检查 ent 生成的迁移与 internal/sql/all.sql 是否一致，不一致时列出差异并以非零状态退出
+//
+// 在 user 目录下执行：go run ./script/schemacheck [-sql internal/sql/all.sql]
+//
+// 比较表注释、列类型、可空、默认值、自增、列注释、主键和索引（名称、唯一、列），
+// 不比较列顺序、索引注释（ent 不支持）和外键（all.sql 不使用外键，迁移时需关闭 migrate.WithForeignKeys）
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"common/sqlddl"
+	"user/internal/ent/migrate"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+func main() {
+	sqlFile := flag.String("sql", "internal/sql/all.sql", "DDL 文件")
+	flag.Parse()
+
+	ddl, err := sqlddl.ParseFile(*sqlFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	diffs := compare(migrate.Tables, ddl)
+	if len(diffs) == 0 {
+		fmt.Printf("ent schema matches %s\n", *sqlFile)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "ent schema drifts from %s:\n", *sqlFile)
+	for _, d := range diffs {
+		fmt.Fprintln(os.Stderr, "  "+d)
+	}
+	os.Exit(1)
+}
+
+func compare(entTables []*schema.Table, ddl []*sqlddl.Table) []string {
+	var diffs []string
+	sqlTables := make(map[string]*sqlddl.Table, len(ddl))
+	for _, t := range ddl {
+		sqlTables[t.Name] = t
+	}
+	for _, et := range entTables {
+		st, ok := sqlTables[et.Name]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("table %s: missing in sql", et.Name))
+			continue
+		}
+		delete(sqlTables, et.Name)
+		diffs = append(diffs, compareTable(et, st)...)
+	}
+	for name := range sqlTables {
+		diffs = append(diffs, fmt.Sprintf("table %s: missing in ent", name))
+	}
+	sort.Strings(diffs)
+	return diffs
+}
+
+func compareTable(et *schema.Table, st *sqlddl.Table) []string {
+	var diffs []string
+	add := func(format string, a ...any) {
+		diffs = append(diffs, et.Name+"."+fmt.Sprintf(format, a...))
+	}
+	if et.Comment != st.Comment {
+		diffs = append(diffs, fmt.Sprintf("table %s: comment %q in ent, %q in sql", et.Name, et.Comment, st.Comment))
+	}
+
+	seen := make(map[string]bool)
+	for _, ec := range et.Columns {
+		seen[ec.Name] = true
+		sc := st.Column(ec.Name)
+		if sc == nil {
+			add("%s: column missing in sql", ec.Name)
+			continue
+		}
+		if t := mysqlType(ec); t != sc.FullType() {
+			add("%s: type %s in ent, %s in sql", ec.Name, t, sc.FullType())
+		}
+		if ec.Nullable != sc.Nullable {
+			add("%s: nullable %v in ent, %v in sql", ec.Name, ec.Nullable, sc.Nullable)
+		}
+		if d, s := entDefault(ec), sqlDefault(sc); d != s {
+			add("%s: default %s in ent, %s in sql", ec.Name, d, s)
+		}
+		if ec.Increment != sc.AutoIncrement {
+			add("%s: auto_increment %v in ent, %v in sql", ec.Name, ec.Increment, sc.AutoIncrement)
+		}
+		if ec.Comment != sc.Comment {
+			add("%s: comment %q in ent, %q in sql", ec.Name, ec.Comment, sc.Comment)
+		}
+	}
+	for _, sc := range st.Columns {
+		if !seen[sc.Name] {
+			add("%s: column missing in ent", sc.Name)
+		}
+	}
+
+	if e, s := columnNames(et.PrimaryKey), strings.Join(st.PrimaryKey, ","); e != s {
+		add("PRIMARY: columns (%s) in ent, (%s) in sql", e, s)
+	}
+
+	seen = make(map[string]bool)
+	for _, ei := range et.Indexes {
+		seen[ei.Name] = true
+		si := st.Index(ei.Name)
+		if si == nil {
+			add("%s: index missing in sql", ei.Name)
+			continue
+		}
+		if ei.Unique != si.Unique {
+			add("%s: unique %v in ent, %v in sql", ei.Name, ei.Unique, si.Unique)
+		}
+		if e, s := columnNames(ei.Columns), strings.Join(si.Columns, ","); e != s {
+			add("%s: columns (%s) in ent, (%s) in sql", ei.Name, e, s)
+		}
+	}
+	for _, si := range st.Indexes {
+		if !seen[si.Name] {
+			add("%s: index missing in ent", si.Name)
+		}
+	}
+	return diffs
+}
+
+// mysqlType ent 列在 MySQL 中的类型，与 ent 的 MySQL 迁移一致
+func mysqlType(c *schema.Column) string {
+	if t, ok := c.SchemaType[dialect.MySQL]; ok {
+		return strings.ToLower(t)
+	}
+	switch c.Type {
+	case field.TypeBool:
+		return "tinyint(1)"
+	case field.TypeInt8:
+		return "tinyint"
+	case field.TypeUint8:
+		return "tinyint unsigned"
+	case field.TypeInt16:
+		return "smallint"
+	case field.TypeUint16:
+		return "smallint unsigned"
+	case field.TypeInt32:
+		return "int"
+	case field.TypeUint32:
+		return "int unsigned"
+	case field.TypeInt, field.TypeInt64:
+		return "bigint"
+	case field.TypeUint, field.TypeUint64:
+		return "bigint unsigned"
+	case field.TypeFloat32:
+		return "float"
+	case field.TypeFloat64:
+		return "double"
+	case field.TypeTime:
+		return "timestamp"
+	case field.TypeJSON:
+		return "json"
+	case field.TypeUUID:
+		return "char(36)"
+	case field.TypeBytes:
+		return "blob"
+	case field.TypeEnum:
+		quoted := make([]string, len(c.Enums))
+		for i, v := range c.Enums {
+			quoted[i] = "'" + strings.ReplaceAll(v, "'", "''") + "'"
+		}
+		return "enum(" + strings.Join(quoted, ",") + ")"
+	case field.TypeString:
+		size := c.Size
+		if size == 0 {
+			size = schema.DefaultStringLen
+		}
+		if size > 1<<16-1 {
+			return "longtext"
+		}
+		return fmt.Sprintf("varchar(%d)", size)
+	default:
+		return c.Type.String()
+	}
+}
+
+func entDefault(c *schema.Column) string {
+	if c.Default == nil {
+		return "NULL"
+	}
+	return fmt.Sprint(c.Default)
+}
+
+func sqlDefault(c *sqlddl.Column) string {
+	if c.Default == nil {
+		return "NULL"
+	}
+	return *c.Default
+}
+
+func columnNames(cols []*schema.Column) string {
+	names := make([]string, len(cols))
+	for i, c := range cols {
+		names[i] = c.Name
+	}
+	return strings.Join(names, ",")
+}